@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io/fs"
 	"log/slog"
 	"net"
 	"net/http"
@@ -22,7 +24,9 @@ import (
 	"github.com/yourorg/timeservice/pkg/auth"
 	"github.com/yourorg/timeservice/pkg/config"
 	"github.com/yourorg/timeservice/pkg/db"
+	"github.com/yourorg/timeservice/pkg/holiday"
 	"github.com/yourorg/timeservice/pkg/metrics"
+	"github.com/yourorg/timeservice/pkg/timescale"
 	"github.com/yourorg/timeservice/pkg/version"
 )
 
@@ -59,11 +63,25 @@ func main() {
 		// Initialize metrics for stdio mode (minimal, for database tracking)
 		metricsCollector := metrics.New("timeservice")
 
-		// Initialize location repository with metrics
+		// Initialize repositories with metrics
 		locationRepo := repository.NewLocationRepository(database, metricsCollector)
+		holidayRepo := repository.NewHolidayRepository(database, metricsCollector)
+		fiscalRepo := repository.NewFiscalCalendarRepository(database, metricsCollector)
 
-		// Create MCP server with metrics and location repository
-		mcpServer := mcpserver.NewServerWithMetrics(logger, metricsCollector, locationRepo)
+		// Import holiday calendars from data files
+		if err := importHolidayCalendars(context.Background(), config.HolidayDataDirFromEnv(), holidayRepo, logger); err != nil {
+			logger.Error("failed to import holiday calendars", "error", err)
+			os.Exit(1)
+		}
+
+		// Load the leap second table, if newer than the bundled one
+		if err := loadLeapSeconds(config.LeapSecondsFileFromEnv(), logger); err != nil {
+			logger.Error("failed to load leap second table", "error", err)
+			os.Exit(1)
+		}
+
+		// Create MCP server with metrics and repositories
+		mcpServer := mcpserver.NewServerWithMetrics(logger, metricsCollector, locationRepo, holidayRepo, fiscalRepo)
 
 		if err := server.ServeStdio(mcpServer); err != nil {
 			logger.Error("MCP stdio server error", "error", err)
@@ -99,6 +117,8 @@ func main() {
 		"db_max_idle_conns", cfg.DBMaxIdleConns,
 		"db_cache_size_kb", cfg.DBCacheSize,
 		"db_wal_mode", cfg.DBWalMode,
+		"holiday_data_dir", cfg.HolidayDataDir,
+		"leap_seconds_file", cfg.LeapSecondsFile,
 	)
 
 	// Warn if wildcard CORS is configured (security risk)
@@ -196,6 +216,22 @@ func main() {
 
 	// Initialize repositories with metrics
 	locationRepo := repository.NewLocationRepository(database, metricsCollector)
+	holidayRepo := repository.NewHolidayRepository(database, metricsCollector)
+	fiscalRepo := repository.NewFiscalCalendarRepository(database, metricsCollector)
+
+	// Import holiday calendars from data files
+	if err := importHolidayCalendars(context.Background(), cfg.HolidayDataDir, holidayRepo, logger); err != nil {
+		logger.Error("failed to import holiday calendars", "error", err)
+		database.Close()
+		os.Exit(1)
+	}
+
+	// Load the leap second table, if newer than the bundled one
+	if err := loadLeapSeconds(cfg.LeapSecondsFile, logger); err != nil {
+		logger.Error("failed to load leap second table", "error", err)
+		database.Close()
+		os.Exit(1)
+	}
 
 	// Start goroutine to periodically update database connection pool metrics
 	go func() {
@@ -208,8 +244,8 @@ func main() {
 		}
 	}()
 
-	// Create MCP server with metrics and location repository
-	mcpServer := mcpserver.NewServerWithMetrics(logger, metricsCollector, locationRepo)
+	// Create MCP server with metrics and repositories
+	mcpServer := mcpserver.NewServerWithMetrics(logger, metricsCollector, locationRepo, holidayRepo, fiscalRepo)
 
 	// Otherwise run HTTP server with both REST endpoints and MCP support
 
@@ -217,11 +253,23 @@ func main() {
 	mcpHTTPServer := server.NewStreamableHTTPServer(mcpServer)
 
 	// Create HTTP handler - only needs the StreamableHTTPServer, not the full MCPServer
-	h := handler.New(logger, mcpHTTPServer)
+	h := handler.New(logger, mcpHTTPServer, locationRepo)
 
 	// Create location handler
 	locationHandler := handler.NewLocationHandler(locationRepo, logger)
 
+	// Create time calculation handler
+	timeHandler := handler.NewTimeHandler(locationRepo, logger)
+
+	// Create holiday handler
+	holidayHandler := handler.NewHolidayHandler(locationRepo, holidayRepo, logger)
+
+	// Create business-day handler
+	businessDayHandler := handler.NewBusinessDayHandler(locationRepo, holidayRepo, logger)
+
+	// Create fiscal calendar handler
+	fiscalHandler := handler.NewFiscalHandler(locationRepo, fiscalRepo, logger)
+
 	// Setup router
 	mux := http.NewServeMux()
 
@@ -231,6 +279,26 @@ func main() {
 	// Time endpoint
 	mux.HandleFunc("GET /api/time", h.GetTime)
 
+	// Time calculation endpoints
+	mux.HandleFunc("GET /api/convert", timeHandler.ConvertTime)
+	mux.HandleFunc("GET /api/time/diff", timeHandler.TimeDiff)
+	mux.HandleFunc("GET /api/time/add", timeHandler.AddTime)
+	mux.HandleFunc("GET /api/time/transitions", timeHandler.Transitions)
+	mux.HandleFunc("GET /api/time/offsets", timeHandler.OffsetTimeline)
+	mux.HandleFunc("GET /api/time/parse", timeHandler.ParseTime)
+	mux.HandleFunc("GET /api/time/relative", timeHandler.RelativeTime)
+	mux.HandleFunc("GET /api/time/bucket", timeHandler.BucketTime)
+	mux.HandleFunc("GET /api/timezones", timeHandler.ListTimezones)
+	mux.HandleFunc("GET /api/timezones/lookup", timeHandler.LookupTimezone)
+	mux.HandleFunc("GET /api/worldclock", timeHandler.WorldClock)
+	mux.HandleFunc("POST /api/meetings/slots", timeHandler.FindMeetingSlots)
+	mux.HandleFunc("POST /api/recurrence/expand", timeHandler.ExpandRecurrence)
+	mux.HandleFunc("POST /api/intervals", timeHandler.Intervals)
+	mux.HandleFunc("GET /api/cron/preview", timeHandler.CronPreview)
+	mux.HandleFunc("GET /api/calendars/convert", timeHandler.ConvertCalendar)
+	mux.HandleFunc("GET /api/timescales/convert", timeHandler.ConvertTimeScale)
+	mux.HandleFunc("GET /api/timescales/leap-seconds", timeHandler.LeapSeconds)
+
 	// Location management endpoints
 	mux.HandleFunc("POST /api/locations", locationHandler.CreateLocation)
 	mux.HandleFunc("GET /api/locations", locationHandler.ListLocations)
@@ -238,6 +306,29 @@ func main() {
 	mux.HandleFunc("PUT /api/locations/{name}", locationHandler.UpdateLocation)
 	mux.HandleFunc("DELETE /api/locations/{name}", locationHandler.DeleteLocation)
 	mux.HandleFunc("GET /api/locations/{name}/time", locationHandler.GetLocationTime)
+	mux.HandleFunc("GET /api/locations/{name}/status", locationHandler.GetLocationStatus)
+	mux.HandleFunc("GET /api/locations/{name}/transitions", locationHandler.GetLocationTransitions)
+	mux.HandleFunc("GET /api/locations/{name}/sun", locationHandler.GetLocationSun)
+
+	// Holiday endpoints
+	mux.HandleFunc("GET /api/holidays/calendars", holidayHandler.ListCalendars)
+	mux.HandleFunc("GET /api/locations/{name}/calendars", holidayHandler.GetLocationCalendars)
+	mux.HandleFunc("PUT /api/locations/{name}/calendars", holidayHandler.SetLocationCalendars)
+	mux.HandleFunc("GET /api/locations/{name}/holidays", holidayHandler.ListHolidays)
+	mux.HandleFunc("POST /api/locations/{name}/holidays", holidayHandler.CreateHoliday)
+	mux.HandleFunc("PUT /api/locations/{name}/holidays/{id}", holidayHandler.UpdateHoliday)
+	mux.HandleFunc("DELETE /api/locations/{name}/holidays/{id}", holidayHandler.DeleteHoliday)
+	mux.HandleFunc("GET /api/locations/{name}/business-days/add", businessDayHandler.AddBusinessDays)
+	mux.HandleFunc("GET /api/locations/{name}/business-days/count", businessDayHandler.CountBusinessDays)
+
+	// Fiscal calendar endpoints
+	mux.HandleFunc("GET /api/fiscal/calendars", fiscalHandler.ListCalendars)
+	mux.HandleFunc("POST /api/fiscal/calendars", fiscalHandler.CreateCalendar)
+	mux.HandleFunc("GET /api/fiscal/calendars/{name}", fiscalHandler.GetCalendar)
+	mux.HandleFunc("PUT /api/fiscal/calendars/{name}", fiscalHandler.UpdateCalendar)
+	mux.HandleFunc("DELETE /api/fiscal/calendars/{name}", fiscalHandler.DeleteCalendar)
+	mux.HandleFunc("GET /api/fiscal/calendars/{name}/date", fiscalHandler.FiscalDate)
+	mux.HandleFunc("GET /api/fiscal/calendars/{name}/periods", fiscalHandler.FiscalPeriods)
 
 	// MCP endpoint (HTTP transport) - POST only for JSON-RPC
 	mux.HandleFunc("POST /mcp", h.MCP)
@@ -335,3 +426,59 @@ func main() {
 
 	logger.Info("server stopped gracefully")
 }
+
+// importHolidayCalendars loads the holiday calendar files in dir into the repository,
+// replacing calendars of the same name
+func importHolidayCalendars(ctx context.Context, dir string, repo repository.HolidayRepository, logger *slog.Logger) error {
+	calendars, err := holiday.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, cal := range calendars {
+		if err := repo.ImportCalendar(ctx, cal); err != nil {
+			return fmt.Errorf("%s: %w", cal.Source, err)
+		}
+		logger.Info("holiday calendar imported",
+			"name", cal.Name,
+			"source", cal.Source,
+			"holidays", cal.HolidayCount,
+		)
+	}
+
+	logger.Info("holiday calendars loaded", "dir", dir, "count", len(calendars))
+	return nil
+}
+
+// loadLeapSeconds replaces the bundled leap second table with the one in
+// path when that is known to be complete for longer. A missing file keeps
+// the bundled table.
+func loadLeapSeconds(path string, logger *slog.Logger) error {
+	table, err := timescale.Load(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		logger.Info("leap second file not found, using bundled table", "path", path)
+	case err != nil:
+		return fmt.Errorf("%s: %w", path, err)
+	case table.Newer(timescale.Default()):
+		timescale.SetDefault(table)
+	default:
+		logger.Warn("leap second file is not newer than the bundled table, ignoring it",
+			"path", path,
+			"expires", table.Expires.Format(time.DateOnly),
+		)
+	}
+
+	current := timescale.Default()
+	logger.Info("leap second table loaded",
+		"source", current.Source,
+		"expires", current.Expires.Format(time.DateOnly),
+		"tai_minus_utc", current.Leaps[len(current.Leaps)-1].Offset,
+	)
+	if current.Expired(time.Now()) {
+		logger.Warn("leap second table has expired; leap seconds announced since are unknown",
+			"recommendation", "download a current leap-seconds.list to LEAP_SECONDS_FILE",
+		)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Package businessday performs business-day arithmetic on the weekends and
+// holiday calendars of saved locations.
+package businessday
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// Calculator errors
+var (
+	ErrInvalidTime = errors.New("invalid date or time")
+	ErrOutOfRange  = errors.New("no result within 100 years of start")
+)
+
+// maxSearchDays bounds how far Add looks for its result
+const maxSearchDays = 100 * 366
+
+// Calculator computes business days from a location's weekend and the holidays
+// of its calendars and custom holidays
+type Calculator struct {
+	locations repository.LocationRepository
+	holidays  repository.HolidayRepository
+}
+
+// NewCalculator creates a new calculator backed by the given repositories
+func NewCalculator(locations repository.LocationRepository, holidays repository.HolidayRepository) *Calculator {
+	return &Calculator{locations: locations, holidays: holidays}
+}
+
+// Add moves the start of req by req.Days business days in the location's timezone.
+// An empty start means now.
+func (c *Calculator) Add(ctx context.Context, req *model.BusinessDaysAddRequest, now time.Time) (*model.BusinessDaysAddResponse, error) {
+	loc, tz, err := c.location(ctx, req.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := timeparse.Parse(req.Start, tz, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: start: %v", ErrInvalidTime, err)
+	}
+
+	// Holidays only push the result further away, so it is exact once it lands
+	// inside the window whose holidays are known; widen the window until it does
+	span := 7*abs(req.Days) + 31
+	for span <= 2*maxSearchDays {
+		from, to := start, start.AddDate(0, 0, span)
+		if req.Days < 0 {
+			from, to = start.AddDate(0, 0, -span), start
+		}
+
+		holidays, err := c.listHolidays(ctx, loc, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		result := loc.BusinessDays(holidays).Add(start, req.Days)
+		if date := dateOf(result); date >= dateOf(from) && date <= dateOf(to) {
+			return model.NewBusinessDaysAddResponse(loc, req.Days, start, result, holidays), nil
+		}
+		span *= 2
+	}
+
+	return nil, ErrOutOfRange
+}
+
+// Count counts the business days between the dates of req in the location's timezone.
+// An empty from means now.
+func (c *Calculator) Count(ctx context.Context, req *model.BusinessDaysCountRequest, now time.Time) (*model.BusinessDaysCountResponse, error) {
+	loc, tz, err := c.location(ctx, req.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := timeparse.Parse(req.From, tz, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: from: %v", ErrInvalidTime, err)
+	}
+	to, err := timeparse.Parse(req.To, tz, now)
+	if err != nil {
+		return nil, fmt.Errorf("%w: to: %v", ErrInvalidTime, err)
+	}
+
+	first, last := from, to
+	if last.Before(first) {
+		first, last = last, first
+	}
+	if last.After(first.AddDate(model.MaxBusinessDayRangeYears, 0, 0)) {
+		return nil, model.ErrBusinessDayRangeLimit
+	}
+
+	holidays, err := c.listHolidays(ctx, loc, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.NewBusinessDaysCountResponse(loc, from, to, holidays), nil
+}
+
+// location looks up a saved location and loads its timezone
+func (c *Calculator) location(ctx context.Context, name string) (*model.Location, *time.Location, error) {
+	loc, err := c.locations.GetByName(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("location %s has invalid timezone %s: %w", loc.Name, loc.Timezone, err)
+	}
+
+	return loc, tz, nil
+}
+
+// listHolidays returns the location's holidays between the dates of from and to
+func (c *Calculator) listHolidays(ctx context.Context, loc *model.Location, from, to time.Time) ([]*model.Holiday, error) {
+	holidays, err := c.holidays.ListHolidays(ctx, loc.Name, dateOf(from), dateOf(to))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list holidays: %w", err)
+	}
+	return holidays, nil
+}
+
+// IsClientError reports whether err was caused by bad input rather than a backend failure
+func IsClientError(err error) bool {
+	return errors.Is(err, ErrInvalidTime) ||
+		errors.Is(err, ErrOutOfRange) ||
+		errors.Is(err, model.ErrBusinessDayRangeLimit)
+}
+
+// dateOf returns the local date of t
+func dateOf(t time.Time) string {
+	return t.Format(model.HolidayDateLayout)
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,179 @@
+package businessday
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// stubLocationRepository serves locations from a map for calculator tests
+type stubLocationRepository struct {
+	repository.LocationRepository
+	locations map[string]*model.Location
+}
+
+func (s *stubLocationRepository) GetByName(ctx context.Context, name string) (*model.Location, error) {
+	if loc, ok := s.locations[name]; ok {
+		return loc, nil
+	}
+	return nil, repository.ErrLocationNotFound
+}
+
+// stubHolidayRepository serves the same holidays to every location and records the ranges asked for
+type stubHolidayRepository struct {
+	repository.HolidayRepository
+	holidays []*model.Holiday
+	ranges   [][2]string
+}
+
+func (s *stubHolidayRepository) ListHolidays(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+	s.ranges = append(s.ranges, [2]string{from, to})
+	var out []*model.Holiday
+	for _, h := range s.holidays {
+		if h.Date >= from && h.Date <= to {
+			out = append(out, h)
+		}
+	}
+	return out, nil
+}
+
+func newTestCalculator(holidays ...*model.Holiday) (*Calculator, *stubHolidayRepository) {
+	locations := &stubLocationRepository{locations: map[string]*model.Location{
+		"tokyo":  {Name: "tokyo", Timezone: "Asia/Tokyo"},
+		"dubai":  {Name: "dubai", Timezone: "Asia/Dubai", Weekend: []string{"saturday", "sunday"}},
+		"riyadh": {Name: "riyadh", Timezone: "Asia/Riyadh", Weekend: []string{"friday", "saturday"}},
+	}}
+	stub := &stubHolidayRepository{holidays: holidays}
+	return NewCalculator(locations, stub), stub
+}
+
+func TestAdd(t *testing.T) {
+	goldenWeek := []*model.Holiday{
+		{Date: "2025-04-29", Name: "Showa Day", Calendar: "jp"},
+		{Date: "2025-05-03", Name: "Constitution Day", Calendar: "jp"},
+		{Date: "2025-05-05", Name: "Children's Day", Calendar: "jp"},
+		{Date: "2025-05-06", Name: "Substitute Holiday", Calendar: "jp"},
+	}
+	calc, _ := newTestCalculator(goldenWeek...)
+	now := time.Date(2025, 4, 25, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		req         model.BusinessDaysAddRequest
+		wantResult  string
+		wantSkipped int
+		wantErr     error
+	}{
+		{
+			name:        "forward across golden week",
+			req:         model.BusinessDaysAddRequest{Location: "tokyo", Start: "2025-04-25", Days: 5},
+			wantResult:  "2025-05-07T00:00:00+09:00",
+			wantSkipped: 3,
+		},
+		{
+			name:        "backward",
+			req:         model.BusinessDaysAddRequest{Location: "tokyo", Start: "2025-05-07T09:30:00+09:00", Days: -5},
+			wantResult:  "2025-04-25T09:30:00+09:00",
+			wantSkipped: 3,
+		},
+		{
+			name:       "defaults to now in the location's timezone",
+			req:        model.BusinessDaysAddRequest{Location: "tokyo", Days: 1},
+			wantResult: "2025-04-28T12:00:00+09:00",
+		},
+		{
+			name:       "friday-saturday weekend",
+			req:        model.BusinessDaysAddRequest{Location: "riyadh", Start: "2025-04-24", Days: 1},
+			wantResult: "2025-04-27T00:00:00+03:00",
+		},
+		{
+			name:    "unknown location",
+			req:     model.BusinessDaysAddRequest{Location: "nowhere", Days: 1},
+			wantErr: repository.ErrLocationNotFound,
+		},
+		{
+			name:    "invalid start",
+			req:     model.BusinessDaysAddRequest{Location: "tokyo", Start: "next week", Days: 1},
+			wantErr: ErrInvalidTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := calc.Add(context.Background(), &tt.req, now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.Result.Time != tt.wantResult {
+				t.Errorf("result = %s, want %s", resp.Result.Time, tt.wantResult)
+			}
+			if len(resp.SkippedHolidays) != tt.wantSkipped {
+				t.Errorf("skipped %d holidays, want %d: %+v", len(resp.SkippedHolidays), tt.wantSkipped, resp.SkippedHolidays)
+			}
+		})
+	}
+}
+
+func TestAddWidensHolidayWindow(t *testing.T) {
+	// A long shutdown pushes the result past the first window
+	var shutdown []*model.Holiday
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	for d := 1; d <= 60; d++ {
+		shutdown = append(shutdown, &model.Holiday{Date: start.AddDate(0, 0, d).Format(model.HolidayDateLayout), Name: "Shutdown"})
+	}
+	calc, stub := newTestCalculator(shutdown...)
+
+	resp, err := calc.Add(context.Background(), &model.BusinessDaysAddRequest{Location: "dubai", Start: "2025-01-06", Days: 1}, start)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The shutdown ends on Friday 7 March
+	if resp.ResultDate != "2025-03-10" {
+		t.Errorf("result date = %s, want 2025-03-10", resp.ResultDate)
+	}
+	if len(stub.ranges) < 2 {
+		t.Errorf("expected the holiday window to be widened, got ranges %v", stub.ranges)
+	}
+}
+
+func TestCount(t *testing.T) {
+	calc, _ := newTestCalculator(
+		&model.Holiday{Date: "2025-12-25", Name: "Christmas Day", Calendar: "uk"},
+		&model.Holiday{Date: "2025-12-27", Name: "Saturday Holiday", Calendar: "uk"},
+	)
+	now := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := calc.Count(context.Background(), &model.BusinessDaysCountRequest{Location: "tokyo", From: "2025-12-22", To: "2026-01-02"}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 23 December to 2 January: 11 days, one weekend, Christmas on a Thursday;
+	// the Saturday holiday does not cost a business day
+	if resp.BusinessDays != 8 || resp.CalendarDays != 11 || resp.WeekendDays != 2 || len(resp.Holidays) != 1 {
+		t.Errorf("unexpected count: business %d, calendar %d, weekend %d, holidays %d",
+			resp.BusinessDays, resp.CalendarDays, resp.WeekendDays, len(resp.Holidays))
+	}
+
+	reversed, err := calc.Count(context.Background(), &model.BusinessDaysCountRequest{Location: "tokyo", From: "2026-01-02", To: "2025-12-22"}, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reversed.BusinessDays != -resp.BusinessDays || reversed.CalendarDays != -11 {
+		t.Errorf("expected reversed count to be negative, got %d", reversed.BusinessDays)
+	}
+
+	_, err = calc.Count(context.Background(), &model.BusinessDaysCountRequest{Location: "tokyo", From: "2020-01-01", To: "2031-01-01"}, now)
+	if !errors.Is(err, model.ErrBusinessDayRangeLimit) || !IsClientError(err) {
+		t.Errorf("expected ErrBusinessDayRangeLimit, got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// BucketTime handles GET /api/time/bucket
+func (h *TimeHandler) BucketTime(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.BucketRequest{
+		Time:     query.Get("time"),
+		Timezone: query.Get("timezone"),
+		Unit:     query.Get("unit"),
+		Mode:     query.Get("mode"),
+		Start:    query.Get("start"),
+		End:      query.Get("end"),
+	}
+	if v := query.Get("size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.logger.Warn("invalid query parameter", "size", v)
+			h.errorJSON(w, "size must be an integer", http.StatusBadRequest)
+			return
+		}
+		req.Size = n
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	now := time.Now()
+	var t *time.Time
+	if req.HasTime() {
+		parsed, err := timeparse.Parse(req.Time, z.TZ, now)
+		if err != nil {
+			h.logger.Warn("invalid time", "time", req.Time, "error", err)
+			h.errorJSON(w, "invalid time: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		t = &parsed
+	}
+	var start, end time.Time
+	if req.Start != "" {
+		if start, end, err = req.Range(z.TZ, now); err != nil {
+			h.logger.Warn("invalid range", "start", req.Start, "end", req.End, "error", err)
+			h.errorJSON(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	response := model.NewBucketResponse(&req, z.TZ, z.Location, t, start, end)
+
+	h.logger.Debug("time bucketed",
+		"timezone", response.Timezone,
+		"bucket", response.Bucket,
+		"mode", response.Mode,
+		"count", response.Count,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestBucketTime(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.BucketResponse)
+	}{
+		{
+			name:           "round to 15 minutes",
+			query:          "time=2025-01-06+10:07:30&timezone=Asia/Kolkata&unit=minute&size=15&mode=round",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.BucketResponse) {
+				if resp.Bucket != "15 minute" || resp.Result.Time != "2025-01-06T10:15:00+05:30" {
+					t.Errorf("unexpected result: %s %+v", resp.Bucket, resp.Result)
+				}
+				if resp.Current.Start != "2025-01-06T10:00:00+05:30" || resp.Buckets != nil {
+					t.Errorf("unexpected current bucket: %+v", resp.Current)
+				}
+			},
+		},
+		{
+			name:           "days of a saved location across DST",
+			query:          "timezone=hq&unit=day&start=2025-03-08&end=2025-03-11",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.BucketResponse) {
+				if resp.Location != "hq" || resp.Time != nil || resp.Count != 3 {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+				if resp.Buckets[1].DurationSeconds != 23*3600 {
+					t.Errorf("expected a 23 hour day, got %s", resp.Buckets[1].Duration)
+				}
+			},
+		},
+		{
+			name:           "invalid size",
+			query:          "unit=hour&size=two",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "size must be an integer",
+		},
+		{
+			name:           "missing unit",
+			query:          "time=now",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyBucketUnit.Error(),
+		},
+		{
+			name:           "reversed range",
+			query:          "unit=day&start=2025-03-11&end=2025-03-08",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrBucketOrder.Error(),
+		},
+		{
+			name:           "invalid time",
+			query:          "unit=day&time=soon",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `invalid time: unrecognized time format: "soon"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"hq": "America/New_York"}),
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/bucket?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.BucketTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.BucketResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
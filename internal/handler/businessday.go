@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/businessday"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// BusinessDayHandler handles business-day arithmetic HTTP requests
+type BusinessDayHandler struct {
+	calc   *businessday.Calculator
+	logger *slog.Logger
+}
+
+// NewBusinessDayHandler creates a new business-day handler
+func NewBusinessDayHandler(locations repository.LocationRepository, holidays repository.HolidayRepository, logger *slog.Logger) *BusinessDayHandler {
+	return &BusinessDayHandler{
+		calc:   businessday.NewCalculator(locations, holidays),
+		logger: logger,
+	}
+}
+
+// AddBusinessDays handles GET /api/locations/{name}/business-days/add?start=&days=
+func (h *BusinessDayHandler) AddBusinessDays(w http.ResponseWriter, r *http.Request) {
+	req := model.BusinessDaysAddRequest{
+		Location: r.PathValue("name"),
+		Start:    r.URL.Query().Get("start"),
+	}
+	days, err := strconv.Atoi(r.URL.Query().Get("days"))
+	if err != nil {
+		h.logger.Warn("invalid query parameter", "parameter", "days", "error", err)
+		h.errorJSON(w, "days must be an integer", http.StatusBadRequest)
+		return
+	}
+	req.Days = days
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.calc.Add(r.Context(), &req, time.Now())
+	if err != nil {
+		h.calcError(w, err, req.Location)
+		return
+	}
+
+	h.logger.Debug("business days added",
+		"name", req.Location,
+		"days", req.Days,
+		"result", response.ResultDate,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// CountBusinessDays handles GET /api/locations/{name}/business-days/count?from=&to=
+func (h *BusinessDayHandler) CountBusinessDays(w http.ResponseWriter, r *http.Request) {
+	req := model.BusinessDaysCountRequest{
+		Location: r.PathValue("name"),
+		From:     r.URL.Query().Get("from"),
+		To:       r.URL.Query().Get("to"),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := h.calc.Count(r.Context(), &req, time.Now())
+	if err != nil {
+		h.calcError(w, err, req.Location)
+		return
+	}
+
+	h.logger.Debug("business days counted",
+		"name", req.Location,
+		"business_days", response.BusinessDays,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// calcError writes the response for a calculator error
+func (h *BusinessDayHandler) calcError(w http.ResponseWriter, err error, name string) {
+	switch {
+	case errors.Is(err, repository.ErrLocationNotFound):
+		h.logger.Debug("location not found", "name", name)
+		h.errorJSON(w, "Location not found", http.StatusNotFound)
+	case businessday.IsClientError(err):
+		h.logger.Warn("invalid business day request", "name", name, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+	default:
+		h.logger.Error("failed to calculate business days", "error", err, "name", name)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// json sends a JSON response
+func (h *BusinessDayHandler) json(w http.ResponseWriter, data interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("json encode error", "error", err)
+	}
+}
+
+// errorJSON sends an error JSON response
+func (h *BusinessDayHandler) errorJSON(w http.ResponseWriter, message string, status int) {
+	h.json(w, map[string]string{"error": message}, status)
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestBusinessDayEndpoints(t *testing.T) {
+	locations := &mockLocationRepository{
+		getByNameFunc: newLocationLookup(map[string]string{"tokyo": "Asia/Tokyo"}),
+	}
+	holidays := &mockHolidayRepository{
+		listHolidaysFunc: func(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+			return []*model.Holiday{{Date: "2025-05-05", Name: "Children's Day", Calendar: "jp"}}, nil
+		},
+	}
+	handler := NewBusinessDayHandler(locations, holidays, newTestLogger())
+
+	tests := []struct {
+		name           string
+		location       string
+		path           string
+		serve          http.HandlerFunc
+		expectedStatus int
+		check          func(t *testing.T, body []byte)
+	}{
+		{
+			name:           "add",
+			location:       "tokyo",
+			path:           "/add?start=2025-05-02&days=1",
+			serve:          handler.AddBusinessDays,
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var resp model.BusinessDaysAddResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.ResultDate != "2025-05-06" || resp.Weekday != "Tuesday" || len(resp.SkippedHolidays) != 1 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Result.Time != "2025-05-06T00:00:00+09:00" {
+					t.Errorf("expected result in the location's timezone, got %s", resp.Result.Time)
+				}
+			},
+		},
+		{
+			name:           "count",
+			location:       "tokyo",
+			path:           "/count?from=2025-05-01&to=2025-05-09",
+			serve:          handler.CountBusinessDays,
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				var resp model.BusinessDaysCountResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.BusinessDays != 5 || resp.WeekendDays != 2 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "missing days",
+			location:       "tokyo",
+			path:           "/add?start=2025-05-02",
+			serve:          handler.AddBusinessDays,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "too many days",
+			location:       "tokyo",
+			path:           "/add?days=100000",
+			serve:          handler.AddBusinessDays,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "missing to",
+			location:       "tokyo",
+			path:           "/count?from=2025-05-01",
+			serve:          handler.CountBusinessDays,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid start",
+			location:       "tokyo",
+			path:           "/add?start=someday&days=1",
+			serve:          handler.AddBusinessDays,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "location not found",
+			location:       "nowhere",
+			path:           "/count?to=2025-05-09",
+			serve:          handler.CountBusinessDays,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/locations/"+tt.location+"/business-days"+tt.path, nil)
+			req.SetPathValue("name", tt.location)
+			w := httptest.NewRecorder()
+
+			tt.serve(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.check != nil {
+				tt.check(t, w.Body.Bytes())
+			}
+		})
+	}
+}
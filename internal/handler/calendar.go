@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/calendar"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// ConvertCalendar handles GET /api/calendars/convert
+func (h *TimeHandler) ConvertCalendar(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.CalendarConvertRequest{
+		From:     query.Get("from"),
+		Date:     query.Get("date"),
+		Era:      query.Get("era"),
+		Time:     query.Get("time"),
+		Timezone: query.Get("timezone"),
+		To:       query["to"],
+	}
+	fields := []struct {
+		name  string
+		value *int
+	}{{"year", &req.Year}, {"month", &req.Month}, {"week", &req.Week}, {"day", &req.Day}}
+	for _, f := range fields {
+		if v := query.Get(f.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				h.logger.Warn("invalid query parameter", f.name, v)
+				h.errorJSON(w, f.name+" must be an integer", http.StatusBadRequest)
+				return
+			}
+			*f.value = n
+		}
+	}
+	leapMonth, err := parseBoolQuery(query, "leap_month", false)
+	if err != nil {
+		h.logger.Warn("invalid query parameter", "leap_month", query.Get("leap_month"))
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.LeapMonth = leapMonth
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var response *model.CalendarConvertResponse
+	if req.HasDate() {
+		days, err := req.SourceDays()
+		if err != nil {
+			h.logger.Warn("invalid date", "calendar", req.From, "error", err)
+			h.errorJSON(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response = model.NewCalendarConvertResponse(&req, days, nil, "")
+	} else {
+		z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+		if err != nil {
+			h.zoneError(w, err)
+			return
+		}
+		t, err := timeparse.Parse(req.Time, z.TZ, time.Now())
+		if err != nil {
+			h.logger.Warn("invalid time", "time", req.Time, "error", err)
+			h.errorJSON(w, "invalid time: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		t = t.In(z.TZ)
+		response = model.NewCalendarConvertResponse(&req, calendar.DaysOf(t), &t, z.Location)
+	}
+
+	h.logger.Debug("calendar date converted",
+		"from", req.From,
+		"gregorian", response.Gregorian,
+		"count", len(response.Dates),
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestConvertCalendar(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.CalendarConvertResponse)
+	}{
+		{
+			name:           "gregorian date to all calendars",
+			query:          "date=2025-10-16",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				if resp.Gregorian != "2025-10-16" || resp.Weekday != "Thursday" || resp.Time != nil {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if len(resp.Dates) != 7 || resp.Dates[3].Formatted != "24 Tishrei 5786" {
+					t.Errorf("unexpected dates: %+v", resp.Dates)
+				}
+			},
+		},
+		{
+			name:           "japanese era fields",
+			query:          "from=japanese&era=Heisei&year=31&month=4&day=30&to=gregorian,chinese",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				if resp.Gregorian != "2019-04-30" || resp.Source.Formatted != "平成31年4月30日" || len(resp.Dates) != 2 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "current date of a saved location",
+			query:          "time=2025-10-16T20:00:00Z&timezone=tokyo&to=iso-week",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				// 05:00 the next morning in Tokyo
+				if resp.Location != "tokyo" || resp.Gregorian != "2025-10-17" || resp.Time.LocalTime != "2025-10-17 05:00:00" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Dates[0].Week != 42 || resp.Dates[0].Day != 5 {
+					t.Errorf("unexpected iso week date: %+v", resp.Dates[0])
+				}
+			},
+		},
+		{
+			name:           "chinese leap month",
+			query:          "from=chinese&date=2025-6-1&leap_month=true&to=gregorian",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				if resp.Gregorian != "2025-07-25" || !resp.Source.LeapMonth || resp.Source.MonthName != "闰六月" {
+					t.Errorf("unexpected response: %+v", resp.Source)
+				}
+			},
+		},
+		{
+			name:           "invalid year",
+			query:          "year=twenty&month=1&day=1",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "year must be an integer",
+		},
+		{
+			name:           "invalid leap_month",
+			query:          "from=chinese&date=2025-6-1&leap_month=maybe",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "leap_month must be true or false",
+		},
+		{
+			name:           "date with timezone",
+			query:          "date=2025-10-16&timezone=UTC",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrCalendarTimeConflict.Error(),
+		},
+		{
+			name:           "missing leap month",
+			query:          "from=chinese&date=2024-6-1&leap_month=true",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "invalid date: chinese leap month 6, 2024 has no such month",
+		},
+		{
+			name:           "unknown timezone",
+			query:          "timezone=Mars/Olympus",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"tokyo": "Asia/Tokyo"}),
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/calendars/convert?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ConvertCalendar(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.CalendarConvertResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
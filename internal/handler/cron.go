@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// CronPreview handles GET /api/cron/preview
+func (h *TimeHandler) CronPreview(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.CronPreviewRequest{
+		Expression: query.Get("expression"),
+		Timezone:   query.Get("timezone"),
+		From:       query.Get("from"),
+		Direction:  query.Get("direction"),
+	}
+	if v := query.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.logger.Warn("invalid query parameter", "count", v)
+			h.errorJSON(w, "count must be an integer", http.StatusBadRequest)
+			return
+		}
+		req.Count = n
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	from, err := timeparse.Parse(req.From, z.TZ, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid from time", "from", req.From, "error", err)
+		h.errorJSON(w, "invalid from: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	from = from.In(z.TZ)
+
+	schedule, preview := req.Preview(from, z.TZ)
+	response := model.NewCronPreviewResponse(&req, schedule, preview, from, z.Location)
+
+	h.logger.Debug("cron previewed",
+		"expression", response.Normalized,
+		"timezone", response.Timezone,
+		"direction", response.Direction,
+		"count", response.Count,
+		"skipped", len(response.Skipped),
+	)
+
+	h.json(w, response, http.StatusOK)
+}
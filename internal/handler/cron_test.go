@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestCronPreview(t *testing.T) {
+	locations := map[string]string{"new-york": "America/New_York"}
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.CronPreviewResponse)
+	}{
+		{
+			name:           "saved location across spring forward",
+			query:          "expression=30+2+*+*+*&timezone=new-york&from=2025-03-08T12:00:00&count=2",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.CronPreviewResponse) {
+				if resp.Location != "new-york" || resp.Timezone != "America/New_York" {
+					t.Errorf("unexpected zone: %s %s", resp.Location, resp.Timezone)
+				}
+				if resp.Count != 2 || resp.Runs[0].Time != "2025-03-10T02:30:00-04:00" {
+					t.Errorf("unexpected runs: %+v", resp.Runs)
+				}
+				if len(resp.Skipped) != 1 || resp.Skipped[0] != "2025-03-09 02:30:00" {
+					t.Errorf("expected 9 March 02:30 to be skipped, got %v", resp.Skipped)
+				}
+			},
+		},
+		{
+			name:           "previous runs with macro",
+			query:          "expression=@daily&from=2025-06-10T12:00:00Z&count=2&direction=previous",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.CronPreviewResponse) {
+				if resp.Normalized != "0 0 * * *" || resp.Direction != "previous" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Count != 2 || resp.Runs[0].UTC != "2025-06-10T00:00:00Z" || resp.Runs[1].UTC != "2025-06-09T00:00:00Z" {
+					t.Errorf("unexpected runs: %+v", resp.Runs)
+				}
+			},
+		},
+		{
+			name:           "missing expression",
+			query:          "timezone=UTC",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyCronExpression.Error(),
+		},
+		{
+			name:           "invalid expression",
+			query:          "expression=61+*+*+*+*",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid count",
+			query:          "expression=@hourly&count=many",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "count must be an integer",
+		},
+		{
+			name:           "unknown location",
+			query:          "expression=@hourly&timezone=atlantis",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid from",
+			query:          "expression=@hourly&from=yesterday-ish",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{getByNameFunc: newLocationLookup(locations)}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/cron/preview?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.CronPreview(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.CronPreviewResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
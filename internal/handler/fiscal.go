@@ -0,0 +1,287 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// FiscalHandler handles fiscal calendar HTTP requests
+type FiscalHandler struct {
+	calendars repository.FiscalCalendarRepository
+	resolver  *zone.Resolver
+	logger    *slog.Logger
+}
+
+// NewFiscalHandler creates a new fiscal calendar handler
+func NewFiscalHandler(locations repository.LocationRepository, calendars repository.FiscalCalendarRepository, logger *slog.Logger) *FiscalHandler {
+	return &FiscalHandler{
+		calendars: calendars,
+		resolver:  zone.NewResolver(locations),
+		logger:    logger,
+	}
+}
+
+// ListCalendars handles GET /api/fiscal/calendars
+func (h *FiscalHandler) ListCalendars(w http.ResponseWriter, r *http.Request) {
+	calendars, err := h.calendars.List(r.Context())
+	if err != nil {
+		h.logger.Error("failed to list fiscal calendars", "error", err)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Debug("fiscal calendars listed", "count", len(calendars))
+	h.json(w, model.NewFiscalCalendarListResponse(calendars), http.StatusOK)
+}
+
+// CreateCalendar handles POST /api/fiscal/calendars
+func (h *FiscalHandler) CreateCalendar(w http.ResponseWriter, r *http.Request) {
+	cal, ok := h.decodeCalendar(w, r, "")
+	if !ok {
+		return
+	}
+
+	if err := h.calendars.Create(r.Context(), cal); err != nil {
+		h.repoError(w, err, "failed to create fiscal calendar", cal.Name)
+		return
+	}
+
+	h.logger.Info("fiscal calendar created",
+		"name", cal.Name,
+		"start_month", cal.StartMonth,
+		"pattern", cal.Pattern,
+	)
+
+	h.json(w, cal, http.StatusCreated)
+}
+
+// GetCalendar handles GET /api/fiscal/calendars/{name}
+func (h *FiscalHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Calendar name is required", http.StatusBadRequest)
+		return
+	}
+
+	cal, err := h.calendars.GetByName(r.Context(), name)
+	if err != nil {
+		h.repoError(w, err, "failed to get fiscal calendar", name)
+		return
+	}
+
+	h.logger.Debug("fiscal calendar retrieved", "name", name)
+	h.json(w, cal, http.StatusOK)
+}
+
+// UpdateCalendar handles PUT /api/fiscal/calendars/{name}.
+// The body replaces every setting of the calendar except its name.
+func (h *FiscalHandler) UpdateCalendar(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Calendar name is required", http.StatusBadRequest)
+		return
+	}
+
+	cal, ok := h.decodeCalendar(w, r, name)
+	if !ok {
+		return
+	}
+
+	if err := h.calendars.Update(r.Context(), name, cal); err != nil {
+		h.repoError(w, err, "failed to update fiscal calendar", name)
+		return
+	}
+
+	h.logger.Info("fiscal calendar updated",
+		"name", name,
+		"start_month", cal.StartMonth,
+		"pattern", cal.Pattern,
+	)
+
+	h.json(w, cal, http.StatusOK)
+}
+
+// DeleteCalendar handles DELETE /api/fiscal/calendars/{name}
+func (h *FiscalHandler) DeleteCalendar(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Calendar name is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.calendars.Delete(r.Context(), name); err != nil {
+		h.repoError(w, err, "failed to delete fiscal calendar", name)
+		return
+	}
+
+	h.logger.Info("fiscal calendar deleted", "name", name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// FiscalDate handles GET /api/fiscal/calendars/{name}/date?time=&timezone=
+func (h *FiscalHandler) FiscalDate(w http.ResponseWriter, r *http.Request) {
+	req := model.FiscalDateRequest{
+		Calendar: r.PathValue("name"),
+		Time:     r.URL.Query().Get("time"),
+		Timezone: r.URL.Query().Get("timezone"),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cal, err := h.calendars.GetByName(r.Context(), req.Calendar)
+	if err != nil {
+		h.repoError(w, err, "failed to get fiscal calendar", req.Calendar)
+		return
+	}
+
+	z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	t, err := timeparse.Parse(req.Time, z.TZ, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid time", "time", req.Time, "error", err)
+		h.errorJSON(w, "invalid time: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NewFiscalDateResponse(cal, t.In(z.TZ), z.Location)
+
+	h.logger.Debug("fiscal date computed",
+		"calendar", cal.Name,
+		"timezone", z.Name,
+		"label", response.Label,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// FiscalPeriods handles GET /api/fiscal/calendars/{name}/periods?year=&timezone=
+func (h *FiscalHandler) FiscalPeriods(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.FiscalPeriodsRequest{
+		Calendar: r.PathValue("name"),
+		Timezone: query.Get("timezone"),
+	}
+	if v := query.Get("year"); v != "" {
+		year, err := strconv.Atoi(v)
+		if err != nil {
+			h.logger.Warn("invalid query parameter", "year", v)
+			h.errorJSON(w, "year must be an integer", http.StatusBadRequest)
+			return
+		}
+		req.Year = year
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cal, err := h.calendars.GetByName(r.Context(), req.Calendar)
+	if err != nil {
+		h.repoError(w, err, "failed to get fiscal calendar", req.Calendar)
+		return
+	}
+
+	year := req.Year
+	if year == 0 {
+		z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+		if err != nil {
+			h.zoneError(w, err)
+			return
+		}
+		year = cal.Calendar().YearOf(time.Now().In(z.TZ))
+	}
+
+	response := model.NewFiscalPeriodsResponse(cal, year)
+
+	h.logger.Debug("fiscal periods listed",
+		"calendar", cal.Name,
+		"year", year,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// decodeCalendar decodes, normalizes and validates a fiscal calendar body,
+// writing an error response if it is invalid. A non-empty name replaces the
+// name in the body.
+func (h *FiscalHandler) decodeCalendar(w http.ResponseWriter, r *http.Request, name string) (*model.FiscalCalendar, bool) {
+	var cal model.FiscalCalendar
+	if err := json.NewDecoder(r.Body).Decode(&cal); err != nil {
+		h.logger.Warn("invalid request body", "error", err)
+		h.errorJSON(w, "Invalid request body", http.StatusBadRequest)
+		return nil, false
+	}
+	if name != "" {
+		cal.Name = name
+	}
+
+	cal.Normalize()
+	if err := cal.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &cal, true
+}
+
+// repoError writes the response for a repository error
+func (h *FiscalHandler) repoError(w http.ResponseWriter, err error, msg, name string) {
+	switch {
+	case errors.Is(err, repository.ErrFiscalCalendarNotFound):
+		h.logger.Debug("fiscal calendar not found", "name", name)
+		h.errorJSON(w, "Fiscal calendar not found", http.StatusNotFound)
+	case errors.Is(err, repository.ErrFiscalCalendarExists):
+		h.logger.Warn("fiscal calendar already exists", "name", name)
+		h.errorJSON(w, "Fiscal calendar already exists", http.StatusConflict)
+	default:
+		h.logger.Error(msg, "error", err, "name", name)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// zoneError writes the response for a timezone resolution error
+func (h *FiscalHandler) zoneError(w http.ResponseWriter, err error) {
+	if zone.IsClientError(err) {
+		h.logger.Warn("invalid timezone or location", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.logger.Error("failed to resolve timezone", "error", err)
+	h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+}
+
+// json sends a JSON response
+func (h *FiscalHandler) json(w http.ResponseWriter, data interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("json encode error", "error", err)
+	}
+}
+
+// errorJSON sends an error JSON response
+func (h *FiscalHandler) errorJSON(w http.ResponseWriter, message string, status int) {
+	h.json(w, map[string]string{"error": message}, status)
+}
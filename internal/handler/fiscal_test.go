@@ -0,0 +1,336 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// mockFiscalCalendarRepository is an in-memory FiscalCalendarRepository for testing
+type mockFiscalCalendarRepository struct {
+	calendars map[string]*model.FiscalCalendar
+}
+
+func newMockFiscalRepository(calendars ...*model.FiscalCalendar) *mockFiscalCalendarRepository {
+	m := &mockFiscalCalendarRepository{calendars: make(map[string]*model.FiscalCalendar)}
+	for _, cal := range calendars {
+		m.calendars[cal.Name] = cal
+	}
+	return m
+}
+
+func (m *mockFiscalCalendarRepository) Create(ctx context.Context, cal *model.FiscalCalendar) error {
+	if _, ok := m.calendars[cal.Name]; ok {
+		return repository.ErrFiscalCalendarExists
+	}
+	m.calendars[cal.Name] = cal
+	return nil
+}
+
+func (m *mockFiscalCalendarRepository) GetByName(ctx context.Context, name string) (*model.FiscalCalendar, error) {
+	if cal, ok := m.calendars[strings.ToLower(name)]; ok {
+		return cal, nil
+	}
+	return nil, repository.ErrFiscalCalendarNotFound
+}
+
+func (m *mockFiscalCalendarRepository) Update(ctx context.Context, name string, cal *model.FiscalCalendar) error {
+	if _, ok := m.calendars[strings.ToLower(name)]; !ok {
+		return repository.ErrFiscalCalendarNotFound
+	}
+	m.calendars[strings.ToLower(name)] = cal
+	return nil
+}
+
+func (m *mockFiscalCalendarRepository) Delete(ctx context.Context, name string) error {
+	if _, ok := m.calendars[strings.ToLower(name)]; !ok {
+		return repository.ErrFiscalCalendarNotFound
+	}
+	delete(m.calendars, strings.ToLower(name))
+	return nil
+}
+
+func (m *mockFiscalCalendarRepository) List(ctx context.Context) ([]*model.FiscalCalendar, error) {
+	calendars := []*model.FiscalCalendar{}
+	for _, cal := range m.calendars {
+		calendars = append(calendars, cal)
+	}
+	return calendars, nil
+}
+
+// testFiscalCalendars returns a UK tax-style monthly calendar and an NRF-style 4-5-4 calendar
+func testFiscalCalendars() []*model.FiscalCalendar {
+	return []*model.FiscalCalendar{
+		{Name: "uk", StartMonth: 4, Pattern: "monthly", WeekStart: "monday", YearLabel: "start"},
+		{Name: "retail", StartMonth: 2, Pattern: "4-5-4", WeekStart: "sunday", YearEnd: "nearest", YearLabel: "start"},
+	}
+}
+
+func TestCreateFiscalCalendar(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, cal *model.FiscalCalendar)
+	}{
+		{
+			name:           "defaults",
+			requestBody:    `{"name": "Apple", "start_month": 10, "pattern": "5-4-4"}`,
+			expectedStatus: http.StatusCreated,
+			checkResponse: func(t *testing.T, cal *model.FiscalCalendar) {
+				if cal.Name != "apple" || cal.WeekStart != "monday" || cal.YearEnd != "last" || cal.YearLabel != "end" {
+					t.Errorf("unexpected calendar: %+v", cal)
+				}
+			},
+		},
+		{
+			name:           "invalid pattern",
+			requestBody:    `{"name": "odd", "start_month": 1, "pattern": "4-4-4"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "pattern must be monthly, 4-4-5, 4-5-4 or 5-4-4: 4-4-4",
+		},
+		{
+			name:           "year end on a monthly calendar",
+			requestBody:    `{"name": "odd", "start_month": 1, "year_end": "nearest"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrFiscalYearEndMonthly.Error(),
+		},
+		{
+			name:           "duplicate",
+			requestBody:    `{"name": "UK", "start_month": 4}`,
+			expectedStatus: http.StatusConflict,
+			expectedError:  "Fiscal calendar already exists",
+		},
+		{
+			name:           "invalid body",
+			requestBody:    `{"name": `,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid request body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewFiscalHandler(&mockLocationRepository{}, newMockFiscalRepository(testFiscalCalendars()...), newTestLogger())
+
+			req := httptest.NewRequest(http.MethodPost, "/api/fiscal/calendars", strings.NewReader(tt.requestBody))
+			w := httptest.NewRecorder()
+
+			handler.CreateCalendar(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+				return
+			}
+
+			var cal model.FiscalCalendar
+			if err := json.NewDecoder(w.Body).Decode(&cal); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			tt.checkResponse(t, &cal)
+		})
+	}
+}
+
+func TestUpdateFiscalCalendar(t *testing.T) {
+	repo := newMockFiscalRepository(testFiscalCalendars()...)
+	handler := NewFiscalHandler(&mockLocationRepository{}, repo, newTestLogger())
+
+	req := httptest.NewRequest(http.MethodPut, "/api/fiscal/calendars/UK", strings.NewReader(`{"name": "ignored", "start_month": 7}`))
+	req.SetPathValue("name", "UK")
+	w := httptest.NewRecorder()
+	handler.UpdateCalendar(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if cal := repo.calendars["uk"]; cal.StartMonth != 7 || cal.Name != "uk" || cal.YearLabel != "end" {
+		t.Errorf("unexpected calendar after update: %+v", cal)
+	}
+
+	req = httptest.NewRequest(http.MethodDelete, "/api/fiscal/calendars/missing", nil)
+	req.SetPathValue("name", "missing")
+	w = httptest.NewRecorder()
+	handler.DeleteCalendar(w, req)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404 deleting a missing calendar, got %d", w.Code)
+	}
+}
+
+func TestFiscalDate(t *testing.T) {
+	locations := &mockLocationRepository{
+		getByNameFunc: newLocationLookup(map[string]string{"london": "Europe/London"}),
+	}
+
+	tests := []struct {
+		name           string
+		calendar       string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.FiscalDateResponse)
+	}{
+		{
+			name:           "monthly calendar in a saved location",
+			calendar:       "uk",
+			query:          "?time=2025-10-16T09:00:00Z&timezone=london",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.FiscalDateResponse) {
+				if resp.Label != "FY2025 Q3 P7 W29" || resp.Time.Location != "london" || resp.Date != "2025-10-16" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.QuarterSpan.Start != "2025-10-01" || resp.QuarterSpan.End != "2025-12-31" || resp.QuarterSpan.Weeks != 0 {
+					t.Errorf("unexpected quarter: %+v", resp.QuarterSpan)
+				}
+				if resp.WeekSpan.Start != "2025-10-13" || resp.WeekSpan.End != "2025-10-19" || resp.WeekSpan.Days != 7 {
+					t.Errorf("unexpected week: %+v", resp.WeekSpan)
+				}
+			},
+		},
+		{
+			name:           "local date decides the fiscal year",
+			calendar:       "retail",
+			query:          "?time=2025-02-02T03:00:00Z&timezone=America/New_York",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.FiscalDateResponse) {
+				// Still Saturday 1 February in New York, the last day of FY2024
+				if resp.FiscalYear != 2024 || resp.Week != 52 || resp.DayOfYear != 364 || resp.Year.End != "2025-02-01" || resp.PeriodSpan.Weeks != 4 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "unknown calendar",
+			calendar:       "missing",
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Fiscal calendar not found",
+		},
+		{
+			name:           "unknown timezone",
+			calendar:       "uk",
+			query:          "?timezone=paris",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid time",
+			calendar:       "uk",
+			query:          "?time=yesterday-ish",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewFiscalHandler(locations, newMockFiscalRepository(testFiscalCalendars()...), newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/fiscal/calendars/"+tt.calendar+"/date"+tt.query, nil)
+			req.SetPathValue("name", tt.calendar)
+			w := httptest.NewRecorder()
+
+			handler.FiscalDate(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				if tt.expectedError != "" && !strings.Contains(w.Body.String(), tt.expectedError) {
+					t.Errorf("expected error '%s', got %s", tt.expectedError, w.Body.String())
+				}
+				return
+			}
+
+			var resp model.FiscalDateResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			tt.checkResponse(t, &resp)
+		})
+	}
+}
+
+func TestFiscalPeriods(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		checkResponse  func(t *testing.T, resp *model.FiscalPeriodsResponse)
+	}{
+		{
+			name:           "53-week year",
+			query:          "?year=2023",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.FiscalPeriodsResponse) {
+				if resp.Year.Start != "2023-01-29" || resp.Year.End != "2024-02-03" || resp.Year.Weeks != 53 {
+					t.Errorf("unexpected year: %+v", resp.Year)
+				}
+				if len(resp.Periods) != 12 || resp.Periods[11].Weeks != 5 || resp.Periods[11].Quarter != 4 {
+					t.Errorf("unexpected periods: %+v", resp.Periods)
+				}
+				if q := resp.Quarters[3]; q.Quarter != 4 || q.Weeks != 14 || q.End != "2024-02-03" {
+					t.Errorf("unexpected fourth quarter: %+v", q)
+				}
+			},
+		},
+		{
+			name:           "current year",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.FiscalPeriodsResponse) {
+				if resp.FiscalYear < 2025 || len(resp.Quarters) != 4 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "year out of range",
+			query:          "?year=1066",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "year not a number",
+			query:          "?year=next",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewFiscalHandler(&mockLocationRepository{}, newMockFiscalRepository(testFiscalCalendars()...), newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/fiscal/calendars/retail/periods"+tt.query, nil)
+			req.SetPathValue("name", "retail")
+			w := httptest.NewRecorder()
+
+			handler.FiscalPeriods(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			var resp model.FiscalPeriodsResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			tt.checkResponse(t, &resp)
+		})
+	}
+}
@@ -2,32 +2,118 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"reflect"
 	"time"
 
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
 	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
 )
 
 // Handler handles HTTP requests
 type Handler struct {
 	logger        *slog.Logger
 	mcpHTTPServer http.Handler
+	repo          repository.LocationRepository
+	resolver      *zone.Resolver
 }
 
-// New creates a new handler with MCP support
-func New(log *slog.Logger, mcpHTTPServer http.Handler) *Handler {
+// New creates a new handler with MCP support. repo resolves the saved
+// locations GetTime accepts and may be nil.
+func New(log *slog.Logger, mcpHTTPServer http.Handler, repo repository.LocationRepository) *Handler {
 	return &Handler{
 		logger:        log,
 		mcpHTTPServer: mcpHTTPServer,
+		repo:          repo,
+		resolver:      zone.NewResolver(repo),
 	}
 }
 
-// GetTime returns the current server time
+// GetTime returns the time now, or at the at query parameter, in the tz
+// timezone or the saved location's timezone, defaulting to server-local time
 func (h *Handler) GetTime(w http.ResponseWriter, r *http.Request) {
-	now := time.Now()
-	response := model.NewTimeResponse(now)
+	query := r.URL.Query()
+	req := model.TimeRequest{
+		Timezone: query.Get("tz"),
+		Location: query.Get("location"),
+		At:       query.Get("at"),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tz := time.Local
+	switch {
+	case req.Timezone != "":
+		z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+		if err != nil {
+			if zone.IsClientError(err) {
+				h.logger.Warn("invalid timezone or location", "error", err)
+				h.error(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			h.logger.Error("failed to resolve timezone", "error", err, "tz", req.Timezone)
+			h.error(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		tz = z.TZ
+		req.Location = z.Location
+	case req.Location != "":
+		// Only saved locations, never IANA names, so a location named like a zone is found
+		var loc *model.Location
+		err := repository.ErrLocationNotFound
+		if h.repo != nil {
+			loc, err = h.repo.GetByName(r.Context(), req.Location)
+		}
+		if err != nil {
+			if errors.Is(err, repository.ErrLocationNotFound) {
+				h.logger.Debug("location not found", "name", req.Location)
+				h.error(w, http.StatusNotFound, "Location not found")
+				return
+			}
+			h.logger.Error("failed to get location", "error", err, "name", req.Location)
+			h.error(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		if tz, err = time.LoadLocation(loc.Timezone); err != nil {
+			h.logger.Error("failed to load timezone", "error", err, "timezone", loc.Timezone)
+			h.error(w, http.StatusInternalServerError, "Internal server error")
+			return
+		}
+		req.Location = loc.Name
+	}
+
+	t, err := timeparse.Parse(req.At, tz, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid time", "at", req.At, "error", err)
+		h.error(w, http.StatusBadRequest, fmt.Sprintf("invalid at: %v", err))
+		return
+	}
+	t = t.In(tz)
+
+	lt, err := formatForRequest(w, r, t)
+	if err != nil {
+		h.logger.Warn("invalid format", "query", r.URL.RawQuery, "error", err)
+		h.error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response := model.NewTimeResponse(t)
+	if tz != time.Local {
+		response.Timezone = tz.String()
+	}
+	response.Location = req.Location
+	response.Formatted = lt.Formatted
+	response.Locale = lt.Locale
+	response.Localized = lt.Localized
 
 	h.logger.Info("time request",
 		"remote_addr", r.RemoteAddr,
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/yourorg/timeservice/internal/testutil"
@@ -13,7 +16,7 @@ import (
 func TestGetTime(t *testing.T) {
 	logger, logHandler := testutil.NewTestLogger()
 	mcpServer := &testutil.MockMCPServer{}
-	h := New(logger, mcpServer)
+	h := New(logger, mcpServer, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/time", nil)
 	w := httptest.NewRecorder()
@@ -53,10 +56,303 @@ func TestGetTime(t *testing.T) {
 	logHandler.AssertInfoCount(t, 1)
 }
 
+func TestGetTimeFormat(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         string
+		expectedStatus int
+		check          func(t *testing.T, resp *model.TimeResponse)
+	}{
+		{
+			name:           "unix preset",
+			format:         "unix",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if resp.Formatted != strconv.FormatInt(resp.UnixTime, 10) {
+					t.Errorf("expected formatted %d, got %s", resp.UnixTime, resp.Formatted)
+				}
+			},
+		},
+		{
+			name:           "strftime pattern",
+			format:         "%Y",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if len(resp.Formatted) != 4 || !strings.HasPrefix(resp.CurrentTime, resp.Formatted) {
+					t.Errorf("expected the year of %s, got %s", resp.CurrentTime, resp.Formatted)
+				}
+			},
+		},
+		{
+			name:           "invalid pattern",
+			format:         "%Q",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			h := New(logger, &testutil.MockMCPServer{}, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time?format="+url.QueryEscape(tt.format), nil)
+			w := httptest.NewRecorder()
+
+			h.GetTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.check != nil {
+				var resp model.TimeResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.check(t, &resp)
+			}
+		})
+	}
+}
+
+func TestGetTimeQuery(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		check          func(t *testing.T, resp *model.TimeResponse)
+	}{
+		{
+			name:           "timezone at an instant",
+			query:          "tz=America/New_York&at=2025-01-15T15:00:00Z",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if resp.CurrentTime != "2025-01-15T10:00:00-05:00" || resp.Timezone != "America/New_York" {
+					t.Errorf("unexpected time %s in %s", resp.CurrentTime, resp.Timezone)
+				}
+				if resp.OffsetSeconds != -5*3600 || resp.Offset != "-05:00" || resp.Abbreviation != "EST" || resp.IsDST {
+					t.Errorf("unexpected offset: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "saved location with a local time",
+			query:          "location=tokyo&at=2025-06-10+09:00&format=%25H:%25M+%25Z",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if resp.Location != "tokyo" || resp.Timezone != "Asia/Tokyo" || resp.UnixTime != 1749513600 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Formatted != "09:00 JST" {
+					t.Errorf("expected formatted 09:00 JST, got %s", resp.Formatted)
+				}
+			},
+		},
+		{
+			name:           "daylight saving time",
+			query:          "tz=Europe/Berlin&at=1749580215",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if !resp.IsDST || resp.Abbreviation != "CEST" || resp.OffsetSeconds != 7200 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "saved location as tz",
+			query:          "tz=tokyo&at=2025-01-15T15:00:00Z",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if resp.Location != "tokyo" || resp.Timezone != "Asia/Tokyo" || resp.CurrentTime != "2025-01-16T00:00:00+09:00" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "both tz and location",
+			query:          "tz=UTC&location=tokyo",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid timezone",
+			query:          "tz=Mars/Olympus",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid location name",
+			query:          "location=bad%20name!",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown location",
+			query:          "location=paris",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "location named like a zone is not a zone",
+			query:          "location=UTC",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "saved location named like a zone",
+			query:          "location=Japan&at=2025-01-15T15:00:00Z",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if resp.Location != "Japan" || resp.Timezone != "Europe/Lisbon" || resp.CurrentTime != "2025-01-15T15:00:00Z" {
+					t.Errorf("expected the saved location's timezone, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "server local zone",
+			query:          "tz=Local",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid instant",
+			query:          "at=whenever",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			repo := &mockLocationRepository{getByNameFunc: newLocationLookup(map[string]string{"tokyo": "Asia/Tokyo", "Japan": "Europe/Lisbon"})}
+			h := New(logger, &testutil.MockMCPServer{}, repo)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.GetTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.check != nil {
+				var resp model.TimeResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.check(t, &resp)
+			}
+		})
+	}
+}
+
+func TestGetTimeLocale(t *testing.T) {
+	tests := []struct {
+		name             string
+		query            string
+		acceptLanguage   string
+		expectedStatus   int
+		expectedLocale   string
+		expectedLanguage string
+		check            func(t *testing.T, resp *model.TimeResponse)
+	}{
+		{
+			name:           "no locale",
+			query:          "",
+			expectedStatus: http.StatusOK,
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if resp.Localized != "" {
+					t.Errorf("expected no localized time, got %s", resp.Localized)
+				}
+			},
+		},
+		{
+			name:             "locale parameter",
+			query:            "locale=de&style=date_long",
+			expectedStatus:   http.StatusOK,
+			expectedLocale:   "de-DE",
+			expectedLanguage: "de-DE",
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if !strings.Contains(resp.Localized, ". ") || strings.Contains(resp.Localized, ":") {
+					t.Errorf("expected a German long date, got %s", resp.Localized)
+				}
+			},
+		},
+		{
+			name:             "accept-language header",
+			acceptLanguage:   "xx, fr-CA;q=0.9, en;q=0.5",
+			expectedStatus:   http.StatusOK,
+			expectedLocale:   "fr-FR",
+			expectedLanguage: "fr-FR",
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if !strings.Contains(resp.Localized, " à ") {
+					t.Errorf("expected a French long time, got %s", resp.Localized)
+				}
+			},
+		},
+		{
+			name:             "parameter overrides header",
+			query:            "locale=ja-JP&format=%25B",
+			acceptLanguage:   "fr",
+			expectedStatus:   http.StatusOK,
+			expectedLocale:   "ja-JP",
+			expectedLanguage: "ja-JP",
+			check: func(t *testing.T, resp *model.TimeResponse) {
+				if !strings.HasSuffix(resp.Formatted, "月") {
+					t.Errorf("expected a Japanese month name, got %s", resp.Formatted)
+				}
+			},
+		},
+		{
+			name:           "unsupported header is ignored",
+			acceptLanguage: "xx",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unsupported locale parameter",
+			query:          "locale=xx",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid style",
+			query:          "locale=en&style=tiny",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			h := New(logger, &testutil.MockMCPServer{}, nil)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time?"+tt.query, nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+
+			h.GetTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Content-Language"); got != tt.expectedLanguage {
+				t.Errorf("expected Content-Language %q, got %q", tt.expectedLanguage, got)
+			}
+			var resp model.TimeResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Locale != tt.expectedLocale {
+				t.Errorf("expected locale %q, got %q", tt.expectedLocale, resp.Locale)
+			}
+			if tt.check != nil {
+				tt.check(t, &resp)
+			}
+		})
+	}
+}
+
 func TestHealth(t *testing.T) {
 	logger, _ := testutil.NewTestLogger()
 	mcpServer := &testutil.MockMCPServer{}
-	h := New(logger, mcpServer)
+	h := New(logger, mcpServer, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/health", nil)
 	w := httptest.NewRecorder()
@@ -107,7 +403,7 @@ func TestServiceInfo(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			logger, _ := testutil.NewTestLogger()
 			mcpServer := &testutil.MockMCPServer{}
-			h := New(logger, mcpServer)
+			h := New(logger, mcpServer, nil)
 
 			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
 			w := httptest.NewRecorder()
@@ -186,7 +482,7 @@ func TestMCP(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			logger, _ := testutil.NewTestLogger()
-			h := New(logger, tt.mcpServer)
+			h := New(logger, tt.mcpServer, nil)
 
 			req := httptest.NewRequest(tt.method, "/mcp", nil)
 			w := httptest.NewRecorder()
@@ -223,7 +519,7 @@ func TestMCP(t *testing.T) {
 func TestHandlerJSONHelpers(t *testing.T) {
 	t.Run("json helper", func(t *testing.T) {
 		logger, _ := testutil.NewTestLogger()
-		h := New(logger, nil)
+		h := New(logger, nil, nil)
 
 		w := httptest.NewRecorder()
 		data := map[string]string{"key": "value"}
@@ -250,7 +546,7 @@ func TestHandlerJSONHelpers(t *testing.T) {
 
 	t.Run("error helper", func(t *testing.T) {
 		logger, _ := testutil.NewTestLogger()
-		h := New(logger, nil)
+		h := New(logger, nil, nil)
 
 		w := httptest.NewRecorder()
 
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// HolidayHandler handles holiday calendar and custom holiday HTTP requests
+type HolidayHandler struct {
+	locations repository.LocationRepository
+	holidays  repository.HolidayRepository
+	logger    *slog.Logger
+}
+
+// NewHolidayHandler creates a new holiday handler
+func NewHolidayHandler(locations repository.LocationRepository, holidays repository.HolidayRepository, logger *slog.Logger) *HolidayHandler {
+	return &HolidayHandler{
+		locations: locations,
+		holidays:  holidays,
+		logger:    logger,
+	}
+}
+
+// ListCalendars handles GET /api/holidays/calendars
+func (h *HolidayHandler) ListCalendars(w http.ResponseWriter, r *http.Request) {
+	calendars, err := h.holidays.ListCalendars(r.Context())
+	if err != nil {
+		h.logger.Error("failed to list holiday calendars", "error", err)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Debug("holiday calendars listed", "count", len(calendars))
+	h.json(w, model.NewCalendarListResponse(calendars), http.StatusOK)
+}
+
+// GetLocationCalendars handles GET /api/locations/{name}/calendars
+func (h *HolidayHandler) GetLocationCalendars(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	calendars, err := h.holidays.LocationCalendars(r.Context(), name)
+	if err != nil {
+		h.repoError(w, err, "failed to get location calendars", name)
+		return
+	}
+
+	h.logger.Debug("location calendars retrieved", "name", name, "count", len(calendars))
+	h.json(w, &model.LocationCalendarsResponse{Location: name, Calendars: calendars}, http.StatusOK)
+}
+
+// SetLocationCalendars handles PUT /api/locations/{name}/calendars
+func (h *HolidayHandler) SetLocationCalendars(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	var req model.LocationCalendarsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", "error", err)
+		h.errorJSON(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.holidays.SetLocationCalendars(r.Context(), name, req.Calendars); err != nil {
+		h.repoError(w, err, "failed to set location calendars", name)
+		return
+	}
+
+	h.logger.Info("location calendars updated", "name", name, "calendars", req.Calendars)
+	h.json(w, &model.LocationCalendarsResponse{Location: name, Calendars: req.Calendars}, http.StatusOK)
+}
+
+// ListHolidays handles GET /api/locations/{name}/holidays?from=&to=
+func (h *HolidayHandler) ListHolidays(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	loc, err := h.locations.GetByName(r.Context(), name)
+	if err != nil {
+		h.repoError(w, err, "failed to get location", name)
+		return
+	}
+
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		h.logger.Error("failed to load timezone", "error", err, "timezone", loc.Timezone)
+		h.errorJSON(w, "Invalid timezone", http.StatusInternalServerError)
+		return
+	}
+
+	req := model.HolidayRangeRequest{
+		From: r.URL.Query().Get("from"),
+		To:   r.URL.Query().Get("to"),
+	}
+	req.Normalize()
+	from, to, err := req.Range(time.Now().In(tz))
+	if err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	holidays, err := h.holidays.ListHolidays(r.Context(), loc.Name, from, to)
+	if err != nil {
+		h.repoError(w, err, "failed to list holidays", name)
+		return
+	}
+
+	h.logger.Debug("holidays listed",
+		"name", name,
+		"from", from,
+		"to", to,
+		"count", len(holidays),
+	)
+
+	h.json(w, model.NewHolidayListResponse(loc, from, to, holidays), http.StatusOK)
+}
+
+// CreateHoliday handles POST /api/locations/{name}/holidays
+func (h *HolidayHandler) CreateHoliday(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	req, ok := h.decodeHoliday(w, r)
+	if !ok {
+		return
+	}
+
+	holiday := req.ToHoliday()
+	if err := h.holidays.CreateCustomHoliday(r.Context(), name, holiday); err != nil {
+		h.repoError(w, err, "failed to create holiday", name)
+		return
+	}
+
+	h.logger.Info("holiday created",
+		"name", name,
+		"id", holiday.ID,
+		"date", holiday.Date,
+	)
+
+	h.json(w, holiday, http.StatusCreated)
+}
+
+// UpdateHoliday handles PUT /api/locations/{name}/holidays/{id}
+func (h *HolidayHandler) UpdateHoliday(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	id, ok := h.holidayID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeHoliday(w, r)
+	if !ok {
+		return
+	}
+
+	holiday := req.ToHoliday()
+	if err := h.holidays.UpdateCustomHoliday(r.Context(), name, id, holiday); err != nil {
+		h.repoError(w, err, "failed to update holiday", name)
+		return
+	}
+
+	h.logger.Info("holiday updated",
+		"name", name,
+		"id", id,
+		"date", holiday.Date,
+	)
+
+	h.json(w, holiday, http.StatusOK)
+}
+
+// DeleteHoliday handles DELETE /api/locations/{name}/holidays/{id}
+func (h *HolidayHandler) DeleteHoliday(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	id, ok := h.holidayID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.holidays.DeleteCustomHoliday(r.Context(), name, id); err != nil {
+		h.repoError(w, err, "failed to delete holiday", name)
+		return
+	}
+
+	h.logger.Info("holiday deleted", "name", name, "id", id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeHoliday decodes and validates a custom holiday request body,
+// writing an error response if it is invalid
+func (h *HolidayHandler) decodeHoliday(w http.ResponseWriter, r *http.Request) (*model.HolidayRequest, bool) {
+	var req model.HolidayRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", "error", err)
+		h.errorJSON(w, "Invalid request body", http.StatusBadRequest)
+		return nil, false
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &req, true
+}
+
+// holidayID parses the holiday ID path value, writing an error response if it is invalid
+func (h *HolidayHandler) holidayID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id <= 0 {
+		h.errorJSON(w, "Invalid holiday ID", http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// repoError writes the response for a repository error
+func (h *HolidayHandler) repoError(w http.ResponseWriter, err error, msg, name string) {
+	switch {
+	case errors.Is(err, repository.ErrLocationNotFound):
+		h.logger.Debug("location not found", "name", name)
+		h.errorJSON(w, "Location not found", http.StatusNotFound)
+	case errors.Is(err, repository.ErrCalendarNotFound):
+		h.logger.Warn("holiday calendar not found", "name", name, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, repository.ErrHolidayNotFound):
+		h.logger.Debug("holiday not found", "name", name)
+		h.errorJSON(w, "Holiday not found", http.StatusNotFound)
+	case errors.Is(err, repository.ErrHolidayExists):
+		h.logger.Warn("holiday already exists", "name", name)
+		h.errorJSON(w, "Holiday already exists", http.StatusConflict)
+	default:
+		h.logger.Error(msg, "error", err, "name", name)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
+// json sends a JSON response
+func (h *HolidayHandler) json(w http.ResponseWriter, data interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("json encode error", "error", err)
+	}
+}
+
+// errorJSON sends an error JSON response
+func (h *HolidayHandler) errorJSON(w http.ResponseWriter, message string, status int) {
+	h.json(w, map[string]string{"error": message}, status)
+}
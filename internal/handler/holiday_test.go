@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// mockHolidayRepository is a mock implementation of HolidayRepository for testing
+type mockHolidayRepository struct {
+	listCalendarsFunc        func(ctx context.Context) ([]*model.HolidayCalendar, error)
+	locationCalendarsFunc    func(ctx context.Context, location string) ([]string, error)
+	setLocationCalendarsFunc func(ctx context.Context, location string, calendars []string) error
+	listHolidaysFunc         func(ctx context.Context, location, from, to string) ([]*model.Holiday, error)
+	createFunc               func(ctx context.Context, location string, h *model.Holiday) error
+	updateFunc               func(ctx context.Context, location string, id int64, h *model.Holiday) error
+	deleteFunc               func(ctx context.Context, location string, id int64) error
+}
+
+func (m *mockHolidayRepository) ImportCalendar(ctx context.Context, cal *model.HolidayCalendar) error {
+	return nil
+}
+
+func (m *mockHolidayRepository) ListCalendars(ctx context.Context) ([]*model.HolidayCalendar, error) {
+	if m.listCalendarsFunc != nil {
+		return m.listCalendarsFunc(ctx)
+	}
+	return []*model.HolidayCalendar{}, nil
+}
+
+func (m *mockHolidayRepository) LocationCalendars(ctx context.Context, location string) ([]string, error) {
+	if m.locationCalendarsFunc != nil {
+		return m.locationCalendarsFunc(ctx, location)
+	}
+	return []string{}, nil
+}
+
+func (m *mockHolidayRepository) SetLocationCalendars(ctx context.Context, location string, calendars []string) error {
+	if m.setLocationCalendarsFunc != nil {
+		return m.setLocationCalendarsFunc(ctx, location, calendars)
+	}
+	return nil
+}
+
+func (m *mockHolidayRepository) ListHolidays(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+	if m.listHolidaysFunc != nil {
+		return m.listHolidaysFunc(ctx, location, from, to)
+	}
+	return []*model.Holiday{}, nil
+}
+
+func (m *mockHolidayRepository) CreateCustomHoliday(ctx context.Context, location string, h *model.Holiday) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, location, h)
+	}
+	return nil
+}
+
+func (m *mockHolidayRepository) UpdateCustomHoliday(ctx context.Context, location string, id int64, h *model.Holiday) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, location, id, h)
+	}
+	return nil
+}
+
+func (m *mockHolidayRepository) DeleteCustomHoliday(ctx context.Context, location string, id int64) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, location, id)
+	}
+	return nil
+}
+
+func TestListHolidays(t *testing.T) {
+	locations := &mockLocationRepository{
+		getByNameFunc: newLocationLookup(map[string]string{"london": "Europe/London"}),
+	}
+
+	tests := []struct {
+		name           string
+		location       string
+		query          string
+		expectedStatus int
+		expectedError  string
+		expectedFrom   string
+		expectedTo     string
+	}{
+		{
+			name:           "explicit range",
+			location:       "london",
+			query:          "?from=2025-12-01&to=2025-12-31",
+			expectedStatus: http.StatusOK,
+			expectedFrom:   "2025-12-01",
+			expectedTo:     "2025-12-31",
+		},
+		{
+			name:           "from only defaults to one year",
+			location:       "london",
+			query:          "?from=2025-01-01",
+			expectedStatus: http.StatusOK,
+			expectedFrom:   "2025-01-01",
+			expectedTo:     "2026-01-01",
+		},
+		{
+			name:           "reversed range",
+			location:       "london",
+			query:          "?from=2025-12-31&to=2025-12-01",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrHolidayRangeOrder.Error(),
+		},
+		{
+			name:           "location not found",
+			location:       "nowhere",
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Location not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays := &mockHolidayRepository{
+				listHolidaysFunc: func(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+					return []*model.Holiday{
+						{ID: 1, Date: "2025-12-25", Name: "Christmas Day", Calendar: "uk-bank"},
+					}, nil
+				},
+			}
+			handler := NewHolidayHandler(locations, holidays, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/locations/"+tt.location+"/holidays"+tt.query, nil)
+			req.SetPathValue("name", tt.location)
+			w := httptest.NewRecorder()
+
+			handler.ListHolidays(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+				return
+			}
+
+			var resp model.HolidayListResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.From != tt.expectedFrom || resp.To != tt.expectedTo {
+				t.Errorf("expected range %s..%s, got %s..%s", tt.expectedFrom, tt.expectedTo, resp.From, resp.To)
+			}
+			if resp.Count != 1 || resp.Timezone != "Europe/London" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestSetLocationCalendars(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		repoErr        error
+		expectedStatus int
+		expectedError  string
+		expectedNames  []string
+	}{
+		{
+			name:           "attach calendars",
+			requestBody:    `{"calendars": ["UK-Bank", "company", "uk-bank"]}`,
+			expectedStatus: http.StatusOK,
+			expectedNames:  []string{"uk-bank", "company"},
+		},
+		{
+			name:           "invalid calendar name",
+			requestBody:    `{"calendars": ["uk bank"]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown calendar",
+			requestBody:    `{"calendars": ["missing"]}`,
+			repoErr:        fmt.Errorf("%w: missing", repository.ErrCalendarNotFound),
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "holiday calendar not found: missing",
+		},
+		{
+			name:           "location not found",
+			requestBody:    `{"calendars": []}`,
+			repoErr:        repository.ErrLocationNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Location not found",
+		},
+		{
+			name:           "invalid body",
+			requestBody:    `{`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid request body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			holidays := &mockHolidayRepository{
+				setLocationCalendarsFunc: func(ctx context.Context, location string, calendars []string) error {
+					got = calendars
+					return tt.repoErr
+				},
+			}
+			handler := NewHolidayHandler(&mockLocationRepository{}, holidays, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodPut, "/api/locations/london/calendars", strings.NewReader(tt.requestBody))
+			req.SetPathValue("name", "london")
+			w := httptest.NewRecorder()
+
+			handler.SetLocationCalendars(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+			if tt.expectedNames != nil && strings.Join(got, ",") != strings.Join(tt.expectedNames, ",") {
+				t.Errorf("expected calendars %v, got %v", tt.expectedNames, got)
+			}
+		})
+	}
+}
+
+func TestCustomHolidayEndpoints(t *testing.T) {
+	holidays := &mockHolidayRepository{
+		createFunc: func(ctx context.Context, location string, h *model.Holiday) error {
+			if h.Date == "2025-08-01" {
+				return repository.ErrHolidayExists
+			}
+			h.ID = 7
+			return nil
+		},
+		updateFunc: func(ctx context.Context, location string, id int64, h *model.Holiday) error {
+			if id != 7 {
+				return repository.ErrHolidayNotFound
+			}
+			h.ID = id
+			return nil
+		},
+		deleteFunc: func(ctx context.Context, location string, id int64) error {
+			if id != 7 {
+				return repository.ErrHolidayNotFound
+			}
+			return nil
+		},
+	}
+	handler := NewHolidayHandler(&mockLocationRepository{}, holidays, newTestLogger())
+
+	tests := []struct {
+		name           string
+		method         string
+		id             string
+		body           string
+		serve          http.HandlerFunc
+		expectedStatus int
+	}{
+		{"create", http.MethodPost, "", `{"date": "2025-08-08", "name": "Summer party"}`, handler.CreateHoliday, http.StatusCreated},
+		{"create duplicate", http.MethodPost, "", `{"date": "2025-08-01", "name": "Summer party"}`, handler.CreateHoliday, http.StatusConflict},
+		{"create invalid date", http.MethodPost, "", `{"date": "08/08/2025", "name": "Summer party"}`, handler.CreateHoliday, http.StatusBadRequest},
+		{"update", http.MethodPut, "7", `{"date": "2025-08-15", "name": "Summer party"}`, handler.UpdateHoliday, http.StatusOK},
+		{"update missing", http.MethodPut, "8", `{"date": "2025-08-15", "name": "Summer party"}`, handler.UpdateHoliday, http.StatusNotFound},
+		{"update invalid id", http.MethodPut, "abc", `{"date": "2025-08-15", "name": "Summer party"}`, handler.UpdateHoliday, http.StatusBadRequest},
+		{"delete", http.MethodDelete, "7", "", handler.DeleteHoliday, http.StatusNoContent},
+		{"delete missing", http.MethodDelete, "8", "", handler.DeleteHoliday, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/locations/london/holidays/"+tt.id, strings.NewReader(tt.body))
+			req.SetPathValue("name", "london")
+			req.SetPathValue("id", tt.id)
+			w := httptest.NewRecorder()
+
+			tt.serve(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if w.Code == http.StatusCreated || w.Code == http.StatusOK {
+				var resp model.Holiday
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if resp.ID != 7 || !resp.Custom {
+					t.Errorf("unexpected holiday: %+v", resp)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// Intervals handles POST /api/intervals
+func (h *TimeHandler) Intervals(w http.ResponseWriter, r *http.Request) {
+	var req model.IntervalsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", "error", err)
+		h.errorJSON(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resolved := make(map[string]*zone.Zone)
+	zones := make(map[string]*time.Location)
+	for _, name := range req.ZoneNames() {
+		z, err := h.resolver.Resolve(r.Context(), name)
+		if err != nil {
+			h.zoneError(w, err)
+			return
+		}
+		resolved[name] = z
+		zones[name] = z.TZ
+	}
+
+	sets, window, err := req.Parse(zones, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid interval", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	outputs := make([]model.IntervalZone, len(req.Zones))
+	for i, name := range req.Zones {
+		outputs[i] = model.IntervalZone{Name: name, Location: resolved[name].Location, TZ: resolved[name].TZ}
+	}
+	response := model.NewIntervalsResponse(req.Operation, req.Apply(sets, window), window, outputs)
+
+	h.logger.Debug("intervals combined",
+		"operation", req.Operation,
+		"sets", len(req.Sets),
+		"count", response.Count,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestIntervals(t *testing.T) {
+	locations := map[string]string{"new-york": "America/New_York"}
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.IntervalsResponse)
+	}{
+		{
+			name: "intersection of local working days",
+			body: `{"operation":"intersection","sets":[
+				{"name":"london","timezone":"Europe/London","intervals":[{"start":"2025-01-06 09:00","end":"2025-01-06 17:00"}]},
+				{"name":"ny","timezone":"new-york","intervals":[{"start":"2025-01-06 09:00","end":"2025-01-06 17:00"}]}]}`,
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.IntervalsResponse) {
+				if resp.Count != 1 || resp.Intervals[0].Start != "2025-01-06T14:00:00Z" || resp.Intervals[0].End != "2025-01-06T17:00:00Z" {
+					t.Fatalf("unexpected intervals: %+v", resp.Intervals)
+				}
+				local := resp.Intervals[0].Local
+				if len(local) != 2 || local[1].Location != "new-york" || local[1].Start != "2025-01-06T09:00:00-05:00" {
+					t.Errorf("unexpected local times: %+v", local[1])
+				}
+			},
+		},
+		{
+			name: "gaps in a window",
+			body: `{"operation":"gaps","window":{"start":"2025-01-06T08:00:00Z","end":"2025-01-06T12:00:00Z"},"zones":["UTC"],"sets":[
+				{"intervals":[{"start":"2025-01-06T09:00:00Z","end":"2025-01-06T10:00:00Z"},{"start":"2025-01-06T09:30:00Z","end":"2025-01-06T11:00:00Z"}]}]}`,
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.IntervalsResponse) {
+				if resp.Count != 2 || resp.TotalSeconds != 7200 || resp.WindowStart != "2025-01-06T08:00:00Z" {
+					t.Errorf("unexpected gaps: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "invalid body",
+			body:           `{"operation":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid request body",
+		},
+		{
+			name:           "gaps without window",
+			body:           `{"operation":"gaps","sets":[{"intervals":[]}]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrGapsWindow.Error(),
+		},
+		{
+			name:           "reversed interval",
+			body:           `{"operation":"union","sets":[{"intervals":[{"start":"2025-01-07","end":"2025-01-06"}]}]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "sets[0].intervals[0]: interval end must be after start",
+		},
+		{
+			name:           "unknown zone",
+			body:           `{"operation":"union","sets":[{"timezone":"atlantis","intervals":[]}]}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unknown timezone or location: atlantis",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{getByNameFunc: newLocationLookup(locations)}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodPost, "/api/intervals", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			handler.Intervals(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.IntervalsResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
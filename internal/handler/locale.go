@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timefmt"
+)
+
+// localizedTime is an instant formatted for one request
+type localizedTime struct {
+	// Formatted follows the format query parameter
+	Formatted string
+	// Locale and Localized are set when the request selects a bundled locale;
+	// Localized follows the style query parameter
+	Locale    string
+	Localized string
+}
+
+// formatForRequest formats t as the request asks. The locale comes from the
+// locale query parameter or, failing that, the Accept-Language header; an
+// unsupported locale parameter is an error while an unsupported header is
+// ignored. It sets the Vary and Content-Language headers on w.
+func formatForRequest(w http.ResponseWriter, r *http.Request, t time.Time) (*localizedTime, error) {
+	query := r.URL.Query()
+	w.Header().Add("Vary", "Accept-Language")
+
+	var locale *timefmt.Locale
+	if tag := query.Get("locale"); tag != "" {
+		l, err := timefmt.ParseLocale(tag)
+		if err != nil {
+			return nil, err
+		}
+		locale = l
+	} else if l, ok := timefmt.NegotiateLocale(r.Header.Get("Accept-Language")); ok {
+		locale = l
+	}
+
+	formatted, err := locale.Format(t, query.Get("format"))
+	if err != nil {
+		return nil, err
+	}
+	result := &localizedTime{Formatted: formatted}
+	if locale == nil {
+		return result, nil
+	}
+
+	style := query.Get("style")
+	if style == "" {
+		style = timefmt.DefaultStyle
+	}
+	if !timefmt.IsStyle(style) {
+		return nil, fmt.Errorf("invalid style %q (supported: %s)", style, strings.Join(timefmt.Styles(), ", "))
+	}
+	result.Locale = locale.Tag
+	result.Localized, _ = locale.Format(t, style)
+	w.Header().Set("Content-Language", locale.Tag)
+	return result, nil
+}
@@ -8,20 +8,23 @@ import (
 	"time"
 
 	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
 	"github.com/yourorg/timeservice/pkg/model"
 )
 
 // LocationHandler handles location-related HTTP requests
 type LocationHandler struct {
-	repo   repository.LocationRepository
-	logger *slog.Logger
+	repo     repository.LocationRepository
+	resolver *zone.Resolver
+	logger   *slog.Logger
 }
 
 // NewLocationHandler creates a new location handler
 func NewLocationHandler(repo repository.LocationRepository, logger *slog.Logger) *LocationHandler {
 	return &LocationHandler{
-		repo:   repo,
-		logger: logger,
+		repo:     repo,
+		resolver: zone.NewResolver(repo),
+		logger:   logger,
 	}
 }
 
@@ -44,6 +47,10 @@ func (h *LocationHandler) CreateLocation(w http.ResponseWriter, r *http.Request)
 
 	// Create location model
 	loc := model.NewLocation(req.Name, req.Timezone, req.Description)
+	loc.BusinessHours = req.BusinessHours
+	loc.Weekend = req.Weekend
+	loc.Tags = req.Tags
+	loc.Coordinates = req.Coordinates
 
 	// Create in repository
 	if err := h.repo.Create(r.Context(), loc); err != nil {
@@ -130,8 +137,30 @@ func (h *LocationHandler) UpdateLocation(w http.ResponseWriter, r *http.Request)
 	if req.Timezone != "" {
 		existing.Timezone = req.Timezone
 	}
-	// Always update description (even if empty string to allow clearing)
-	existing.Description = req.Description
+	// Replace the description when provided; an empty string clears it
+	if req.Description != nil {
+		existing.Description = *req.Description
+	}
+	// Replace business hours when provided; an empty schedule clears them
+	if req.BusinessHours != nil {
+		existing.BusinessHours = req.BusinessHours
+		if req.BusinessHours.IsEmpty() {
+			existing.BusinessHours = nil
+		}
+	}
+	if req.Weekend != nil {
+		existing.Weekend = req.Weekend
+	}
+	// Replace tags when provided; an empty list clears them
+	if req.Tags != nil {
+		existing.Tags = req.Tags
+	}
+	if req.Coordinates != nil {
+		existing.Coordinates = req.Coordinates
+	}
+	if req.ClearCoordinates {
+		existing.Coordinates = nil
+	}
 	existing.UpdatedAt = time.Now().UTC()
 
 	// Update in repository
@@ -220,13 +249,38 @@ func (h *LocationHandler) GetLocationTime(w http.ResponseWriter, r *http.Request
 
 	// Get current time in the location's timezone
 	now := time.Now().In(tz)
+	lt, err := formatForRequest(w, r, now)
+	if err != nil {
+		h.logger.Warn("invalid format", "query", r.URL.RawQuery, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	response := &model.LocationTimeResponse{
 		Location:    loc.Name,
 		Timezone:    loc.Timezone,
 		CurrentTime: now,
 		UnixTime:    now.Unix(),
-		Formatted:   now.Format(time.RFC3339),
+		Formatted:   lt.Formatted,
+		Locale:      lt.Locale,
+		Localized:   lt.Localized,
+	}
+
+	// Describe the location's offset relative to the caller's zone
+	if relativeTo := r.URL.Query().Get("relative_to"); relativeTo != "" {
+		caller, err := h.resolver.Resolve(r.Context(), relativeTo)
+		if err != nil {
+			if zone.IsClientError(err) {
+				h.logger.Warn("invalid timezone or location", "relative_to", relativeTo, "error", err)
+				h.errorJSON(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			h.logger.Error("failed to resolve timezone", "relative_to", relativeTo, "error", err)
+			h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+			return
+		}
+		response.RelativeTo = relativeTo
+		response.RelativeOffset = model.RelativeOffset(now, caller.TZ)
 	}
 
 	h.logger.Debug("location time retrieved",
@@ -238,6 +292,152 @@ func (h *LocationHandler) GetLocationTime(w http.ResponseWriter, r *http.Request
 	h.json(w, response, http.StatusOK)
 }
 
+// GetLocationTransitions handles GET /api/locations/{name}/transitions
+func (h *LocationHandler) GetLocationTransitions(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	loc, err := h.repo.GetByName(r.Context(), name)
+	if err != nil {
+		if errors.Is(err, repository.ErrLocationNotFound) {
+			h.logger.Debug("location not found", "name", name)
+			h.errorJSON(w, "Location not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("failed to get location", "error", err, "name", name)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Load the timezone
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		h.logger.Error("failed to load timezone", "error", err, "timezone", loc.Timezone)
+		h.errorJSON(w, "Invalid timezone", http.StatusInternalServerError)
+		return
+	}
+
+	query := r.URL.Query()
+	req := model.TransitionsRequest{
+		Zone:  loc.Name,
+		Start: query.Get("start"),
+		End:   query.Get("end"),
+	}
+	req.Normalize()
+
+	start, end, err := req.Range(tz, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid range", "start", req.Start, "end", req.End, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NewTransitionsResponse(tz, loc.Name, start, end)
+
+	h.logger.Debug("location transitions listed",
+		"name", name,
+		"timezone", loc.Timezone,
+		"count", len(response.Transitions),
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// GetLocationStatus handles GET /api/locations/{name}/status
+func (h *LocationHandler) GetLocationStatus(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	loc, err := h.repo.GetByName(r.Context(), name)
+	if err != nil {
+		if errors.Is(err, repository.ErrLocationNotFound) {
+			h.logger.Debug("location not found", "name", name)
+			h.errorJSON(w, "Location not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("failed to get location", "error", err, "name", name)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Load the timezone
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		h.logger.Error("failed to load timezone", "error", err, "timezone", loc.Timezone)
+		h.errorJSON(w, "Invalid timezone", http.StatusInternalServerError)
+		return
+	}
+
+	response := model.NewLocationStatusResponse(loc, tz, time.Now())
+
+	h.logger.Debug("location status retrieved",
+		"name", name,
+		"timezone", loc.Timezone,
+		"status", response.Status,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// GetLocationSun handles GET /api/locations/{name}/sun
+func (h *LocationHandler) GetLocationSun(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if name == "" {
+		h.errorJSON(w, "Location name is required", http.StatusBadRequest)
+		return
+	}
+
+	loc, err := h.repo.GetByName(r.Context(), name)
+	if err != nil {
+		if errors.Is(err, repository.ErrLocationNotFound) {
+			h.logger.Debug("location not found", "name", name)
+			h.errorJSON(w, "Location not found", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("failed to get location", "error", err, "name", name)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	// Load the timezone
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		h.logger.Error("failed to load timezone", "error", err, "timezone", loc.Timezone)
+		h.errorJSON(w, "Invalid timezone", http.StatusInternalServerError)
+		return
+	}
+
+	dateParam := r.URL.Query().Get("date")
+	date, err := model.ParseSunDate(dateParam, tz, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid date", "date", dateParam, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := model.NewSunResponse(loc, tz, date)
+	if err != nil {
+		h.logger.Warn("sun times unavailable", "name", name, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("location sun times computed",
+		"name", name,
+		"date", response.Date,
+		"polar_day", response.PolarDay,
+		"polar_night", response.PolarNight,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
 // json sends a JSON response
 func (h *LocationHandler) json(w http.ResponseWriter, data interface{}, status int) {
 	w.Header().Set("Content-Type", "application/json")
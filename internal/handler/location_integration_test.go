@@ -175,7 +175,7 @@ func TestLocationIntegration_FullCRUDWorkflow(t *testing.T) {
 	t.Run("Update location", func(t *testing.T) {
 		reqBody := model.UpdateLocationRequest{
 			Timezone:    "America/Chicago",
-			Description: "Central Headquarters",
+			Description: ptr("Central Headquarters"),
 		}
 		body, _ := json.Marshal(reqBody)
 
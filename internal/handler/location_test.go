@@ -9,11 +9,13 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/yourorg/timeservice/internal/repository"
 	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timefmt"
 )
 
 // mockLocationRepository is a mock implementation of LocationRepository for testing
@@ -121,6 +123,35 @@ func TestCreateLocation(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "with business hours",
+			requestBody: model.CreateLocationRequest{
+				Name:     "shop",
+				Timezone: "Europe/Paris",
+				BusinessHours: &model.BusinessHours{Weekly: map[string][]model.OpeningInterval{
+					"Saturday": {{Open: "10:00", Close: "16:00"}},
+				}},
+			},
+			mockCreateFunc: func(ctx context.Context, loc *model.Location) error {
+				if loc.BusinessHours == nil || len(loc.BusinessHours.Weekly["saturday"]) != 1 {
+					t.Errorf("expected normalized business hours, got %+v", loc.BusinessHours)
+				}
+				return nil
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "invalid business hours",
+			requestBody: model.CreateLocationRequest{
+				Name:     "shop",
+				Timezone: "Europe/Paris",
+				BusinessHours: &model.BusinessHours{Weekly: map[string][]model.OpeningInterval{
+					"sat": {{Open: "10:00", Close: "16:00"}},
+				}},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "weekly keys must be weekday names such as monday: sat",
+		},
 		{
 			name: "duplicate location",
 			requestBody: model.CreateLocationRequest{
@@ -303,7 +334,7 @@ func TestUpdateLocation(t *testing.T) {
 			pathName: "hq",
 			requestBody: model.UpdateLocationRequest{
 				Timezone:    "America/Los_Angeles",
-				Description: "New description",
+				Description: ptr("New description"),
 			},
 			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
 				// Return a copy of existing location
@@ -327,6 +358,58 @@ func TestUpdateLocation(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:        "partial update keeps description",
+			pathName:    "hq",
+			requestBody: `{"timezone": "Europe/Paris"}`,
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				loc := *existingLocation
+				return &loc, nil
+			},
+			mockUpdateFunc: func(ctx context.Context, name string, loc *model.Location) error {
+				return nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body []byte) {
+				var resp model.LocationResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if resp.Timezone != "Europe/Paris" || resp.Description != "Old description" {
+					t.Errorf("expected Europe/Paris with the old description, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:        "clear description and coordinates",
+			pathName:    "hq",
+			requestBody: `{"description": "", "clear_coordinates": true}`,
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				loc := *existingLocation
+				loc.Coordinates = &model.Coordinates{Latitude: 40.7128, Longitude: -74.006}
+				return &loc, nil
+			},
+			mockUpdateFunc: func(ctx context.Context, name string, loc *model.Location) error {
+				return nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body []byte) {
+				var resp model.LocationResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if resp.Description != "" || resp.Coordinates != nil || resp.Timezone != "America/New_York" {
+					t.Errorf("expected description and coordinates cleared, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "coordinates and clear coordinates",
+			pathName:       "hq",
+			requestBody:    `{"coordinates": {"latitude": 1, "longitude": 2}, "clear_coordinates": true}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrCoordinatesCleared.Error(),
+		},
 		{
 			name:           "invalid JSON body",
 			pathName:       "hq",
@@ -585,6 +668,8 @@ func TestGetLocationTime(t *testing.T) {
 	tests := []struct {
 		name              string
 		pathName          string
+		query             string
+		acceptLanguage    string
 		mockGetByNameFunc func(ctx context.Context, name string) (*model.Location, error)
 		expectedStatus    int
 		expectedError     string
@@ -620,6 +705,116 @@ func TestGetLocationTime(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:     "strftime format",
+			pathName: "hq",
+			query:    "format=%25Y-W%25V-%25u",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "America/New_York"}, nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body []byte) {
+				var resp model.LocationTimeResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				want, _ := timefmt.Format(resp.CurrentTime, "%Y-W%V-%u")
+				if resp.Formatted != want {
+					t.Errorf("expected formatted %q, got %q", want, resp.Formatted)
+				}
+			},
+		},
+		{
+			name:     "invalid format",
+			pathName: "hq",
+			query:    "format=%25Q",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "America/New_York"}, nil
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "invalid time format: unknown conversion %Q",
+		},
+		{
+			name:     "locale parameter",
+			pathName: "hq",
+			query:    "locale=es&style=date_full",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "Asia/Tokyo"}, nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body []byte) {
+				var resp model.LocationTimeResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if resp.Locale != "es-ES" {
+					t.Errorf("expected locale es-ES, got %q", resp.Locale)
+				}
+				// The date is the one on the location's wall clock
+				l, _ := timefmt.ParseLocale("es")
+				want, _ := l.Format(resp.CurrentTime, "date_full")
+				if resp.Localized != want {
+					t.Errorf("expected localized %q, got %q", want, resp.Localized)
+				}
+			},
+		},
+		{
+			name:           "accept-language header",
+			pathName:       "hq",
+			acceptLanguage: "en-GB,en;q=0.9",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "Europe/London"}, nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body []byte) {
+				var resp model.LocationTimeResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if resp.Locale != "en-GB" || !strings.Contains(resp.Localized, " at ") {
+					t.Errorf("expected an en-GB long time, got %q in %q", resp.Localized, resp.Locale)
+				}
+				if resp.Formatted != resp.CurrentTime.Format(time.RFC3339) {
+					t.Errorf("expected formatted to stay RFC 3339, got %s", resp.Formatted)
+				}
+			},
+		},
+		{
+			name:     "relative to the caller's zone",
+			pathName: "hq",
+			query:    "relative_to=Asia/Kolkata",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "Asia/Tokyo"}, nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, body []byte) {
+				var resp model.LocationTimeResponse
+				if err := json.Unmarshal(body, &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if resp.RelativeTo != "Asia/Kolkata" || resp.RelativeOffset != "3 hours and 30 minutes ahead" {
+					t.Errorf("unexpected relative offset %q to %q", resp.RelativeOffset, resp.RelativeTo)
+				}
+			},
+		},
+		{
+			name:     "relative to an unknown zone",
+			pathName: "hq",
+			query:    "relative_to=Mars/Olympus",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "Asia/Tokyo"}, nil
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "unsupported locale",
+			pathName: "hq",
+			query:    "locale=tlh",
+			mockGetByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+				return &model.Location{ID: 1, Name: "hq", Timezone: "America/New_York"}, nil
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name:     "location not found",
 			pathName: "nonexistent",
@@ -651,8 +846,11 @@ func TestGetLocationTime(t *testing.T) {
 			}
 			handler := NewLocationHandler(mockRepo, newTestLogger())
 
-			req := httptest.NewRequest(http.MethodGet, "/api/locations/"+tt.pathName+"/time", nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/locations/"+tt.pathName+"/time?"+tt.query, nil)
 			req.SetPathValue("name", tt.pathName)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
 			w := httptest.NewRecorder()
 
 			handler.GetLocationTime(w, req)
@@ -677,3 +875,277 @@ func TestGetLocationTime(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLocationTransitions(t *testing.T) {
+	tests := []struct {
+		name              string
+		pathName          string
+		query             string
+		mockGetByNameFunc func(ctx context.Context, name string) (*model.Location, error)
+		expectedStatus    int
+		expectedError     string
+		checkResponse     func(t *testing.T, resp *model.TransitionsResponse)
+	}{
+		{
+			name:              "transitions in range",
+			pathName:          "hq",
+			query:             "start=2025-01-01&end=2026-01-01",
+			mockGetByNameFunc: newLocationLookup(map[string]string{"hq": "America/New_York"}),
+			expectedStatus:    http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TransitionsResponse) {
+				if resp.Location != "hq" || resp.Timezone != "America/New_York" {
+					t.Errorf("unexpected zone: %s (%s)", resp.Location, resp.Timezone)
+				}
+				if len(resp.Transitions) != 2 {
+					t.Fatalf("expected 2 transitions, got %d", len(resp.Transitions))
+				}
+				if resp.Transitions[1].Instant != "2025-11-02T06:00:00Z" || resp.Transitions[1].NewAbbreviation != "EST" {
+					t.Errorf("unexpected fall back transition: %+v", resp.Transitions[1])
+				}
+			},
+		},
+		{
+			name:              "zone without dst",
+			pathName:          "tokyo",
+			mockGetByNameFunc: newLocationLookup(map[string]string{"tokyo": "Asia/Tokyo"}),
+			expectedStatus:    http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TransitionsResponse) {
+				if resp.Transitions == nil || len(resp.Transitions) != 0 {
+					t.Errorf("expected empty transitions list, got %+v", resp.Transitions)
+				}
+			},
+		},
+		{
+			name:              "location not found",
+			pathName:          "nonexistent",
+			mockGetByNameFunc: newLocationLookup(nil),
+			expectedStatus:    http.StatusNotFound,
+			expectedError:     "Location not found",
+		},
+		{
+			name:              "reversed range",
+			pathName:          "hq",
+			query:             "start=2025-01-01&end=2024-01-01",
+			mockGetByNameFunc: newLocationLookup(map[string]string{"hq": "America/New_York"}),
+			expectedStatus:    http.StatusBadRequest,
+			expectedError:     model.ErrTransitionOrder.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: tt.mockGetByNameFunc,
+			}
+			handler := NewLocationHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/locations/"+tt.pathName+"/transitions?"+tt.query, nil)
+			req.SetPathValue("name", tt.pathName)
+			w := httptest.NewRecorder()
+
+			handler.GetLocationTransitions(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.TransitionsResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestGetLocationStatus(t *testing.T) {
+	allDay := []model.OpeningInterval{{Open: "00:00", Close: "24:00"}}
+	alwaysOpen := &model.BusinessHours{Weekly: map[string][]model.OpeningInterval{
+		"monday": allDay, "tuesday": allDay, "wednesday": allDay, "thursday": allDay,
+		"friday": allDay, "saturday": allDay, "sunday": allDay,
+	}}
+
+	tests := []struct {
+		name           string
+		location       *model.Location
+		expectedStatus int
+		expectedError  string
+		expectedOpen   string
+	}{
+		{
+			name:           "always open",
+			location:       &model.Location{Name: "store", Timezone: "Asia/Tokyo", BusinessHours: alwaysOpen},
+			expectedStatus: http.StatusOK,
+			expectedOpen:   model.StatusOpen,
+		},
+		{
+			name:           "no business hours",
+			location:       &model.Location{Name: "hq", Timezone: "America/New_York"},
+			expectedStatus: http.StatusOK,
+			expectedOpen:   model.StatusUnknown,
+		},
+		{
+			name:           "location not found",
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Location not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+					if tt.location == nil {
+						return nil, repository.ErrLocationNotFound
+					}
+					return tt.location, nil
+				},
+			}
+			handler := NewLocationHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/locations/store/status", nil)
+			req.SetPathValue("name", "store")
+			w := httptest.NewRecorder()
+
+			handler.GetLocationStatus(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+				return
+			}
+
+			var resp model.LocationStatusResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if resp.Status != tt.expectedOpen {
+				t.Errorf("expected status %s, got %s", tt.expectedOpen, resp.Status)
+			}
+			if resp.Timezone != tt.location.Timezone || resp.LocalTime == "" {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+		})
+	}
+}
+
+func TestGetLocationSun(t *testing.T) {
+	tromso := &model.Location{Name: "tromso", Timezone: "Europe/Oslo", Coordinates: &model.Coordinates{Latitude: 69.6492, Longitude: 18.9553}}
+
+	tests := []struct {
+		name           string
+		location       *model.Location
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.SunResponse)
+	}{
+		{
+			name:           "polar day",
+			location:       tromso,
+			query:          "?date=2025-06-21",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.SunResponse) {
+				if resp.Date != "2025-06-21" || !resp.PolarDay || resp.Sunrise != "" || resp.DayLengthSeconds != 86400 {
+					t.Errorf("expected polar day, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "defaults to today",
+			location:       &model.Location{Name: "london", Timezone: "Europe/London", Coordinates: &model.Coordinates{Latitude: 51.5074, Longitude: -0.1278}},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.SunResponse) {
+				london, _ := time.LoadLocation("Europe/London")
+				if today := time.Now().In(london).Format("2006-01-02"); resp.Date != today || resp.Sunrise == "" || resp.Sunset == "" {
+					t.Errorf("expected today's sunrise and sunset, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "invalid date",
+			location:       tromso,
+			query:          "?date=21/06/2025",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "date must be in YYYY-MM-DD format: 21/06/2025",
+		},
+		{
+			name:           "no coordinates",
+			location:       &model.Location{Name: "hq", Timezone: "America/New_York"},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrNoCoordinates.Error(),
+		},
+		{
+			name:           "location not found",
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "Location not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+					if tt.location == nil {
+						return nil, repository.ErrLocationNotFound
+					}
+					return tt.location, nil
+				},
+			}
+			handler := NewLocationHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/locations/tromso/sun"+tt.query, nil)
+			req.SetPathValue("name", "tromso")
+			w := httptest.NewRecorder()
+
+			handler.GetLocationSun(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, w.Code)
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+				return
+			}
+
+			var resp model.SunResponse
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			tt.checkResponse(t, &resp)
+		})
+	}
+}
+
+// ptr returns a pointer to v
+func ptr[T any](v T) *T {
+	return &v
+}
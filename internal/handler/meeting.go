@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// FindMeetingSlots handles POST /api/meetings/slots
+func (h *TimeHandler) FindMeetingSlots(w http.ResponseWriter, r *http.Request) {
+	var req model.MeetingSlotsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", "error", err)
+		h.errorJSON(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rangeZone, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	attendees := make([]model.MeetingAttendee, len(req.Locations))
+	for i, name := range req.Locations {
+		z, err := h.resolver.Resolve(r.Context(), name)
+		if err != nil {
+			h.zoneError(w, err)
+			return
+		}
+		attendees[i] = model.MeetingAttendee{Name: name, Location: z.Location, TZ: z.TZ}
+	}
+
+	from, to := req.Range(rangeZone.TZ)
+	response := model.NewMeetingSlotsResponse(&req, attendees, from, to)
+
+	h.logger.Debug("meeting slots found",
+		"locations", req.Locations,
+		"start", response.RangeStart,
+		"end", response.RangeEnd,
+		"candidates", response.TotalCandidates,
+		"count", response.Count,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestFindMeetingSlots(t *testing.T) {
+	locations := map[string]string{"london": "Europe/London", "new-york": "America/New_York"}
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.MeetingSlotsResponse)
+	}{
+		{
+			name:           "saved locations across a DST change",
+			body:           `{"locations":["london","new-york"],"start_date":"2025-03-10","duration_minutes":60,"max_results":3}`,
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.MeetingSlotsResponse) {
+				if resp.TotalCandidates != 7 || resp.Count != 3 {
+					t.Errorf("expected 3 of 7 candidates, got %d of %d", resp.Count, resp.TotalCandidates)
+				}
+				if resp.Slots[0].Start != "2025-03-10T14:30:00Z" {
+					t.Errorf("expected 14:30Z first, got %s", resp.Slots[0].Start)
+				}
+				if resp.Participants[1].Location != "new-york" || resp.Participants[1].Timezone != "America/New_York" {
+					t.Errorf("unexpected participant: %+v", resp.Participants[1])
+				}
+			},
+		},
+		{
+			name:           "per-location hours and IANA timezone",
+			body:           `{"locations":["london","Asia/Tokyo"],"start_date":"2025-06-02","location_hours":{"Asia/Tokyo":{"start":"14:00","end":"20:00"}}}`,
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.MeetingSlotsResponse) {
+				// Tokyo 14:00-20:00 JST and London 09:00-17:00 BST overlap 08:00-11:00Z
+				if resp.TotalCandidates != 6 {
+					t.Errorf("expected 6 candidates, got %d", resp.TotalCandidates)
+				}
+				if resp.Participants[1].WorkingHours.Start != "14:00" {
+					t.Errorf("expected Tokyo hours, got %+v", resp.Participants[1].WorkingHours)
+				}
+			},
+		},
+		{
+			name:           "invalid body",
+			body:           `{"locations":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid request body",
+		},
+		{
+			name:           "validation error",
+			body:           `{"locations":["london"],"start_date":"2025-03-10","step_minutes":7}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrInvalidMeetingStep.Error(),
+		},
+		{
+			name:           "unknown location",
+			body:           `{"locations":["london","atlantis"],"start_date":"2025-03-10"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{getByNameFunc: newLocationLookup(locations)}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodPost, "/api/meetings/slots", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			handler.FindMeetingSlots(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.MeetingSlotsResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
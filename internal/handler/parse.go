@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// ParseTime handles GET /api/time/parse
+func (h *TimeHandler) ParseTime(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.TimeParseRequest{
+		Value:    query.Get("value"),
+		Timezone: query.Get("timezone"),
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	result, err := timeparse.Detect(req.Value, z.TZ, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid time", "value", req.Value, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	response := model.NewTimeParseResponse(req.Value, z.TZ, z.Location, result)
+
+	h.logger.Debug("time parsed",
+		"value", req.Value,
+		"format", response.Format,
+		"utc", response.UTC,
+		"ambiguous", response.Ambiguous,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestParseTime(t *testing.T) {
+	locations := map[string]string{"new-york": "America/New_York"}
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.TimeParseResponse)
+	}{
+		{
+			name:           "http date",
+			query:          "value=" + url.QueryEscape("Tue, 10 Jun 2025 14:30:15 GMT"),
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimeParseResponse) {
+				if resp.Format != "rfc1123" || resp.UTC != "2025-06-10T14:30:15Z" || resp.Local || resp.Ambiguous {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "epoch milliseconds",
+			query:          "value=1749580215123&timezone=new-york",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimeParseResponse) {
+				if resp.Format != "unix_millis" || resp.Time != "2025-06-10T14:30:15.123-04:00" || resp.Location != "new-york" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "repeated local time",
+			query:          "value=" + url.QueryEscape("2025-11-02 01:30") + "&timezone=America/New_York",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimeParseResponse) {
+				if !resp.Local || !resp.Ambiguous || len(resp.Alternatives) != 1 {
+					t.Fatalf("expected an ambiguous local time, got %+v", resp)
+				}
+				if resp.Offset != "-04:00" || resp.Alternatives[0].Offset != "-05:00" {
+					t.Errorf("unexpected readings: %s and %s", resp.Offset, resp.Alternatives[0].Offset)
+				}
+			},
+		},
+		{
+			name:           "missing value",
+			query:          "timezone=UTC",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyParseValue.Error(),
+		},
+		{
+			name:           "unrecognized value",
+			query:          "value=next+tuesday",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `unrecognized time format: "next tuesday"`,
+		},
+		{
+			name:           "unknown location",
+			query:          "value=2025-06-10&timezone=atlantis",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{getByNameFunc: newLocationLookup(locations)}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/parse?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ParseTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.TimeParseResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// ExpandRecurrence handles POST /api/recurrence/expand
+func (h *TimeHandler) ExpandRecurrence(w http.ResponseWriter, r *http.Request) {
+	var req model.RecurrenceExpandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Warn("invalid request body", "error", err)
+		h.errorJSON(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	set := req.Set(z.TZ)
+	from, to := req.Window(z.TZ)
+	expansion, err := set.Expand(from, to, req.MaxResults)
+	if err != nil {
+		h.logger.Warn("recurrence expansion failed", "rrule", req.RRule, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	response := model.NewRecurrenceExpandResponse(set, z.Location, from, to, expansion)
+
+	h.logger.Debug("recurrence expanded",
+		"timezone", response.Timezone,
+		"rrule", response.RRule,
+		"count", response.Count,
+		"skipped", len(response.Skipped),
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestExpandRecurrence(t *testing.T) {
+	locations := map[string]string{"new-york": "America/New_York"}
+
+	tests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.RecurrenceExpandResponse)
+	}{
+		{
+			name:           "saved location across DST",
+			body:           `{"dtstart":"20250307T023000","timezone":"new-york","rrule":"FREQ=DAILY","to":"2025-03-11"}`,
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.RecurrenceExpandResponse) {
+				if resp.Location != "new-york" || resp.Timezone != "America/New_York" {
+					t.Errorf("unexpected zone: %s %s", resp.Location, resp.Timezone)
+				}
+				// 7, 8 and 10 March; 02:30 does not exist on 9 March
+				if resp.Count != 3 || resp.Occurrences[2].Time != "2025-03-10T02:30:00-04:00" {
+					t.Errorf("unexpected occurrences: %+v", resp.Occurrences)
+				}
+				if len(resp.Skipped) != 1 || resp.Truncated {
+					t.Errorf("expected one skipped time and no truncation, got %v %v", resp.Skipped, resp.Truncated)
+				}
+			},
+		},
+		{
+			name:           "rdates and exdates",
+			body:           `{"dtstart":"2025-06-02T10:00:00Z","rrule":"FREQ=WEEKLY;COUNT=3","rdate":["2025-06-04T10:00:00Z"],"exdate":["20250609T100000Z"]}`,
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.RecurrenceExpandResponse) {
+				if resp.Count != 3 || resp.Occurrences[1].Source != "rdate" || resp.Occurrences[2].Time != "2025-06-16T10:00:00Z" {
+					t.Errorf("unexpected occurrences: %+v", resp.Occurrences)
+				}
+			},
+		},
+		{
+			name:           "invalid body",
+			body:           `{"dtstart":`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "Invalid request body",
+		},
+		{
+			name:           "validation error",
+			body:           `{"dtstart":"20250307T090000"}`,
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyRecurrence.Error(),
+		},
+		{
+			name:           "unknown location",
+			body:           `{"dtstart":"20250307T090000","timezone":"atlantis","rrule":"FREQ=DAILY"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "rule that never matches",
+			body:           `{"dtstart":"20250101T090000","rrule":"FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{getByNameFunc: newLocationLookup(locations)}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodPost, "/api/recurrence/expand", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			handler.ExpandRecurrence(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.RecurrenceExpandResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
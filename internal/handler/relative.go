@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// RelativeTime handles GET /api/time/relative
+func (h *TimeHandler) RelativeTime(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.RelativeTimeRequest{
+		Time:      query.Get("time"),
+		Reference: query.Get("reference"),
+		Timezone:  query.Get("timezone"),
+	}
+	var err error
+	if req.Calendar, err = parseBoolQuery(query, "calendar", true); err == nil {
+		req.HumanizeOptions, err = parseHumanizeQuery(query)
+	}
+	if err != nil {
+		h.logger.Warn("invalid query parameter", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	z, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	now := time.Now()
+	t, err := timeparse.Parse(req.Time, z.TZ, now)
+	if err != nil {
+		h.logger.Warn("invalid time", "time", req.Time, "error", err)
+		h.errorJSON(w, "invalid time: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	ref, err := timeparse.Parse(req.Reference, z.TZ, now)
+	if err != nil {
+		h.logger.Warn("invalid reference", "reference", req.Reference, "error", err)
+		h.errorJSON(w, "invalid reference: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	response := model.NewRelativeTimeResponse(&req, t.In(z.TZ), ref.In(z.TZ), z.Location)
+
+	h.logger.Debug("relative time described",
+		"time", response.Time.Time,
+		"reference", response.Reference.Time,
+		"humanized", response.Humanized,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
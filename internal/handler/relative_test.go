@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestRelativeTime(t *testing.T) {
+	locations := map[string]string{"tokyo-office": "Asia/Tokyo"}
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.RelativeTimeResponse)
+	}{
+		{
+			name:           "calendar phrase in a saved location",
+			query:          "time=" + url.QueryEscape("2025-06-11 09:00") + "&reference=2025-06-10T06:00:00Z&timezone=tokyo-office",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "tomorrow at 09:00 tokyo-office time" || resp.Direction != model.RelativeFuture {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Seconds != 18*3600 || resp.Reference.Time != "2025-06-10T15:00:00+09:00" {
+					t.Errorf("unexpected times: %v seconds from %s", resp.Seconds, resp.Reference.Time)
+				}
+			},
+		},
+		{
+			name:           "relative phrase",
+			query:          "time=2025-06-10T12:00:00Z&reference=2025-06-12T15:20:00Z&calendar=false&precision=2",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "2 days and 3 hours ago" || resp.Direction != model.RelativePast {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "granularity",
+			query:          "time=2025-06-10T12:00:00Z&reference=2025-06-10T11:59:40Z&granularity=minutes",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "in less than a minute" {
+					t.Errorf("unexpected description %q", resp.Humanized)
+				}
+			},
+		},
+		{
+			name:           "defaults to now",
+			query:          "time=1000000000",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if !strings.HasSuffix(resp.Humanized, " years ago") {
+					t.Errorf("unexpected description %q", resp.Humanized)
+				}
+			},
+		},
+		{
+			name:           "missing time",
+			query:          "reference=now",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyRelativeTime.Error(),
+		},
+		{
+			name:           "invalid granularity",
+			query:          "time=now&granularity=fortnight",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid calendar",
+			query:          "time=now&calendar=often",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "calendar must be true or false",
+		},
+		{
+			name:           "invalid reference",
+			query:          "time=now&reference=someday",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `invalid reference: unrecognized time format: "someday"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{getByNameFunc: newLocationLookup(locations)}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/relative?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.RelativeTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.RelativeTimeResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
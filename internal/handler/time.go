@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// TimeHandler handles time calculation HTTP requests.
+// Anywhere a timezone is accepted, a saved location name may be used instead.
+type TimeHandler struct {
+	resolver *zone.Resolver
+	logger   *slog.Logger
+}
+
+// NewTimeHandler creates a new time calculation handler
+func NewTimeHandler(repo repository.LocationRepository, logger *slog.Logger) *TimeHandler {
+	return &TimeHandler{
+		resolver: zone.NewResolver(repo),
+		logger:   logger,
+	}
+}
+
+// ConvertTime handles GET /api/convert
+func (h *TimeHandler) ConvertTime(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.ConvertRequest{
+		Time: query.Get("time"),
+		From: query.Get("from"),
+		To:   query["to"],
+	}
+	var err error
+	if req.Humanize, err = parseBoolQuery(query, "humanize", false); err == nil {
+		req.HumanizeOptions, err = parseHumanizeQuery(query)
+	}
+	if err != nil {
+		h.logger.Warn("invalid query parameter", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	source, err := h.resolver.Resolve(r.Context(), req.From)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	now := time.Now()
+	instant, err := timeparse.Parse(req.Time, source.TZ, now)
+	if err != nil {
+		h.logger.Warn("invalid time", "time", req.Time, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	targets, err := h.resolver.ResolveAll(r.Context(), req.To)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	response := &model.ConvertResponse{
+		Instant:  instant.UTC().Format(time.RFC3339Nano),
+		UnixTime: instant.Unix(),
+		Source:   req.ZonedTime(instant, source.Location, now),
+		Targets:  make([]*model.ZonedTime, len(targets)),
+	}
+	for i, target := range targets {
+		response.Targets[i] = req.ZonedTime(instant.In(target.TZ), target.Location, now)
+	}
+
+	h.logger.Debug("time converted",
+		"from", req.From,
+		"to", req.To,
+		"instant", response.Instant,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// TimeDiff handles GET /api/time/diff
+func (h *TimeHandler) TimeDiff(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.DiffRequest{
+		Start:     query.Get("start"),
+		End:       query.Get("end"),
+		StartZone: query.Get("start_zone"),
+		EndZone:   query.Get("end_zone"),
+		Zone:      query.Get("zone"),
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	startZone, err := h.resolver.Resolve(r.Context(), req.StartZone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+	endZone, err := h.resolver.Resolve(r.Context(), req.EndZone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+	calendarZone, err := h.resolver.Resolve(r.Context(), req.Zone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	now := time.Now()
+	start, err := timeparse.Parse(req.Start, startZone.TZ, now)
+	if err != nil {
+		h.logger.Warn("invalid start time", "start", req.Start, "error", err)
+		h.errorJSON(w, "invalid start: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	end, err := timeparse.Parse(req.End, endZone.TZ, now)
+	if err != nil {
+		h.logger.Warn("invalid end time", "end", req.End, "error", err)
+		h.errorJSON(w, "invalid end: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NewDiffResponse(start, end, startZone.Location, endZone.Location, calendarZone.TZ)
+
+	h.logger.Debug("time difference calculated",
+		"start", response.Start.Time,
+		"end", response.End.Time,
+		"zone", response.Zone,
+		"iso8601", response.ISO8601,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// AddTime handles GET /api/time/add
+func (h *TimeHandler) AddTime(w http.ResponseWriter, r *http.Request) {
+	req, err := parseAddTimeQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Warn("invalid query parameter", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	target, err := h.resolver.Resolve(r.Context(), req.Timezone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	base, err := timeparse.Parse(req.Base, target.TZ, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid base time", "base", req.Base, "error", err)
+		h.errorJSON(w, "invalid base: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result := req.Apply(base, target.TZ)
+	response := model.NewAddTimeResponse(base, result, target.Location, req.Overflow)
+
+	h.logger.Debug("time offset applied",
+		"base", response.Base.Time,
+		"result", response.Result.Time,
+		"timezone", req.Timezone,
+		"overflow", req.Overflow,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// parseAddTimeQuery builds an AddTimeRequest from query parameters
+func parseAddTimeQuery(query url.Values) (model.AddTimeRequest, error) {
+	req := model.AddTimeRequest{
+		Base:     query.Get("base"),
+		Timezone: query.Get("timezone"),
+		Duration: query.Get("duration"),
+		Overflow: query.Get("overflow"),
+	}
+
+	ints := []struct {
+		name string
+		dst  *int
+	}{
+		{"years", &req.Years},
+		{"months", &req.Months},
+		{"weeks", &req.Weeks},
+		{"days", &req.Days},
+	}
+	for _, p := range ints {
+		if v := query.Get(p.name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return req, fmt.Errorf("%s must be an integer", p.name)
+			}
+			*p.dst = n
+		}
+	}
+
+	floats := []struct {
+		name string
+		dst  *float64
+	}{
+		{"hours", &req.Hours},
+		{"minutes", &req.Minutes},
+		{"seconds", &req.Seconds},
+	}
+	for _, p := range floats {
+		if v := query.Get(p.name); v != "" {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return req, fmt.Errorf("%s must be a number", p.name)
+			}
+			*p.dst = f
+		}
+	}
+
+	return req, nil
+}
+
+// parseHumanizeQuery builds HumanizeOptions from query parameters
+func parseHumanizeQuery(query url.Values) (model.HumanizeOptions, error) {
+	opts := model.HumanizeOptions{Granularity: query.Get("granularity")}
+	if v := query.Get("precision"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return opts, fmt.Errorf("precision must be an integer")
+		}
+		opts.Precision = n
+	}
+	return opts, nil
+}
+
+// parseBoolQuery parses an optional boolean query parameter
+func parseBoolQuery(query url.Values, name string, defaultValue bool) (bool, error) {
+	v := query.Get(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("%s must be true or false", name)
+	}
+	return b, nil
+}
+
+// zoneError sends the appropriate error response for a zone resolution failure
+func (h *TimeHandler) zoneError(w http.ResponseWriter, err error) {
+	if zone.IsClientError(err) {
+		h.logger.Warn("invalid timezone or location", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.logger.Error("failed to resolve timezone", "error", err)
+	h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+}
+
+// json sends a JSON response
+func (h *TimeHandler) json(w http.ResponseWriter, data interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("json encode error", "error", err)
+	}
+}
+
+// errorJSON sends an error JSON response
+func (h *TimeHandler) errorJSON(w http.ResponseWriter, message string, status int) {
+	h.json(w, map[string]string{"error": message}, status)
+}
@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newLocationLookup returns a GetByName mock serving the given name→timezone pairs
+func newLocationLookup(zones map[string]string) func(ctx context.Context, name string) (*model.Location, error) {
+	return func(ctx context.Context, name string) (*model.Location, error) {
+		if tz, ok := zones[name]; ok {
+			return &model.Location{Name: name, Timezone: tz}, nil
+		}
+		return nil, repository.ErrLocationNotFound
+	}
+}
+
+func TestConvertTime(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockGetByName  func(ctx context.Context, name string) (*model.Location, error)
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.ConvertResponse)
+	}{
+		{
+			name:           "convert between IANA zones",
+			query:          "time=2025-06-01T14:30:00&from=Europe/Berlin&to=Asia/Tokyo",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ConvertResponse) {
+				if resp.Instant != "2025-06-01T12:30:00Z" {
+					t.Errorf("expected instant 2025-06-01T12:30:00Z, got %s", resp.Instant)
+				}
+				if resp.Source.Abbreviation != "CEST" {
+					t.Errorf("expected source abbreviation CEST, got %s", resp.Source.Abbreviation)
+				}
+				if len(resp.Targets) != 1 {
+					t.Fatalf("expected 1 target, got %d", len(resp.Targets))
+				}
+				if resp.Targets[0].Time != "2025-06-01T21:30:00+09:00" {
+					t.Errorf("expected Tokyo time 21:30, got %s", resp.Targets[0].Time)
+				}
+			},
+		},
+		{
+			name:           "humanized",
+			query:          "time=2020-01-01T00:00:00Z&to=Asia/Tokyo&humanize=true&granularity=year",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ConvertResponse) {
+				if !strings.HasSuffix(resp.Source.Humanized, " years ago") || resp.Targets[0].Humanized != resp.Source.Humanized {
+					t.Errorf("unexpected descriptions: %q and %q", resp.Source.Humanized, resp.Targets[0].Humanized)
+				}
+			},
+		},
+		{
+			name:           "invalid humanize",
+			query:          "to=UTC&humanize=maybe",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "humanize must be true or false",
+		},
+		{
+			name:           "invalid precision",
+			query:          "to=UTC&humanize=true&precision=9",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrInvalidPrecision.Error(),
+		},
+		{
+			name:           "saved locations as source and targets",
+			query:          "time=2025-01-15T09:00:00&from=hq&to=tokyo,Europe/London&to=UTC",
+			mockGetByName:  newLocationLookup(map[string]string{"hq": "America/New_York", "tokyo": "Asia/Tokyo"}),
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ConvertResponse) {
+				if resp.Source.Location != "hq" || resp.Source.Offset != "-05:00" {
+					t.Errorf("unexpected source: %+v", resp.Source)
+				}
+				if len(resp.Targets) != 3 {
+					t.Fatalf("expected 3 targets, got %d", len(resp.Targets))
+				}
+				if resp.Targets[0].Location != "tokyo" || resp.Targets[0].LocalTime != "2025-01-15 23:00:00" {
+					t.Errorf("unexpected tokyo target: %+v", resp.Targets[0])
+				}
+				if resp.Targets[2].Timezone != "UTC" || resp.Targets[2].LocalTime != "2025-01-15 14:00:00" {
+					t.Errorf("unexpected UTC target: %+v", resp.Targets[2])
+				}
+			},
+		},
+		{
+			name:           "defaults to now in UTC",
+			query:          "to=Asia/Tokyo",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ConvertResponse) {
+				if resp.Source.Timezone != "UTC" {
+					t.Errorf("expected UTC source, got %s", resp.Source.Timezone)
+				}
+			},
+		},
+		{
+			name:           "missing targets",
+			query:          "from=UTC",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrNoConvertTargets.Error(),
+		},
+		{
+			name:           "unknown source",
+			query:          "from=nowhere&to=UTC",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unknown timezone or location: nowhere",
+		},
+		{
+			name:           "unknown target",
+			query:          "to=Invalid/Timezone",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unknown timezone or location: Invalid/Timezone",
+		},
+		{
+			name:           "invalid time",
+			query:          "time=yesterday&to=UTC",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `unrecognized time format: "yesterday"`,
+		},
+		{
+			name:  "repository error",
+			query: "from=hq&to=UTC",
+			mockGetByName: func(ctx context.Context, name string) (*model.Location, error) {
+				return nil, errors.New("database error")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: tt.mockGetByName,
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/convert?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ConvertTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.ConvertResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestTimeDiff(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.DiffResponse)
+	}{
+		{
+			name:           "calendar day across fall back",
+			query:          "start=2025-11-01T12:00&end=2025-11-02T12:00&start_zone=hq",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.DiffResponse) {
+				if resp.Calendar.Days != 1 || resp.Calendar.Hours != 0 {
+					t.Errorf("expected exactly 1 day, got %+v", resp.Calendar)
+				}
+				if resp.DurationSeconds != 25*3600 {
+					t.Errorf("expected 25 elapsed hours, got %v seconds", resp.DurationSeconds)
+				}
+				if resp.Zone != "America/New_York" {
+					t.Errorf("expected calendar zone America/New_York, got %s", resp.Zone)
+				}
+			},
+		},
+		{
+			name:           "negative difference",
+			query:          "start=2025-03-01T00:00:00Z&end=2025-01-01T00:00:00Z",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.DiffResponse) {
+				if !resp.Negative || resp.ISO8601 != "-P2M" {
+					t.Errorf("expected -P2M, got %s (negative=%v)", resp.ISO8601, resp.Negative)
+				}
+			},
+		},
+		{
+			name:           "missing start",
+			query:          "end=2025-01-01",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyDiffStart.Error(),
+		},
+		{
+			name:           "unknown end zone",
+			query:          "start=2025-01-01&end=2025-01-02&end_zone=Nowhere/City",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unknown timezone or location: Nowhere/City",
+		},
+		{
+			name:           "invalid end",
+			query:          "start=2025-01-01&end=tomorrow",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `invalid end: unrecognized time format: "tomorrow"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"hq": "America/New_York"}),
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/diff?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.TimeDiff(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.DiffResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestAddTime(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.AddTimeResponse)
+	}{
+		{
+			name:           "one day across spring forward in saved location",
+			query:          "base=2025-03-08T12:00&days=1&timezone=hq",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.AddTimeResponse) {
+				if resp.Result.Time != "2025-03-09T12:00:00-04:00" {
+					t.Errorf("expected 2025-03-09T12:00:00-04:00, got %s", resp.Result.Time)
+				}
+				if resp.Result.Location != "hq" {
+					t.Errorf("expected location hq, got %q", resp.Result.Location)
+				}
+				if resp.ElapsedISO8601 != "PT23H" {
+					t.Errorf("expected PT23H elapsed, got %s", resp.ElapsedISO8601)
+				}
+			},
+		},
+		{
+			name:           "month end rolls over",
+			query:          "base=2025-01-31T09:00:00Z&months=1&overflow=rollover",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.AddTimeResponse) {
+				if resp.Result.Time != "2025-03-03T09:00:00Z" {
+					t.Errorf("expected 2025-03-03T09:00:00Z, got %s", resp.Result.Time)
+				}
+				if resp.Overflow != "rollover" {
+					t.Errorf("expected overflow rollover, got %s", resp.Overflow)
+				}
+			},
+		},
+		{
+			name:           "iso 8601 duration clamps by default",
+			query:          "base=2025-01-31T09:00:00Z&duration=P1MT1H",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.AddTimeResponse) {
+				if resp.Result.Time != "2025-02-28T10:00:00Z" {
+					t.Errorf("expected 2025-02-28T10:00:00Z, got %s", resp.Result.Time)
+				}
+			},
+		},
+		{
+			name:           "duration combined with units",
+			query:          "duration=P1D&hours=1",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrDurationWithUnits.Error(),
+		},
+		{
+			name:           "non-integer days",
+			query:          "days=1.5",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "days must be an integer",
+		},
+		{
+			name:           "unknown overflow policy",
+			query:          "months=1&overflow=wrap",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "overflow must be 'clamp' or 'rollover': wrap",
+		},
+		{
+			name:           "invalid base",
+			query:          "base=tomorrow&days=1",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `invalid base: unrecognized time format: "tomorrow"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"hq": "America/New_York"}),
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/add?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.AddTime(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.AddTimeResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timescale"
+)
+
+// ConvertTimeScale handles GET /api/timescales/convert
+func (h *TimeHandler) ConvertTimeScale(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.TimeScaleRequest{
+		Scale: query.Get("scale"),
+		Time:  query.Get("time"),
+		To:    query["to"],
+	}
+	if v := query.Get("week"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.logger.Warn("invalid query parameter", "week", v)
+			h.errorJSON(w, "week must be an integer", http.StatusBadRequest)
+			return
+		}
+		req.Week = n
+	}
+	if v := query.Get("seconds_of_week"); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			h.logger.Warn("invalid query parameter", "seconds_of_week", v)
+			h.errorJSON(w, "seconds_of_week must be a number", http.StatusBadRequest)
+			return
+		}
+		req.SecondsOfWeek = f
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reading, err := req.Reading(time.Now())
+	if err != nil {
+		h.logger.Warn("invalid time", "scale", req.Scale, "time", req.Time, "error", err)
+		h.errorJSON(w, "invalid time: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response, err := model.NewTimeScaleResponse(timescale.Default(), reading, req.Targets())
+	if err != nil {
+		h.logger.Warn("time scale conversion failed", "scale", req.Scale, "time", req.Time, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Debug("time scales converted",
+		"scale", response.Input.Scale,
+		"time", response.Input.Time,
+		"tai_minus_utc", response.TAIMinusUTC,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// LeapSeconds handles GET /api/timescales/leap-seconds
+func (h *TimeHandler) LeapSeconds(w http.ResponseWriter, r *http.Request) {
+	response := model.NewLeapSecondsResponse(timescale.Default(), time.Now())
+
+	h.logger.Debug("leap seconds listed",
+		"tai_minus_utc", response.TAIMinusUTC,
+		"next", response.NextStatus,
+		"source", response.Table.Source,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
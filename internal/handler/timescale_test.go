@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timescale"
+)
+
+func TestConvertTimeScale(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.TimeScaleResponse)
+	}{
+		{
+			name:           "leap second to every scale",
+			query:          "time=2016-12-31T23:59:60.5Z",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimeScaleResponse) {
+				if !resp.Input.LeapSecond || resp.TAIMinusUTC != 36 || len(resp.Readings) != 5 {
+					t.Fatalf("unexpected response: %+v", resp)
+				}
+				want := []string{"2016-12-31T23:59:60.5Z", "2017-01-01T00:00:36.5", "2017-01-01T00:00:17.5", "2017-01-01T00:01:08.684", "1483228800.5"}
+				for i, r := range resp.Readings {
+					if r.Time != want[i] {
+						t.Errorf("%s reading = %s, want %s", r.Scale, r.Time, want[i])
+					}
+				}
+			},
+		},
+		{
+			name:           "gps week to utc",
+			query:          "scale=gps&week=2347&seconds_of_week=259218&to=utc",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimeScaleResponse) {
+				if len(resp.Readings) != 1 || resp.Readings[0].Time != "2025-01-01T00:00:00Z" {
+					t.Errorf("unexpected readings: %+v", resp.Readings)
+				}
+			},
+		},
+		{
+			name:           "tai to unix",
+			query:          "scale=tai&time=2025-01-01T00:00:37&to=unix",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimeScaleResponse) {
+				if len(resp.Readings) != 1 || *resp.Readings[0].Seconds != 1735689600 {
+					t.Errorf("unexpected readings: %+v", resp.Readings)
+				}
+			},
+		},
+		{
+			name:           "no leap second that day",
+			query:          "time=2015-12-31T23:59:60Z",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "no leap second was inserted at that time: 2015-12-31T23:59:60Z",
+		},
+		{
+			name:           "before the table",
+			query:          "time=1970-01-01T00:00:00Z",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  timescale.ErrBeforeTable.Error(),
+		},
+		{
+			name:           "unknown scale",
+			query:          "scale=tcg",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `unknown time scale: "tcg"`,
+		},
+		{
+			name:           "invalid week",
+			query:          "scale=gps&week=last",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "week must be an integer",
+		},
+		{
+			name:           "zone offset on a tai reading",
+			query:          "scale=tai&time=2025-01-01T00:00:37%2B01:00",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewTimeHandler(&mockLocationRepository{}, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/timescales/convert?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ConvertTimeScale(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.TimeScaleResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestLeapSeconds(t *testing.T) {
+	handler := NewTimeHandler(&mockLocationRepository{}, newTestLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/api/timescales/leap-seconds", nil)
+	w := httptest.NewRecorder()
+
+	handler.LeapSeconds(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+	var resp model.LeapSecondsResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.TAIMinusUTC < 37 || resp.GPSMinusUTC != resp.TAIMinusUTC-19 || resp.Table.Source == "" || resp.Note == "" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Table.Expired && resp.NextStatus != model.LeapSecondUnknown {
+		t.Errorf("expected an unknown next leap second with an expired table, got %s", resp.NextStatus)
+	}
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// Transitions handles GET /api/time/transitions
+func (h *TimeHandler) Transitions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.TransitionsRequest{
+		Zone:  query.Get("zone"),
+		Start: query.Get("start"),
+		End:   query.Get("end"),
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	target, err := h.resolver.Resolve(r.Context(), req.Zone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	start, end, err := req.Range(target.TZ, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid range", "start", req.Start, "end", req.End, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NewTransitionsResponse(target.TZ, target.Location, start, end)
+
+	h.logger.Debug("transitions listed",
+		"zone", req.Zone,
+		"start", response.Start,
+		"end", response.End,
+		"count", len(response.Transitions),
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// OffsetTimeline handles GET /api/time/offsets
+func (h *TimeHandler) OffsetTimeline(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.OffsetTimelineRequest{
+		Zone:  query.Get("zone"),
+		Other: query.Get("other"),
+		Start: query.Get("start"),
+		End:   query.Get("end"),
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	target, err := h.resolver.Resolve(r.Context(), req.Zone)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+	other, err := h.resolver.Resolve(r.Context(), req.Other)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	start, end, err := req.Range(target.TZ, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid range", "start", req.Start, "end", req.End, "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NewOffsetTimelineResponse(target.TZ, target.Location, other.TZ, other.Location, start, end)
+
+	h.logger.Debug("offset timeline computed",
+		"zone", req.Zone,
+		"other", req.Other,
+		"usual", response.UsualDifference,
+		"changes", response.Changes,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// ListTimezones handles GET /api/timezones
+func (h *TimeHandler) ListTimezones(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.TimezoneListRequest{
+		Search: query.Get("search"),
+		Prefix: query.Get("prefix"),
+	}
+	if v := query.Get("canonical"); v != "" {
+		canonical, err := strconv.ParseBool(v)
+		if err != nil {
+			h.logger.Warn("invalid query parameter", "canonical", v)
+			h.errorJSON(w, "canonical must be true or false", http.StatusBadRequest)
+			return
+		}
+		req.CanonicalOnly = canonical
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	response := model.NewTimezoneListResponse(&req, time.Now())
+
+	h.logger.Debug("timezones listed",
+		"search", req.Search,
+		"prefix", req.Prefix,
+		"count", response.Count,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
+
+// LookupTimezone handles GET /api/timezones/lookup
+func (h *TimeHandler) LookupTimezone(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.ZoneLookupRequest{
+		Query: query.Get("q"),
+		At:    query.Get("at"),
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	at, err := timeparse.Parse(req.At, time.UTC, time.Now())
+	if err != nil {
+		h.logger.Warn("invalid time", "at", req.At, "error", err)
+		h.errorJSON(w, "invalid at: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	locations, err := h.resolver.SavedLocations(r.Context())
+	if err != nil {
+		h.logger.Error("failed to list locations", "error", err)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	response := model.NewZoneLookupResponse(req.ZoneQuery(), at, locations)
+
+	h.logger.Debug("timezone lookup",
+		"query", response.Query,
+		"at", response.At,
+		"count", response.Count,
+		"ambiguous", response.Ambiguous,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
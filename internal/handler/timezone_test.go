@@ -0,0 +1,382 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestTransitions(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.TransitionsResponse)
+	}{
+		{
+			name:           "iana zone",
+			query:          "zone=Europe/London&start=2025-01-01&end=2025-12-31",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TransitionsResponse) {
+				if len(resp.Transitions) != 2 {
+					t.Fatalf("expected 2 transitions, got %d", len(resp.Transitions))
+				}
+				first := resp.Transitions[0]
+				if first.Instant != "2025-03-30T01:00:00Z" || first.OldAbbreviation != "GMT" || first.NewAbbreviation != "BST" {
+					t.Errorf("unexpected spring transition: %+v", first)
+				}
+				if !first.IsDST || first.NewOffset != "+01:00" {
+					t.Errorf("expected DST at +01:00, got %+v", first)
+				}
+			},
+		},
+		{
+			name:           "saved location",
+			query:          "zone=hq&start=2025-03-01&end=2025-04-01",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TransitionsResponse) {
+				if resp.Location != "hq" || len(resp.Transitions) != 1 {
+					t.Errorf("expected 1 transition for hq, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:           "missing zone",
+			query:          "start=2025-01-01",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyTransitionZone.Error(),
+		},
+		{
+			name:           "range too long",
+			query:          "zone=UTC&start=1900-01-01&end=2100-01-01",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrTransitionRange.Error(),
+		},
+		{
+			name:           "invalid end",
+			query:          "zone=UTC&end=later",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  `invalid end: unrecognized time format: "later"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"hq": "America/New_York"}),
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/transitions?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.Transitions(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.TransitionsResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestOffsetTimeline(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.OffsetTimelineResponse)
+	}{
+		{
+			name:           "saved locations",
+			query:          "zone=london&other=nyc&start=2025-01-01&end=2026-01-01",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.OffsetTimelineResponse) {
+				if resp.Zone.Location != "london" || resp.Other.Location != "nyc" {
+					t.Errorf("unexpected zones: %+v, %+v", resp.Zone, resp.Other)
+				}
+				if resp.UsualDifference != "+05:00" || resp.Changes != 4 {
+					t.Errorf("expected usual +05:00 with 4 changes, got %s with %d", resp.UsualDifference, resp.Changes)
+				}
+				if resp.Periods[1].Difference != "+04:00" || resp.Periods[3].Difference != "+04:00" {
+					t.Errorf("expected two +04:00 periods, got %+v and %+v", resp.Periods[1], resp.Periods[3])
+				}
+			},
+		},
+		{
+			name:           "same transitions",
+			query:          "zone=Europe/Berlin&other=london&start=2025-01-01&end=2026-01-01",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.OffsetTimelineResponse) {
+				if resp.Changes != 0 || resp.Summary != "Europe/Berlin is 1 hour ahead of london" {
+					t.Errorf("expected a constant difference, got %d changes: %s", resp.Changes, resp.Summary)
+				}
+			},
+		},
+		{
+			name:           "missing other",
+			query:          "zone=london",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyTimelineZones.Error(),
+		},
+		{
+			name:           "unknown other",
+			query:          "zone=london&other=Mars/Olympus",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "unknown timezone or location: Mars/Olympus",
+		},
+		{
+			name:           "range too long",
+			query:          "zone=london&other=nyc&start=1900-01-01&end=2100-01-01",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrTransitionRange.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"london": "Europe/London", "nyc": "America/New_York"}),
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/time/offsets?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.OffsetTimeline(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.OffsetTimelineResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestListTimezones(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.TimezoneListResponse)
+	}{
+		{
+			name:           "search by city name",
+			query:          "search=new+york",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimezoneListResponse) {
+				if resp.Count != 1 || resp.Timezones[0].Name != "America/New_York" {
+					t.Fatalf("expected only America/New_York, got %+v", resp.Timezones)
+				}
+				if !resp.Timezones[0].ObservesDST || !resp.Timezones[0].Canonical {
+					t.Errorf("unexpected entry: %+v", resp.Timezones[0])
+				}
+			},
+		},
+		{
+			name:           "canonical zones under a prefix",
+			query:          "prefix=europe/&canonical=true",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimezoneListResponse) {
+				if resp.Count == 0 {
+					t.Fatal("expected European zones")
+				}
+				for _, tz := range resp.Timezones {
+					if !tz.Canonical || !strings.HasPrefix(tz.Name, "Europe/") {
+						t.Errorf("unexpected entry: %+v", tz)
+					}
+				}
+			},
+		},
+		{
+			name:           "links report their target",
+			query:          "search=GB-Eire",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.TimezoneListResponse) {
+				if resp.Count != 1 || resp.Timezones[0].LinkTarget != "Europe/London" {
+					t.Errorf("expected GB-Eire linked to Europe/London, got %+v", resp.Timezones)
+				}
+			},
+		},
+		{
+			name:           "invalid canonical flag",
+			query:          "canonical=maybe",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  "canonical must be true or false",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewTimeHandler(&mockLocationRepository{}, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/timezones?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.ListTimezones(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.TimezoneListResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
+
+func TestLookupTimezone(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockList       func(ctx context.Context) ([]*model.Location, error)
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.ZoneLookupResponse)
+	}{
+		{
+			name:           "ambiguous abbreviation",
+			query:          "q=ist&at=2025-01-15T12:00:00Z",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ZoneLookupResponse) {
+				if !resp.Ambiguous || len(resp.Offsets) < 3 {
+					t.Errorf("expected IST to be ambiguous, got offsets %v", resp.Offsets)
+				}
+				if resp.Matches[0].Timezone != "Asia/Kolkata" {
+					t.Errorf("expected Asia/Kolkata first, got %s", resp.Matches[0].Timezone)
+				}
+			},
+		},
+		{
+			name:  "saved locations rank first",
+			query: "q=PST&at=2025-01-15T12:00:00Z",
+			mockList: func(ctx context.Context) ([]*model.Location, error) {
+				return []*model.Location{{Name: "manila-office", Timezone: "Asia/Manila"}}, nil
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ZoneLookupResponse) {
+				first := resp.Matches[0]
+				if first.Timezone != "Asia/Manila" || len(first.SavedLocations) != 1 {
+					t.Errorf("expected Asia/Manila with saved location first, got %+v", first)
+				}
+			},
+		},
+		{
+			name:           "offset",
+			query:          "q=%2B05:45",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.ZoneLookupResponse) {
+				if resp.Kind != "offset" || resp.Matches[0].Timezone != "Asia/Kathmandu" {
+					t.Errorf("expected Asia/Kathmandu for +05:45, got %+v", resp.Matches)
+				}
+			},
+		},
+		{
+			name:           "missing query",
+			query:          "",
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  model.ErrEmptyZoneQuery.Error(),
+		},
+		{
+			name:  "repository error",
+			query: "q=EST",
+			mockList: func(ctx context.Context) ([]*model.Location, error) {
+				return nil, errors.New("database unavailable")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{listFunc: tt.mockList}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/timezones/lookup?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.LookupTimezone(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.ZoneLookupResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// WorldClock handles GET /api/worldclock
+func (h *TimeHandler) WorldClock(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	req := model.WorldClockRequest{
+		Names:     query["name"],
+		Tags:      query["tag"],
+		Reference: query.Get("reference"),
+	}
+
+	// Normalize and validate request
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		h.logger.Warn("validation failed", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ref, err := h.resolver.Resolve(r.Context(), req.Reference)
+	if err != nil {
+		h.zoneError(w, err)
+		return
+	}
+
+	locations, err := h.resolver.SavedLocations(r.Context())
+	if err != nil {
+		h.logger.Error("failed to list locations", "error", err)
+		h.errorJSON(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	if err := req.CheckNames(locations); err != nil {
+		h.logger.Debug("location not found", "error", err)
+		h.errorJSON(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	response := model.NewWorldClockResponse(&req, time.Now(), ref.TZ, ref.Location, locations)
+
+	h.logger.Debug("world clock",
+		"reference", ref.Name,
+		"names", len(req.Names),
+		"tags", len(req.Tags),
+		"count", response.Count,
+	)
+
+	h.json(w, response, http.StatusOK)
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestWorldClock(t *testing.T) {
+	saved := []*model.Location{
+		{Name: "tokyo", Timezone: "Asia/Tokyo", Tags: []string{"apac"}},
+		{Name: "nyc", Timezone: "America/New_York", Tags: []string{"amer"}},
+		{Name: "london", Timezone: "Europe/London", Tags: []string{"emea"}},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		mockList       func(ctx context.Context) ([]*model.Location, error)
+		expectedStatus int
+		expectedError  string
+		checkResponse  func(t *testing.T, resp *model.WorldClockResponse)
+	}{
+		{
+			name:           "all locations sorted by offset",
+			query:          "",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.WorldClockResponse) {
+				if resp.Count != 3 || resp.Locations[0].Location != "nyc" || resp.Locations[2].Location != "tokyo" {
+					t.Errorf("unexpected locations: %+v", resp.Locations)
+				}
+				if resp.Reference.Timezone != "UTC" {
+					t.Errorf("expected UTC reference, got %s", resp.Reference.Timezone)
+				}
+			},
+		},
+		{
+			name:           "name and tag filters",
+			query:          "name=tokyo,nyc&name=london&tag=apac&tag=emea&reference=nyc",
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, resp *model.WorldClockResponse) {
+				if resp.Count != 2 || resp.Locations[0].Location != "london" || resp.Reference.Location != "nyc" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if offset := resp.Locations[1].RelativeOffset; offset != "13 hours ahead" && offset != "14 hours ahead" {
+					t.Errorf("expected tokyo 13 or 14 hours ahead of nyc, got %s", offset)
+				}
+			},
+		},
+		{
+			name:           "unknown names",
+			query:          "name=tokyo,paris",
+			expectedStatus: http.StatusNotFound,
+			expectedError:  "location not found: paris",
+		},
+		{
+			name:           "invalid tag",
+			query:          "tag=a/b",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown reference",
+			query:          "reference=Mars/Olympus",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "repository error",
+			query: "",
+			mockList: func(ctx context.Context) ([]*model.Location, error) {
+				return nil, errors.New("database unavailable")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedError:  "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: newLocationLookup(map[string]string{"nyc": "America/New_York"}),
+				listFunc:      tt.mockList,
+			}
+			if mockRepo.listFunc == nil {
+				mockRepo.listFunc = func(ctx context.Context) ([]*model.Location, error) {
+					return saved, nil
+				}
+			}
+			handler := NewTimeHandler(mockRepo, newTestLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/api/worldclock?"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			handler.WorldClock(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+
+			if tt.expectedError != "" {
+				var errResp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&errResp); err != nil {
+					t.Fatalf("failed to decode error response: %v", err)
+				}
+				if errResp["error"] != tt.expectedError {
+					t.Errorf("expected error '%s', got '%s'", tt.expectedError, errResp["error"])
+				}
+			}
+
+			if tt.checkResponse != nil {
+				var resp model.WorldClockResponse
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				tt.checkResponse(t, &resp)
+			}
+		})
+	}
+}
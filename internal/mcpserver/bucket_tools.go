@@ -0,0 +1,107 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timecalc"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newBucketTimeTool returns the bucket_time tool definition
+func newBucketTimeTool() mcp.Tool {
+	return mcp.NewTool("bucket_time",
+		mcp.WithDescription("Floor, ceil or round an instant to a 15-minute, hourly, daily, ISO-week or other bucket of local time in a zone or saved location, and list the bucket boundaries in a range. Buckets follow the wall clock, so days around DST changes last 23 or 25 hours"),
+		mcp.WithString("unit",
+			mcp.Required(),
+			mcp.Description("Bucket unit; weeks start on Monday"),
+			mcp.Enum(timecalc.BucketUnits()...),
+		),
+		mcp.WithNumber("size",
+			mcp.Description("Units per bucket, e.g. 15 with minute; must divide the next larger unit (default: 1)"),
+		),
+		mcp.WithString("time",
+			mcp.Description("Instant to align in any format parse_time detects (default: now, or none when a range is given)"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location whose local time the buckets follow (default: UTC)"),
+		),
+		mcp.WithString("mode",
+			mcp.Description("How to align the time (default: floor)"),
+			mcp.Enum(model.BucketFloor, model.BucketCeil, model.BucketRound),
+		),
+		mcp.WithString("start",
+			mcp.Description("Start of a range whose bucket boundaries to list; requires end"),
+		),
+		mcp.WithString("end",
+			mcp.Description(fmt.Sprintf("End of the range, exclusive (at most %d buckets are listed)", model.MaxBuckets)),
+		),
+	)
+}
+
+// handleBucketTime handles the bucket_time tool
+func handleBucketTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.BucketRequest{
+		Time:     request.GetString("time", ""),
+		Timezone: request.GetString("timezone", ""),
+		Unit:     request.GetString("unit", ""),
+		Size:     request.GetInt("size", 0),
+		Mode:     request.GetString("mode", ""),
+		Start:    request.GetString("start", ""),
+		End:      request.GetString("end", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("bucket_time: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "bucket_time", req.Timezone, err), nil
+	}
+
+	now := time.Now()
+	var t *time.Time
+	if req.HasTime() {
+		parsed, err := timeparse.Parse(req.Time, z.TZ, now)
+		if err != nil {
+			log.Warn("bucket_time: invalid time", "time", req.Time, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid time '%s': %v", req.Time, err)), nil
+		}
+		t = &parsed
+	}
+	var start, end time.Time
+	if req.Start != "" {
+		if start, end, err = req.Range(z.TZ, now); err != nil {
+			log.Warn("bucket_time: invalid range", "start", req.Start, "end", req.End, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid range: %v", err)), nil
+		}
+	}
+	response := model.NewBucketResponse(&req, z.TZ, z.Location, t, start, end)
+
+	log.Info("bucket_time executed",
+		"timezone", response.Timezone,
+		"bucket", response.Bucket,
+		"mode", response.Mode,
+		"count", response.Count,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.BucketResponse
+	}{true, response})
+	if err != nil {
+		log.Error("bucket_time: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,123 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleBucketTime(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"hq": "America/New_York"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.BucketResponse)
+	}{
+		{
+			name: "floor to the ISO week",
+			arguments: map[string]interface{}{
+				"time":     "2025-01-01 15:00",
+				"timezone": "hq",
+				"unit":     "week",
+			},
+			check: func(t *testing.T, resp *model.BucketResponse) {
+				if resp.Mode != "floor" || resp.Result.Time != "2024-12-30T00:00:00-05:00" || resp.Result.Location != "hq" {
+					t.Errorf("unexpected result: %+v", resp.Result)
+				}
+			},
+		},
+		{
+			name: "hourly boundaries on the fall back day",
+			arguments: map[string]interface{}{
+				"timezone": "hq",
+				"unit":     "hour",
+				"start":    "2025-11-02",
+				"end":      "2025-11-03",
+			},
+			check: func(t *testing.T, resp *model.BucketResponse) {
+				if resp.Count != 25 || resp.Result != nil {
+					t.Fatalf("expected 25 buckets and no result, got %d", resp.Count)
+				}
+				if resp.Buckets[1].LocalStart != "2025-11-02 01:00" || resp.Buckets[2].LocalStart != "2025-11-02 01:00" {
+					t.Errorf("expected the repeated hour twice, got %s and %s", resp.Buckets[1].Start, resp.Buckets[2].Start)
+				}
+			},
+		},
+		{
+			name: "ceil to 30 minutes",
+			arguments: map[string]interface{}{
+				"time": "2025-06-01T10:05:00Z",
+				"unit": "minutes",
+				"size": float64(30),
+				"mode": "ceil",
+			},
+			check: func(t *testing.T, resp *model.BucketResponse) {
+				if resp.Bucket != "30 minute" || resp.Result.Time != "2025-06-01T10:30:00Z" {
+					t.Errorf("unexpected result: %s %+v", resp.Bucket, resp.Result)
+				}
+			},
+		},
+		{
+			name:         "invalid size",
+			arguments:    map[string]interface{}{"unit": "minute", "size": float64(7)},
+			shouldError:  true,
+			errorMessage: "Validation failed: invalid bucket size",
+		},
+		{
+			name:         "unknown zone",
+			arguments:    map[string]interface{}{"unit": "day", "timezone": "branch"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'branch'",
+		},
+		{
+			name:         "reversed range",
+			arguments:    map[string]interface{}{"unit": "day", "start": "2025-01-02", "end": "2025-01-01"},
+			shouldError:  true,
+			errorMessage: "Invalid range: end must be after start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleBucketTime(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.BucketResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/businessday"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newAddBusinessDaysTool returns the add_business_days tool definition
+func newAddBusinessDaysTool() mcp.Tool {
+	return mcp.NewTool("add_business_days",
+		mcp.WithDescription("Add or subtract business days at a saved location, skipping its weekend days and the holidays of its calendars and custom holidays. The result keeps the start's local time of day in the location's timezone"),
+		mcp.WithString("location",
+			mcp.Required(),
+			mcp.Description("Saved location name"),
+		),
+		mcp.WithNumber("days",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Business days to add; negative to subtract (at most %d either way)", model.MaxBusinessDays)),
+		),
+		mcp.WithString("start",
+			mcp.Description("Start date or instant: YYYY-MM-DD, '2006-01-02 15:04' in the location's timezone, RFC3339, or unix seconds (default: now)"),
+		),
+	)
+}
+
+// newCountBusinessDaysTool returns the count_business_days tool definition
+func newCountBusinessDaysTool() mcp.Tool {
+	return mcp.NewTool("count_business_days",
+		mcp.WithDescription("Count the business days between two dates at a saved location, excluding the from date and including the to date, using its weekend days and holiday calendars"),
+		mcp.WithString("location",
+			mcp.Required(),
+			mcp.Description("Saved location name"),
+		),
+		mcp.WithString("to",
+			mcp.Required(),
+			mcp.Description("End date (YYYY-MM-DD or any start format); before from gives a negative count"),
+		),
+		mcp.WithString("from",
+			mcp.Description("Start date: YYYY-MM-DD, '2006-01-02 15:04' in the location's timezone, RFC3339, or unix seconds (default: today)"),
+		),
+	)
+}
+
+// handleAddBusinessDays handles the add_business_days tool
+func handleAddBusinessDays(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, calc *businessday.Calculator) (*mcp.CallToolResult, error) {
+	days, err := request.RequireInt("days")
+	if err != nil {
+		log.Warn("add_business_days: missing required parameter", "parameter", "days")
+		return mcp.NewToolResultError("Parameter 'days' is required"), nil
+	}
+
+	req := model.BusinessDaysAddRequest{
+		Location: request.GetString("location", ""),
+		Start:    request.GetString("start", ""),
+		Days:     days,
+	}
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("add_business_days: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	response, err := calc.Add(ctx, &req, time.Now())
+	if err != nil {
+		return businessDayErrorResult(log, "add_business_days", req.Location, err), nil
+	}
+
+	log.Info("add_business_days executed",
+		"location", response.Location,
+		"days", response.Days,
+		"result", response.ResultDate,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.BusinessDaysAddResponse
+	}{true, response})
+	if err != nil {
+		log.Error("add_business_days: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// handleCountBusinessDays handles the count_business_days tool
+func handleCountBusinessDays(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, calc *businessday.Calculator) (*mcp.CallToolResult, error) {
+	req := model.BusinessDaysCountRequest{
+		Location: request.GetString("location", ""),
+		From:     request.GetString("from", ""),
+		To:       request.GetString("to", ""),
+	}
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("count_business_days: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	response, err := calc.Count(ctx, &req, time.Now())
+	if err != nil {
+		return businessDayErrorResult(log, "count_business_days", req.Location, err), nil
+	}
+
+	log.Info("count_business_days executed",
+		"location", response.Location,
+		"business_days", response.BusinessDays,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.BusinessDaysCountResponse
+	}{true, response})
+	if err != nil {
+		log.Error("count_business_days: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// businessDayErrorResult converts a calculator error into a tool error result
+func businessDayErrorResult(log *slog.Logger, tool, location string, err error) *mcp.CallToolResult {
+	switch {
+	case errors.Is(err, repository.ErrLocationNotFound):
+		log.Warn(tool+": location not found", "location", location)
+		return mcp.NewToolResultError(fmt.Sprintf("Location '%s' not found", location))
+	case businessday.IsClientError(err):
+		log.Warn(tool+": validation failed", "location", location, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err))
+	default:
+		log.Error(tool+": failed to calculate business days", "location", location, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to calculate business days: %v", err))
+	}
+}
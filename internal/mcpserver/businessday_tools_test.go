@@ -0,0 +1,124 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/businessday"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleBusinessDays(t *testing.T) {
+	locations := &mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			if name != "riyadh" {
+				return nil, repository.ErrLocationNotFound
+			}
+			return &model.Location{Name: name, Timezone: "Asia/Riyadh", Weekend: []string{"friday", "saturday"}}, nil
+		},
+	}
+	holidays := &mockHolidayRepository{
+		listHolidaysFunc: func(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+			return []*model.Holiday{{Date: "2025-09-23", Name: "Saudi National Day", Calendar: "sa"}}, nil
+		},
+	}
+	calc := businessday.NewCalculator(locations, holidays)
+
+	tests := []struct {
+		name         string
+		count        bool
+		arguments    map[string]interface{}
+		errorMessage string
+		check        func(t *testing.T, text string)
+	}{
+		{
+			name:      "add over a friday-saturday weekend and a holiday",
+			arguments: map[string]interface{}{"location": "riyadh", "start": "2025-09-18 14:00", "days": float64(3)},
+			check: func(t *testing.T, text string) {
+				var resp model.BusinessDaysAddResponse
+				if err := json.Unmarshal([]byte(text), &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				// Thursday 18th + 3: Sunday 21st, Monday 22nd, (National Day), Wednesday 24th
+				if resp.ResultDate != "2025-09-24" || resp.Result.Time != "2025-09-24T14:00:00+03:00" {
+					t.Errorf("unexpected result: %s %s", resp.ResultDate, resp.Result.Time)
+				}
+			},
+		},
+		{
+			name:      "count",
+			count:     true,
+			arguments: map[string]interface{}{"location": "riyadh", "from": "2025-09-18", "to": "2025-09-25"},
+			check: func(t *testing.T, text string) {
+				var resp model.BusinessDaysCountResponse
+				if err := json.Unmarshal([]byte(text), &resp); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if resp.BusinessDays != 4 || len(resp.Holidays) != 1 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "missing days",
+			arguments:    map[string]interface{}{"location": "riyadh"},
+			errorMessage: "Parameter 'days' is required",
+		},
+		{
+			name:         "unknown location",
+			arguments:    map[string]interface{}{"location": "nowhere", "days": float64(1)},
+			errorMessage: "Location 'nowhere' not found",
+		},
+		{
+			name:         "missing to",
+			count:        true,
+			arguments:    map[string]interface{}{"location": "riyadh"},
+			errorMessage: "Validation failed: to is required",
+		},
+		{
+			name:         "invalid from",
+			count:        true,
+			arguments:    map[string]interface{}{"location": "riyadh", "from": "last week", "to": "2025-09-25"},
+			errorMessage: "Validation failed: invalid date or time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			var result *mcp.CallToolResult
+			var err error
+			if tt.count {
+				result, err = handleCountBusinessDays(context.Background(), request, logger, calc)
+			} else {
+				result, err = handleAddBusinessDays(context.Background(), request, logger, calc)
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.errorMessage != "" {
+				if !result.IsError || !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			tt.check(t, text)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/calendar"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newConvertCalendarTool returns the convert_calendar tool definition
+func newConvertCalendarTool() mcp.Tool {
+	return mcp.NewTool("convert_calendar",
+		mcp.WithDescription("Convert a date between the Gregorian, ISO week, Islamic (tabular Hijri), Hebrew, Persian (Solar Hijri), Japanese era and Chinese lunisolar calendars, or show today's date at a timezone or saved location in those calendars. Give a date, or leave it out to convert the local date of a time"),
+		mcp.WithString("from",
+			mcp.Description("Calendar the date is given in (default: gregorian)"),
+			mcp.Enum(calendar.Names()...),
+		),
+		mcp.WithString("date",
+			mcp.Description("Date in the from calendar as year-month-day, e.g. '5786-1-24' for hebrew or '2025-W42-4' for iso-week; Hebrew months count from Tishrei"),
+		),
+		mcp.WithNumber("year",
+			mcp.Description("Year of the date, instead of date; Japanese years count from the start of the era"),
+		),
+		mcp.WithNumber("month",
+			mcp.Description("Month of the date, 1 for the first month of the year"),
+		),
+		mcp.WithNumber("week",
+			mcp.Description("ISO week of the date, for the iso-week calendar"),
+		),
+		mcp.WithNumber("day",
+			mcp.Description("Day of the month, or ISO weekday from 1 (Monday) to 7 (Sunday)"),
+		),
+		mcp.WithBoolean("leap_month",
+			mcp.Description("Whether a Chinese month is the leap month repeating its number"),
+		),
+		mcp.WithString("era",
+			mcp.Description("Japanese era for the japanese calendar, e.g. 'Reiwa' or 'Heisei'"),
+		),
+		mcp.WithString("time",
+			mcp.Description("Instant whose local date to convert when no date is given, in any format parse_time detects (default: now)"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location whose local date to convert when no date is given (default: UTC)"),
+		),
+		mcp.WithArray("to",
+			mcp.Description("Calendars to convert to (default: all)"),
+			mcp.WithStringItems(),
+		),
+	)
+}
+
+// handleConvertCalendar handles the convert_calendar tool
+func handleConvertCalendar(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	to, err := stringListArg(request, "to")
+	if err != nil {
+		log.Warn("convert_calendar: invalid to", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid to: %v", err)), nil
+	}
+	req := model.CalendarConvertRequest{
+		From:      request.GetString("from", ""),
+		Date:      request.GetString("date", ""),
+		Year:      request.GetInt("year", 0),
+		Month:     request.GetInt("month", 0),
+		Week:      request.GetInt("week", 0),
+		Day:       request.GetInt("day", 0),
+		LeapMonth: request.GetBool("leap_month", false),
+		Era:       request.GetString("era", ""),
+		Time:      request.GetString("time", ""),
+		Timezone:  request.GetString("timezone", ""),
+		To:        to,
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("convert_calendar: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	var response *model.CalendarConvertResponse
+	if req.HasDate() {
+		days, err := req.SourceDays()
+		if err != nil {
+			log.Warn("convert_calendar: invalid date", "calendar", req.From, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid %s date: %v", req.From, err)), nil
+		}
+		response = model.NewCalendarConvertResponse(&req, days, nil, "")
+	} else {
+		z, err := resolver.Resolve(ctx, req.Timezone)
+		if err != nil {
+			return zoneErrorResult(log, "convert_calendar", req.Timezone, err), nil
+		}
+		t, err := timeparse.Parse(req.Time, z.TZ, time.Now())
+		if err != nil {
+			log.Warn("convert_calendar: invalid time", "time", req.Time, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid time '%s': %v", req.Time, err)), nil
+		}
+		t = t.In(z.TZ)
+		response = model.NewCalendarConvertResponse(&req, calendar.DaysOf(t), &t, z.Location)
+	}
+
+	log.Info("convert_calendar executed",
+		"from", req.From,
+		"gregorian", response.Gregorian,
+		"count", len(response.Dates),
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.CalendarConvertResponse
+	}{true, response})
+	if err != nil {
+		log.Error("convert_calendar: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
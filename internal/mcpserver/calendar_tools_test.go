@@ -0,0 +1,129 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleConvertCalendar(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"tehran": "Asia/Tehran"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.CalendarConvertResponse)
+	}{
+		{
+			name:      "gregorian date to every calendar",
+			arguments: map[string]interface{}{"date": "2025-10-16"},
+			check: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				want := []string{"16 October 2025", "2025-W42-4", "23 Rabi al-Thani 1447 AH", "24 Tishrei 5786", "24 Mehr 1404 SH", "令和7年10月16日", "乙巳年八月廿五"}
+				if len(resp.Dates) != len(want) {
+					t.Fatalf("expected %d dates, got %d", len(want), len(resp.Dates))
+				}
+				for i, d := range resp.Dates {
+					if d.Formatted != want[i] {
+						t.Errorf("%s date = %q, want %q", d.Calendar, d.Formatted, want[i])
+					}
+				}
+			},
+		},
+		{
+			name: "persian fields to gregorian",
+			arguments: map[string]interface{}{
+				"from":  "persian",
+				"year":  float64(1404),
+				"month": float64(1),
+				"day":   float64(1),
+				"to":    []interface{}{"gregorian"},
+			},
+			check: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				if resp.Gregorian != "2025-03-21" || resp.Source.MonthName != "Farvardin" || len(resp.Dates) != 1 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name: "local date of a saved location",
+			arguments: map[string]interface{}{
+				"time":     "2025-03-20T21:00:00Z",
+				"timezone": "tehran",
+				"to":       []interface{}{"persian"},
+			},
+			check: func(t *testing.T, resp *model.CalendarConvertResponse) {
+				// 00:30 on 21 March in Tehran, the first day of 1404
+				if resp.Location != "tehran" || resp.Gregorian != "2025-03-21" || resp.Dates[0].Formatted != "1 Farvardin 1404 SH" {
+					t.Errorf("unexpected response: %+v %+v", resp, resp.Dates[0])
+				}
+			},
+		},
+		{
+			name:         "unknown calendar",
+			arguments:    map[string]interface{}{"from": "julian", "date": "2025-10-03"},
+			shouldError:  true,
+			errorMessage: "Validation failed: unknown calendar",
+		},
+		{
+			name:         "invalid date",
+			arguments:    map[string]interface{}{"from": "islamic", "date": "1447-2-30"},
+			shouldError:  true,
+			errorMessage: "Invalid islamic date: invalid date: islamic day 30, Safar 1447 has 29 days",
+		},
+		{
+			name:         "unknown era",
+			arguments:    map[string]interface{}{"from": "japanese", "date": "1-1-1"},
+			shouldError:  true,
+			errorMessage: "Invalid japanese date",
+		},
+		{
+			name:         "unknown zone",
+			arguments:    map[string]interface{}{"timezone": "branch"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'branch'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleConvertCalendar(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.CalendarConvertResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
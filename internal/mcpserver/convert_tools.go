@@ -0,0 +1,115 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newConvertTimeTool returns the convert_time tool definition
+func newConvertTimeTool() mcp.Tool {
+	return mcp.NewTool("convert_time",
+		mcp.WithDescription("Convert an instant from one timezone or saved location to one or more others"),
+		mcp.WithString("time",
+			mcp.Description("Instant to convert in any format parse_time detects, e.g. RFC3339, RFC1123, unix epoch, '2006-01-02 15:04' or '15:04' (default: now)"),
+		),
+		mcp.WithString("from",
+			mcp.Description("Source IANA timezone or saved location name, used for times without an offset (default: UTC)"),
+		),
+		mcp.WithArray("to",
+			mcp.Required(),
+			mcp.Description("Target IANA timezones or saved location names"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithBoolean("humanize",
+			mcp.Description("Also describe the instant relative to now in each zone, e.g. 'tomorrow at 09:00 Tokyo time' (default: false)"),
+		),
+		granularityProperty(),
+		precisionProperty(),
+	)
+}
+
+// handleConvertTime handles the convert_time tool
+func handleConvertTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	// Targets may be passed as an array or as a comma-separated string
+	to := request.GetStringSlice("to", nil)
+	if len(to) == 0 {
+		if s := request.GetString("to", ""); s != "" {
+			to = []string{s}
+		}
+	}
+
+	req := model.ConvertRequest{
+		Time:            request.GetString("time", ""),
+		From:            request.GetString("from", "UTC"),
+		To:              to,
+		Humanize:        request.GetBool("humanize", false),
+		HumanizeOptions: humanizeOptions(request),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("convert_time: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	source, err := resolver.Resolve(ctx, req.From)
+	if err != nil {
+		return zoneErrorResult(log, "convert_time", req.From, err), nil
+	}
+
+	now := time.Now()
+	instant, err := timeparse.Parse(req.Time, source.TZ, now)
+	if err != nil {
+		log.Warn("convert_time: invalid time", "time", req.Time, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid time '%s': %v", req.Time, err)), nil
+	}
+
+	response := &model.ConvertResponse{
+		Instant:  instant.UTC().Format(time.RFC3339Nano),
+		UnixTime: instant.Unix(),
+		Source:   req.ZonedTime(instant, source.Location, now),
+		Targets:  make([]*model.ZonedTime, 0, len(req.To)),
+	}
+	for _, name := range req.To {
+		target, err := resolver.Resolve(ctx, name)
+		if err != nil {
+			return zoneErrorResult(log, "convert_time", name, err), nil
+		}
+		response.Targets = append(response.Targets, req.ZonedTime(instant.In(target.TZ), target.Location, now))
+	}
+
+	log.Info("convert_time executed",
+		"from", req.From,
+		"to", req.To,
+		"instant", response.Instant,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.ConvertResponse
+	}{true, response})
+	if err != nil {
+		log.Error("convert_time: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// zoneErrorResult builds a tool error result for a zone resolution failure
+func zoneErrorResult(log *slog.Logger, tool, name string, err error) *mcp.CallToolResult {
+	if zone.IsClientError(err) {
+		log.Warn(tool+": invalid timezone or location", "zone", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid timezone or location '%s'", name))
+	}
+	log.Error(tool+": failed to resolve timezone", "zone", name, "error", err)
+	return mcp.NewToolResultError(fmt.Sprintf("Failed to resolve timezone '%s': %v", name, err))
+}
@@ -0,0 +1,203 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newTestResolver returns a resolver backed by a mock repository serving the given name→timezone pairs
+func newTestResolver(zones map[string]string) *zone.Resolver {
+	return zone.NewResolver(&mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			if tz, ok := zones[name]; ok {
+				return &model.Location{Name: name, Timezone: tz}, nil
+			}
+			return nil, repository.ErrLocationNotFound
+		},
+	})
+}
+
+// resultText returns the text of the first content item of a tool result
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+	if len(result.Content) == 0 {
+		t.Fatal("expected result content to be non-empty")
+	}
+	text, ok := result.Content[0].(mcp.TextContent)
+	if !ok {
+		t.Fatalf("expected text content, got %T", result.Content[0])
+	}
+	return text.Text
+}
+
+func TestHandleConvertTime(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"tokyo": "Asia/Tokyo"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.ConvertResponse)
+	}{
+		{
+			name: "berlin to tokyo",
+			arguments: map[string]interface{}{
+				"time": "2025-06-01 14:30",
+				"from": "Europe/Berlin",
+				"to":   []interface{}{"Asia/Tokyo", "America/New_York"},
+			},
+			check: func(t *testing.T, resp *model.ConvertResponse) {
+				if len(resp.Targets) != 2 {
+					t.Fatalf("expected 2 targets, got %d", len(resp.Targets))
+				}
+				if resp.Targets[0].Time != "2025-06-01T21:30:00+09:00" {
+					t.Errorf("unexpected Tokyo time %s", resp.Targets[0].Time)
+				}
+				if resp.Targets[1].Abbreviation != "EDT" || !resp.Targets[1].IsDST {
+					t.Errorf("unexpected New York target: %+v", resp.Targets[1])
+				}
+			},
+		},
+		{
+			name: "humanized in a saved location",
+			arguments: map[string]interface{}{
+				"time":        "2020-01-01T00:00:00Z",
+				"to":          []interface{}{"tokyo"},
+				"humanize":    true,
+				"granularity": "year",
+			},
+			check: func(t *testing.T, resp *model.ConvertResponse) {
+				if !strings.HasSuffix(resp.Targets[0].Humanized, " years ago") {
+					t.Errorf("unexpected description %q", resp.Targets[0].Humanized)
+				}
+			},
+		},
+		{
+			name: "invalid humanize granularity",
+			arguments: map[string]interface{}{
+				"to":          []interface{}{"UTC"},
+				"humanize":    true,
+				"granularity": "fortnight",
+			},
+			shouldError:  true,
+			errorMessage: "Validation failed: granularity",
+		},
+		{
+			name: "saved location target as comma-separated string",
+			arguments: map[string]interface{}{
+				"time": "2025-01-01T00:00:00Z",
+				"to":   "tokyo, UTC",
+			},
+			check: func(t *testing.T, resp *model.ConvertResponse) {
+				if len(resp.Targets) != 2 {
+					t.Fatalf("expected 2 targets, got %d", len(resp.Targets))
+				}
+				if resp.Targets[0].Location != "tokyo" || resp.Targets[0].LocalTime != "2025-01-01 09:00:00" {
+					t.Errorf("unexpected tokyo target: %+v", resp.Targets[0])
+				}
+			},
+		},
+		{
+			name:         "missing targets",
+			arguments:    map[string]interface{}{"from": "UTC"},
+			shouldError:  true,
+			errorMessage: "Validation failed",
+		},
+		{
+			name: "unknown target",
+			arguments: map[string]interface{}{
+				"to": []interface{}{"Mars/Olympus"},
+			},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'Mars/Olympus'",
+		},
+		{
+			name: "invalid time",
+			arguments: map[string]interface{}{
+				"time": "soon",
+				"to":   []interface{}{"UTC"},
+			},
+			shouldError:  true,
+			errorMessage: "Invalid time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleConvertTime(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp struct {
+				Success bool `json:"success"`
+				model.ConvertResponse
+			}
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if !resp.Success {
+				t.Error("expected success flag")
+			}
+			tt.check(t, &resp.ConvertResponse)
+		})
+	}
+}
+
+func TestHandleConvertTimeRepositoryError(t *testing.T) {
+	logger, logHandler := testutil.NewTestLogger()
+	resolver := zone.NewResolver(&mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			return nil, errors.New("database error")
+		},
+	})
+
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"from": "hq",
+				"to":   []interface{}{"UTC"},
+			},
+		},
+	}
+
+	result, err := handleConvertTime(context.Background(), request, logger, resolver)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Error("expected error result")
+	}
+	logHandler.AssertErrorCount(t, 1)
+}
@@ -0,0 +1,87 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newNextCronRunsTool returns the next_cron_runs tool definition
+func newNextCronRunsTool() mcp.Tool {
+	return mcp.NewTool("next_cron_runs",
+		mcp.WithDescription("Preview the next or previous fire times of a cron expression on a timezone's wall clock. Local times skipped by a DST change are listed in skipped, and local times that happen twice are returned for both instants and marked doubled"),
+		mcp.WithString("expression",
+			mcp.Required(),
+			mcp.Description("Cron expression with 5 fields (minute hour day-of-month month day-of-week), 6 fields with leading seconds, or a macro such as '@daily'"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location to evaluate the expression in (default: UTC)"),
+		),
+		mcp.WithString("from",
+			mcp.Description("Time to preview from, exclusive; values without an offset are local to timezone (default: now)"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description(fmt.Sprintf("Number of fire times to return (default: %d, max: %d)", model.DefaultCronRuns, model.MaxCronRuns)),
+		),
+		mcp.WithString("direction",
+			mcp.Description("'next' for fire times after from, or 'previous' for fire times before it, latest first (default: next)"),
+			mcp.Enum(model.CronDirectionNext, model.CronDirectionPrevious),
+		),
+	)
+}
+
+// handleNextCronRuns handles the next_cron_runs tool
+func handleNextCronRuns(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	var req model.CronPreviewRequest
+	if err := request.BindArguments(&req); err != nil {
+		log.Warn("next_cron_runs: invalid arguments", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("next_cron_runs: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "next_cron_runs", req.Timezone, err), nil
+	}
+
+	from, err := timeparse.Parse(req.From, z.TZ, time.Now())
+	if err != nil {
+		log.Warn("next_cron_runs: invalid from time", "from", req.From, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid from '%s': %v", req.From, err)), nil
+	}
+	from = from.In(z.TZ)
+
+	schedule, preview := req.Preview(from, z.TZ)
+	response := model.NewCronPreviewResponse(&req, schedule, preview, from, z.Location)
+
+	log.Info("next_cron_runs executed",
+		"expression", response.Normalized,
+		"timezone", response.Timezone,
+		"direction", response.Direction,
+		"count", response.Count,
+		"skipped", len(response.Skipped),
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.CronPreviewResponse
+	}{true, response})
+	if err != nil {
+		log.Error("next_cron_runs: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
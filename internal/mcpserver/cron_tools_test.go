@@ -0,0 +1,113 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleNextCronRuns(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.CronPreviewResponse)
+	}{
+		{
+			name: "repeated local time fires twice",
+			arguments: map[string]interface{}{
+				"expression": "30 1 * * *",
+				"timezone":   "America/New_York",
+				"from":       "2025-11-01T12:00:00",
+				"count":      float64(3),
+			},
+			check: func(t *testing.T, resp *model.CronPreviewResponse) {
+				if resp.Count != 3 {
+					t.Fatalf("expected 3 runs, got %d", resp.Count)
+				}
+				if resp.Runs[0].Offset != "-04:00" || !resp.Runs[0].Doubled || resp.Runs[1].Offset != "-05:00" || !resp.Runs[1].Doubled {
+					t.Errorf("expected both 01:30 runs on 2 November, got %+v %+v", resp.Runs[0], resp.Runs[1])
+				}
+				if resp.Runs[2].LocalTime != "2025-11-03 01:30:00" || resp.Runs[2].Doubled {
+					t.Errorf("unexpected third run: %+v", resp.Runs[2])
+				}
+			},
+		},
+		{
+			name: "previous runs with seconds field",
+			arguments: map[string]interface{}{
+				"expression": "0 0 9 * * MON",
+				"from":       "2025-06-10T00:00:00Z",
+				"count":      float64(2),
+				"direction":  "previous",
+			},
+			check: func(t *testing.T, resp *model.CronPreviewResponse) {
+				if resp.Count != 2 || resp.Runs[0].Time != "2025-06-09T09:00:00Z" || resp.Runs[1].Time != "2025-06-02T09:00:00Z" {
+					t.Errorf("unexpected runs: %+v", resp.Runs)
+				}
+			},
+		},
+		{
+			name:         "missing expression",
+			arguments:    map[string]interface{}{"timezone": "UTC"},
+			shouldError:  true,
+			errorMessage: "Validation failed: expression is required",
+		},
+		{
+			name:         "never fires",
+			arguments:    map[string]interface{}{"expression": "0 0 30 2 *"},
+			shouldError:  true,
+			errorMessage: "Validation failed: cron expression never fires",
+		},
+		{
+			name:         "unknown timezone",
+			arguments:    map[string]interface{}{"expression": "@daily", "timezone": "Mars/Olympus"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'Mars/Olympus'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			resolver := zone.NewResolver(&mockLocationRepository{})
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleNextCronRuns(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.CronPreviewResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
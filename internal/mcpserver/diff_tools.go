@@ -0,0 +1,100 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newTimeDifferenceTool returns the time_difference tool definition
+func newTimeDifferenceTool() mcp.Tool {
+	return mcp.NewTool("time_difference",
+		mcp.WithDescription("Calculate the exact and calendar (DST-aware) difference between two instants"),
+		mcp.WithString("start",
+			mcp.Required(),
+			mcp.Description("Start instant in any format parse_time detects, e.g. RFC3339, RFC1123, unix epoch, '2006-01-02 15:04' or '15:04'"),
+		),
+		mcp.WithString("end",
+			mcp.Required(),
+			mcp.Description("End instant in any format parse_time detects, e.g. RFC3339, RFC1123, unix epoch, '2006-01-02 15:04' or '15:04'"),
+		),
+		mcp.WithString("start_zone",
+			mcp.Description("IANA timezone or saved location name for the start instant (default: UTC)"),
+		),
+		mcp.WithString("end_zone",
+			mcp.Description("IANA timezone or saved location name for the end instant (default: start_zone)"),
+		),
+		mcp.WithString("zone",
+			mcp.Description("IANA timezone or saved location name for the calendar breakdown (default: start_zone)"),
+		),
+	)
+}
+
+// handleTimeDifference handles the time_difference tool
+func handleTimeDifference(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.DiffRequest{
+		Start:     request.GetString("start", ""),
+		End:       request.GetString("end", ""),
+		StartZone: request.GetString("start_zone", ""),
+		EndZone:   request.GetString("end_zone", ""),
+		Zone:      request.GetString("zone", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("time_difference: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	startZone, err := resolver.Resolve(ctx, req.StartZone)
+	if err != nil {
+		return zoneErrorResult(log, "time_difference", req.StartZone, err), nil
+	}
+	endZone, err := resolver.Resolve(ctx, req.EndZone)
+	if err != nil {
+		return zoneErrorResult(log, "time_difference", req.EndZone, err), nil
+	}
+	calendarZone, err := resolver.Resolve(ctx, req.Zone)
+	if err != nil {
+		return zoneErrorResult(log, "time_difference", req.Zone, err), nil
+	}
+
+	now := time.Now()
+	start, err := timeparse.Parse(req.Start, startZone.TZ, now)
+	if err != nil {
+		log.Warn("time_difference: invalid start time", "start", req.Start, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid start '%s': %v", req.Start, err)), nil
+	}
+	end, err := timeparse.Parse(req.End, endZone.TZ, now)
+	if err != nil {
+		log.Warn("time_difference: invalid end time", "end", req.End, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid end '%s': %v", req.End, err)), nil
+	}
+
+	response := model.NewDiffResponse(start, end, startZone.Location, endZone.Location, calendarZone.TZ)
+
+	log.Info("time_difference executed",
+		"start", response.Start.Time,
+		"end", response.End.Time,
+		"zone", response.Zone,
+		"iso8601", response.ISO8601,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.DiffResponse
+	}{true, response})
+	if err != nil {
+		log.Error("time_difference: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
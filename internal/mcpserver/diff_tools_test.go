@@ -0,0 +1,123 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleTimeDifference(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"hq": "America/New_York"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.DiffResponse)
+	}{
+		{
+			name: "across spring forward in saved location",
+			arguments: map[string]interface{}{
+				"start":      "2025-03-08 12:00",
+				"end":        "2025-03-09 12:00",
+				"start_zone": "hq",
+			},
+			check: func(t *testing.T, resp *model.DiffResponse) {
+				if resp.ISO8601 != "P1D" {
+					t.Errorf("expected P1D, got %s", resp.ISO8601)
+				}
+				if resp.DurationISO8601 != "PT23H" {
+					t.Errorf("expected PT23H, got %s", resp.DurationISO8601)
+				}
+				if resp.Start.Location != "hq" || resp.End.Location != "hq" {
+					t.Errorf("expected both instants in hq, got %q and %q", resp.Start.Location, resp.End.Location)
+				}
+			},
+		},
+		{
+			name: "different zones with explicit calendar zone",
+			arguments: map[string]interface{}{
+				"start":      "2025-01-01T09:00:00",
+				"start_zone": "Asia/Tokyo",
+				"end":        "2025-01-01T09:00:00",
+				"end_zone":   "Europe/London",
+				"zone":       "UTC",
+			},
+			check: func(t *testing.T, resp *model.DiffResponse) {
+				if resp.DurationSeconds != 9*3600 {
+					t.Errorf("expected 9 hours, got %v seconds", resp.DurationSeconds)
+				}
+				if resp.Zone != "UTC" {
+					t.Errorf("expected calendar zone UTC, got %s", resp.Zone)
+				}
+			},
+		},
+		{
+			name:         "missing end",
+			arguments:    map[string]interface{}{"start": "2025-01-01"},
+			shouldError:  true,
+			errorMessage: "end time is required",
+		},
+		{
+			name: "unknown zone",
+			arguments: map[string]interface{}{
+				"start": "2025-01-01",
+				"end":   "2025-01-02",
+				"zone":  "branch",
+			},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'branch'",
+		},
+		{
+			name: "invalid start",
+			arguments: map[string]interface{}{
+				"start": "last week",
+				"end":   "2025-01-02",
+			},
+			shouldError:  true,
+			errorMessage: "Invalid start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleTimeDifference(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.DiffResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
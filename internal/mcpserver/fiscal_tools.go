@@ -0,0 +1,172 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newGetFiscalDateTool returns the get_fiscal_date tool definition
+func newGetFiscalDateTool() mcp.Tool {
+	return mcp.NewTool("get_fiscal_date",
+		mcp.WithDescription("Find the fiscal year, quarter, period and week of an instant's local date in a saved fiscal calendar, e.g. which fiscal week it is today in the London office. Also returns the dates of that year, quarter, period and week"),
+		mcp.WithString("calendar",
+			mcp.Required(),
+			mcp.Description("Saved fiscal calendar name"),
+		),
+		mcp.WithString("time",
+			mcp.Description("Instant to look up, in any format parse_time detects (default: now)"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location whose local date to use (default: UTC)"),
+		),
+	)
+}
+
+// newListFiscalPeriodsTool returns the list_fiscal_periods tool definition
+func newListFiscalPeriodsTool() mcp.Tool {
+	return mcp.NewTool("list_fiscal_periods",
+		mcp.WithDescription("List the start and end dates of the quarters and twelve periods of a fiscal year in a saved fiscal calendar. Retail (4-4-5, 4-5-4, 5-4-4) periods include their number of weeks; 53-week years add the extra week to the last period"),
+		mcp.WithString("calendar",
+			mcp.Required(),
+			mcp.Description("Saved fiscal calendar name"),
+		),
+		mcp.WithNumber("year",
+			mcp.Description(fmt.Sprintf("Fiscal year, %d to %d (default: the current fiscal year)", model.MinFiscalYear, model.MaxFiscalYear)),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location whose local date gives the current fiscal year (default: UTC)"),
+		),
+	)
+}
+
+// handleGetFiscalDate handles the get_fiscal_date tool
+func handleGetFiscalDate(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver, calendars repository.FiscalCalendarRepository) (*mcp.CallToolResult, error) {
+	req := model.FiscalDateRequest{
+		Calendar: request.GetString("calendar", ""),
+		Time:     request.GetString("time", ""),
+		Timezone: request.GetString("timezone", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("get_fiscal_date: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	cal, errResult := getFiscalCalendar(ctx, log, "get_fiscal_date", calendars, req.Calendar)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "get_fiscal_date", req.Timezone, err), nil
+	}
+
+	t, err := timeparse.Parse(req.Time, z.TZ, time.Now())
+	if err != nil {
+		log.Warn("get_fiscal_date: invalid time", "time", req.Time, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid time '%s': %v", req.Time, err)), nil
+	}
+
+	response := model.NewFiscalDateResponse(cal, t.In(z.TZ), z.Location)
+
+	log.Info("get_fiscal_date executed",
+		"calendar", cal.Name,
+		"timezone", z.Name,
+		"label", response.Label,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.FiscalDateResponse
+	}{true, response})
+	if err != nil {
+		log.Error("get_fiscal_date: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// handleListFiscalPeriods handles the list_fiscal_periods tool
+func handleListFiscalPeriods(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver, calendars repository.FiscalCalendarRepository) (*mcp.CallToolResult, error) {
+	req := model.FiscalPeriodsRequest{
+		Calendar: request.GetString("calendar", ""),
+		Year:     request.GetInt("year", 0),
+		Timezone: request.GetString("timezone", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("list_fiscal_periods: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	cal, errResult := getFiscalCalendar(ctx, log, "list_fiscal_periods", calendars, req.Calendar)
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	year := req.Year
+	if year == 0 {
+		z, err := resolver.Resolve(ctx, req.Timezone)
+		if err != nil {
+			return zoneErrorResult(log, "list_fiscal_periods", req.Timezone, err), nil
+		}
+		year = cal.Calendar().YearOf(time.Now().In(z.TZ))
+	}
+
+	response := model.NewFiscalPeriodsResponse(cal, year)
+
+	log.Info("list_fiscal_periods executed",
+		"calendar", cal.Name,
+		"year", year,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.FiscalPeriodsResponse
+	}{true, response})
+	if err != nil {
+		log.Error("list_fiscal_periods: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// getFiscalCalendar looks up a saved fiscal calendar, returning an error
+// result naming the saved calendars when it does not exist
+func getFiscalCalendar(ctx context.Context, log *slog.Logger, tool string, calendars repository.FiscalCalendarRepository, name string) (*model.FiscalCalendar, *mcp.CallToolResult) {
+	cal, err := calendars.GetByName(ctx, name)
+	if err == nil {
+		return cal, nil
+	}
+	if !errors.Is(err, repository.ErrFiscalCalendarNotFound) {
+		log.Error(tool+": failed to get fiscal calendar", "calendar", name, "error", err)
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Failed to get fiscal calendar: %v", err))
+	}
+
+	log.Warn(tool+": fiscal calendar not found", "calendar", name)
+	saved, err := calendars.List(ctx)
+	if err != nil || len(saved) == 0 {
+		return nil, mcp.NewToolResultError(fmt.Sprintf("Fiscal calendar '%s' not found", name))
+	}
+	names := make([]string, len(saved))
+	for i, c := range saved {
+		names[i] = c.Name
+	}
+	return nil, mcp.NewToolResultError(fmt.Sprintf("Fiscal calendar '%s' not found; available calendars: %s", name, strings.Join(names, ", ")))
+}
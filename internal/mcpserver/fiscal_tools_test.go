@@ -0,0 +1,221 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// mockFiscalCalendarRepository is a read-only mock of FiscalCalendarRepository for testing
+type mockFiscalCalendarRepository struct {
+	calendars map[string]*model.FiscalCalendar
+}
+
+func (m *mockFiscalCalendarRepository) Create(ctx context.Context, cal *model.FiscalCalendar) error {
+	return nil
+}
+
+func (m *mockFiscalCalendarRepository) GetByName(ctx context.Context, name string) (*model.FiscalCalendar, error) {
+	if cal, ok := m.calendars[strings.ToLower(name)]; ok {
+		return cal, nil
+	}
+	return nil, repository.ErrFiscalCalendarNotFound
+}
+
+func (m *mockFiscalCalendarRepository) Update(ctx context.Context, name string, cal *model.FiscalCalendar) error {
+	return nil
+}
+
+func (m *mockFiscalCalendarRepository) Delete(ctx context.Context, name string) error {
+	return nil
+}
+
+func (m *mockFiscalCalendarRepository) List(ctx context.Context) ([]*model.FiscalCalendar, error) {
+	calendars := []*model.FiscalCalendar{}
+	for _, cal := range m.calendars {
+		calendars = append(calendars, cal)
+	}
+	sort.Slice(calendars, func(i, j int) bool { return calendars[i].Name < calendars[j].Name })
+	return calendars, nil
+}
+
+func newTestFiscalRepository() *mockFiscalCalendarRepository {
+	return &mockFiscalCalendarRepository{calendars: map[string]*model.FiscalCalendar{
+		"uk":     {Name: "uk", StartMonth: 4, Pattern: "monthly", WeekStart: "monday", YearLabel: "start"},
+		"retail": {Name: "retail", StartMonth: 2, Pattern: "4-5-4", WeekStart: "sunday", YearEnd: "nearest", YearLabel: "start"},
+	}}
+}
+
+func TestHandleGetFiscalDate(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"london": "Europe/London"})
+	calendars := newTestFiscalRepository()
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.FiscalDateResponse)
+	}{
+		{
+			name: "fiscal week in the london office",
+			arguments: map[string]interface{}{
+				"calendar": "UK",
+				"time":     "2025-10-16T09:00:00Z",
+				"timezone": "london",
+			},
+			check: func(t *testing.T, resp *model.FiscalDateResponse) {
+				if resp.Label != "FY2025 Q3 P7 W29" || resp.Time.Location != "london" || resp.PeriodSpan.Start != "2025-10-01" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name: "53rd retail week",
+			arguments: map[string]interface{}{
+				"calendar": "retail",
+				"time":     "2024-02-01T12:00:00Z",
+			},
+			check: func(t *testing.T, resp *model.FiscalDateResponse) {
+				if resp.FiscalYear != 2023 || resp.Week != 53 || resp.WeeksInYear != 53 || resp.PeriodSpan.Weeks != 5 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "unknown calendar lists the saved ones",
+			arguments:    map[string]interface{}{"calendar": "us-gov"},
+			shouldError:  true,
+			errorMessage: "Fiscal calendar 'us-gov' not found; available calendars: retail, uk",
+		},
+		{
+			name:         "missing calendar",
+			arguments:    map[string]interface{}{},
+			shouldError:  true,
+			errorMessage: "Validation failed: calendar name cannot be empty",
+		},
+		{
+			name:         "unknown zone",
+			arguments:    map[string]interface{}{"calendar": "uk", "timezone": "paris-office"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'paris-office'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleGetFiscalDate(context.Background(), request, logger, resolver, calendars)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.FiscalDateResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
+
+func TestHandleListFiscalPeriods(t *testing.T) {
+	resolver := newTestResolver(nil)
+	calendars := newTestFiscalRepository()
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.FiscalPeriodsResponse)
+	}{
+		{
+			name:      "monthly year",
+			arguments: map[string]interface{}{"calendar": "uk", "year": float64(2026)},
+			check: func(t *testing.T, resp *model.FiscalPeriodsResponse) {
+				if resp.Year.Start != "2026-04-01" || resp.Year.End != "2027-03-31" || resp.Periods[10].Start != "2027-02-01" || resp.Periods[10].Days != 28 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:      "current retail year",
+			arguments: map[string]interface{}{"calendar": "retail"},
+			check: func(t *testing.T, resp *model.FiscalPeriodsResponse) {
+				if resp.Pattern != "4-5-4" || len(resp.Periods) != 12 || resp.Year.Weeks < 52 {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "year out of range",
+			arguments:    map[string]interface{}{"calendar": "uk", "year": float64(3000)},
+			shouldError:  true,
+			errorMessage: "Validation failed: year must be between 1900 and 2200",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleListFiscalPeriods(context.Background(), request, logger, resolver, calendars)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.FiscalPeriodsResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newIsHolidayTool returns the is_holiday tool definition
+func newIsHolidayTool() mcp.Tool {
+	return mcp.NewTool("is_holiday",
+		mcp.WithDescription("Check whether a date is a holiday at a saved location, using the holiday calendars attached to the location and its custom company holidays"),
+		mcp.WithString("location",
+			mcp.Required(),
+			mcp.Description("Saved location name"),
+		),
+		mcp.WithString("date",
+			mcp.Description("Date to check (YYYY-MM-DD, default: today in the location's timezone)"),
+		),
+	)
+}
+
+// handleIsHoliday handles the is_holiday tool
+func handleIsHoliday(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, locations repository.LocationRepository, holidays repository.HolidayRepository) (*mcp.CallToolResult, error) {
+	name := request.GetString("location", "")
+	if name == "" {
+		log.Warn("is_holiday: missing required parameter", "parameter", "location")
+		return mcp.NewToolResultError("Parameter 'location' is required"), nil
+	}
+
+	loc, err := locations.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrLocationNotFound) {
+			log.Warn("is_holiday: location not found", "location", name)
+			return mcp.NewToolResultError(fmt.Sprintf("Location '%s' not found", name)), nil
+		}
+		log.Error("is_holiday: failed to get location", "location", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get location: %v", err)), nil
+	}
+
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		log.Error("is_holiday: failed to load timezone", "location", name, "timezone", loc.Timezone, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid timezone '%s': %v", loc.Timezone, err)), nil
+	}
+
+	date := time.Now().In(tz)
+	if s := request.GetString("date", ""); s != "" {
+		if err := model.ValidateHolidayDate(s); err != nil {
+			log.Warn("is_holiday: validation failed", "date", s, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+		}
+		date, _ = time.ParseInLocation(model.HolidayDateLayout, s, tz)
+	}
+
+	day := date.Format(model.HolidayDateLayout)
+	found, err := holidays.ListHolidays(ctx, loc.Name, day, day)
+	if err != nil {
+		log.Error("is_holiday: failed to list holidays", "location", name, "date", day, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list holidays: %v", err)), nil
+	}
+
+	response := model.NewHolidayCheckResponse(loc, date, found)
+
+	log.Info("is_holiday executed",
+		"location", loc.Name,
+		"date", response.Date,
+		"is_holiday", response.IsHoliday,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.HolidayCheckResponse
+	}{true, response})
+	if err != nil {
+		log.Error("is_holiday: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,170 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// mockHolidayRepository is a mock implementation of HolidayRepository for testing
+type mockHolidayRepository struct {
+	listHolidaysFunc func(ctx context.Context, location, from, to string) ([]*model.Holiday, error)
+}
+
+func (m *mockHolidayRepository) ImportCalendar(ctx context.Context, cal *model.HolidayCalendar) error {
+	return nil
+}
+
+func (m *mockHolidayRepository) ListCalendars(ctx context.Context) ([]*model.HolidayCalendar, error) {
+	return []*model.HolidayCalendar{}, nil
+}
+
+func (m *mockHolidayRepository) LocationCalendars(ctx context.Context, location string) ([]string, error) {
+	return []string{}, nil
+}
+
+func (m *mockHolidayRepository) SetLocationCalendars(ctx context.Context, location string, calendars []string) error {
+	return nil
+}
+
+func (m *mockHolidayRepository) ListHolidays(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+	if m.listHolidaysFunc != nil {
+		return m.listHolidaysFunc(ctx, location, from, to)
+	}
+	return []*model.Holiday{}, nil
+}
+
+func (m *mockHolidayRepository) CreateCustomHoliday(ctx context.Context, location string, h *model.Holiday) error {
+	return nil
+}
+
+func (m *mockHolidayRepository) UpdateCustomHoliday(ctx context.Context, location string, id int64, h *model.Holiday) error {
+	return nil
+}
+
+func (m *mockHolidayRepository) DeleteCustomHoliday(ctx context.Context, location string, id int64) error {
+	return nil
+}
+
+func TestHandleIsHoliday(t *testing.T) {
+	locations := &mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			if name != "london" {
+				return nil, repository.ErrLocationNotFound
+			}
+			return &model.Location{Name: name, Timezone: "Europe/London"}, nil
+		},
+	}
+	holidays := &mockHolidayRepository{
+		listHolidaysFunc: func(ctx context.Context, location, from, to string) ([]*model.Holiday, error) {
+			if from == "2025-12-25" && to == from {
+				return []*model.Holiday{{ID: 1, Date: from, Name: "Christmas Day", Calendar: "uk-bank"}}, nil
+			}
+			return []*model.Holiday{}, nil
+		},
+	}
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		wantHoliday  bool
+		wantDate     string
+		wantWeekday  string
+		todayIn      string
+	}{
+		{
+			name:        "holiday",
+			arguments:   map[string]interface{}{"location": "london", "date": "2025-12-25"},
+			wantHoliday: true,
+			wantDate:    "2025-12-25",
+			wantWeekday: "Thursday",
+		},
+		{
+			name:        "working day",
+			arguments:   map[string]interface{}{"location": "london", "date": "2025-12-29"},
+			wantDate:    "2025-12-29",
+			wantWeekday: "Monday",
+		},
+		{
+			name:      "defaults to today in the location's timezone",
+			arguments: map[string]interface{}{"location": "london"},
+			todayIn:   "Europe/London",
+		},
+		{
+			name:         "missing location",
+			arguments:    map[string]interface{}{},
+			shouldError:  true,
+			errorMessage: "Parameter 'location' is required",
+		},
+		{
+			name:         "unknown location",
+			arguments:    map[string]interface{}{"location": "nowhere"},
+			shouldError:  true,
+			errorMessage: "Location 'nowhere' not found",
+		},
+		{
+			name:         "invalid date",
+			arguments:    map[string]interface{}{"location": "london", "date": "25/12/2025"},
+			shouldError:  true,
+			errorMessage: "Validation failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleIsHoliday(context.Background(), request, logger, locations, holidays)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.HolidayCheckResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+
+			if tt.todayIn != "" {
+				tz, _ := time.LoadLocation(tt.todayIn)
+				if today := time.Now().In(tz).Format(model.HolidayDateLayout); resp.Date != today {
+					t.Errorf("expected date %s, got %s", today, resp.Date)
+				}
+				return
+			}
+			if resp.IsHoliday != tt.wantHoliday || resp.Date != tt.wantDate || resp.Weekday != tt.wantWeekday {
+				t.Errorf("unexpected response: %+v", resp)
+			}
+			if tt.wantHoliday && (len(resp.Holidays) != 1 || resp.Holidays[0].Calendar != "uk-bank") {
+				t.Errorf("unexpected holidays: %+v", resp.Holidays)
+			}
+		})
+	}
+}
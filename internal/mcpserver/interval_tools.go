@@ -0,0 +1,113 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newCombineIntervalsTool returns the combine_intervals tool definition
+func newCombineIntervalsTool() mcp.Tool {
+	intervalProperties := map[string]any{
+		"start":    map[string]any{"type": "string", "description": "Start of the interval: RFC3339, unix seconds, or a local '2006-01-02 15:04'"},
+		"end":      map[string]any{"type": "string", "description": "End of the interval, exclusive, in the same formats"},
+		"timezone": map[string]any{"type": "string", "description": "Timezone or saved location for local times (default: the set's timezone)"},
+	}
+
+	return mcp.NewTool("combine_intervals",
+		mcp.WithDescription("Combine sets of time intervals given as instants or local times in different zones: union merges overlapping ranges, intersection finds common availability, difference removes busy time from the first set, and gaps finds free time within a window. Results are normalized to UTC and shown in each requested zone"),
+		mcp.WithString("operation",
+			mcp.Required(),
+			mcp.Description("Operation to apply"),
+			mcp.Enum(model.IntervalUnion, model.IntervalIntersection, model.IntervalDifference, model.IntervalGaps),
+		),
+		mcp.WithArray("sets",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Interval sets (1 to %d); intervals within a set may overlap", model.MaxIntervalSets)),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":     map[string]any{"type": "string", "description": "Label for the set"},
+					"timezone": map[string]any{"type": "string", "description": "Timezone or saved location for local times in this set (default: timezone)"},
+					"intervals": map[string]any{
+						"type":  "array",
+						"items": map[string]any{"type": "object", "properties": intervalProperties, "required": []string{"start", "end"}},
+					},
+				},
+				"required": []string{"intervals"},
+			}),
+		),
+		mcp.WithObject("window",
+			mcp.Description("Bounding window for the result; required for gaps"),
+			mcp.Properties(intervalProperties),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("Default timezone or saved location for local times (default: UTC)"),
+		),
+		mcp.WithArray("zones",
+			mcp.Description("Timezones or saved locations to show the result in (default: the timezones of the sets)"),
+			mcp.WithStringItems(),
+		),
+	)
+}
+
+// handleCombineIntervals handles the combine_intervals tool
+func handleCombineIntervals(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	var req model.IntervalsRequest
+	if err := request.BindArguments(&req); err != nil {
+		log.Warn("combine_intervals: invalid arguments", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("combine_intervals: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	resolved := make(map[string]*zone.Zone)
+	zones := make(map[string]*time.Location)
+	for _, name := range req.ZoneNames() {
+		z, err := resolver.Resolve(ctx, name)
+		if err != nil {
+			return zoneErrorResult(log, "combine_intervals", name, err), nil
+		}
+		resolved[name] = z
+		zones[name] = z.TZ
+	}
+
+	sets, window, err := req.Parse(zones, time.Now())
+	if err != nil {
+		log.Warn("combine_intervals: invalid interval", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid interval: %v", err)), nil
+	}
+
+	outputs := make([]model.IntervalZone, len(req.Zones))
+	for i, name := range req.Zones {
+		outputs[i] = model.IntervalZone{Name: name, Location: resolved[name].Location, TZ: resolved[name].TZ}
+	}
+	response := model.NewIntervalsResponse(req.Operation, req.Apply(sets, window), window, outputs)
+
+	log.Info("combine_intervals executed",
+		"operation", req.Operation,
+		"sets", len(req.Sets),
+		"count", response.Count,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.IntervalsResponse
+	}{true, response})
+	if err != nil {
+		log.Error("combine_intervals: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
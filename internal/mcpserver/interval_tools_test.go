@@ -0,0 +1,142 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleCombineIntervals(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"london": "Europe/London", "new-york": "America/New_York"})
+
+	workday := func(zone string) map[string]interface{} {
+		return map[string]interface{}{
+			"timezone":  zone,
+			"intervals": []interface{}{map[string]interface{}{"start": "2025-01-06 09:00", "end": "2025-01-06 17:00"}},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.IntervalsResponse)
+	}{
+		{
+			name: "common working hours",
+			arguments: map[string]interface{}{
+				"operation": "intersection",
+				"sets":      []interface{}{workday("london"), workday("new-york")},
+			},
+			check: func(t *testing.T, resp *model.IntervalsResponse) {
+				if resp.Count != 1 || resp.Intervals[0].Start != "2025-01-06T14:00:00Z" || resp.Intervals[0].DurationSeconds != 10800 {
+					t.Fatalf("unexpected intervals: %+v", resp.Intervals)
+				}
+				if local := resp.Intervals[0].Local[0]; local.Location != "london" || local.End != "2025-01-06T17:00:00Z" {
+					t.Errorf("unexpected London time: %+v", local)
+				}
+			},
+		},
+		{
+			name: "busy time removed",
+			arguments: map[string]interface{}{
+				"operation": "difference",
+				"sets": []interface{}{
+					workday("london"),
+					map[string]interface{}{"intervals": []interface{}{map[string]interface{}{"start": "2025-01-06T12:00:00Z", "end": "2025-01-06T13:00:00Z"}}},
+				},
+				"zones": []interface{}{"new-york"},
+			},
+			check: func(t *testing.T, resp *model.IntervalsResponse) {
+				if resp.Count != 2 || resp.TotalSeconds != 7*3600 {
+					t.Fatalf("unexpected intervals: %+v", resp.Intervals)
+				}
+				if local := resp.Intervals[1].Local; len(local) != 1 || local[0].Start != "2025-01-06T08:00:00-05:00" {
+					t.Errorf("unexpected New York time: %+v", local)
+				}
+			},
+		},
+		{
+			name: "gaps",
+			arguments: map[string]interface{}{
+				"operation": "gaps",
+				"sets":      []interface{}{workday("london")},
+				"window":    map[string]interface{}{"start": "2025-01-06", "end": "2025-01-07", "timezone": "london"},
+			},
+			check: func(t *testing.T, resp *model.IntervalsResponse) {
+				if resp.Count != 2 || resp.Intervals[0].End != "2025-01-06T09:00:00Z" || resp.Intervals[1].Start != "2025-01-06T17:00:00Z" {
+					t.Errorf("unexpected gaps: %+v", resp.Intervals)
+				}
+			},
+		},
+		{
+			name:         "unknown operation",
+			arguments:    map[string]interface{}{"operation": "xor", "sets": []interface{}{workday("london")}},
+			shouldError:  true,
+			errorMessage: "Validation failed",
+		},
+		{
+			name:         "invalid arguments",
+			arguments:    map[string]interface{}{"operation": "union", "sets": "london"},
+			shouldError:  true,
+			errorMessage: "Invalid arguments",
+		},
+		{
+			name:         "unknown zone",
+			arguments:    map[string]interface{}{"operation": "union", "sets": []interface{}{workday("atlantis")}},
+			shouldError:  true,
+			errorMessage: "atlantis",
+		},
+		{
+			name: "unparseable time",
+			arguments: map[string]interface{}{
+				"operation": "union",
+				"sets":      []interface{}{map[string]interface{}{"intervals": []interface{}{map[string]interface{}{"start": "soon", "end": "later"}}}},
+			},
+			shouldError:  true,
+			errorMessage: "Invalid interval: sets[0].intervals[0]: invalid start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleCombineIntervals(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.IntervalsResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
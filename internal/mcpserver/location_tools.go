@@ -10,9 +10,38 @@ import (
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
 	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timefmt"
 )
 
+// newAddLocationTool returns the add_location tool definition
+func newAddLocationTool() mcp.Tool {
+	return mcp.NewTool("add_location",
+		mcp.WithDescription("Add a named location with timezone"),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Location name (alphanumeric, hyphens, and underscores only)"),
+		),
+		mcp.WithString("timezone",
+			mcp.Required(),
+			mcp.Description("IANA timezone (e.g., America/New_York, Europe/London, Asia/Tokyo)"),
+		),
+		mcp.WithString("description",
+			mcp.Description("Optional description of the location"),
+		),
+		businessHoursProperty("Optional weekly business hours with date-specific overrides"),
+		weekendProperty("Optional weekend days, e.g. ['friday', 'saturday'] (default: saturday and sunday)"),
+		tagsProperty("Optional tags grouping the location, e.g. ['emea', 'engineering']"),
+		mcp.WithNumber("latitude",
+			mcp.Description("Optional latitude in decimal degrees, north positive; requires longitude"),
+		),
+		mcp.WithNumber("longitude",
+			mcp.Description("Optional longitude in decimal degrees, east positive; requires latitude"),
+		),
+	)
+}
+
 // handleAddLocation handles the add_location tool
 func handleAddLocation(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, repo repository.LocationRepository) (*mcp.CallToolResult, error) {
 	// Extract arguments with validation
@@ -30,8 +59,38 @@ func handleAddLocation(ctx context.Context, request mcp.CallToolRequest, log *sl
 
 	description := request.GetString("description", "")
 
+	hours, err := businessHoursArg(request)
+	if err != nil {
+		log.Warn("add_location: invalid business hours", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid business_hours: %v", err)), nil
+	}
+
+	weekend, err := weekendArg(request)
+	if err != nil {
+		log.Warn("add_location: invalid weekend", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid weekend: %v", err)), nil
+	}
+
+	tags, err := tagsArg(request)
+	if err != nil {
+		log.Warn("add_location: invalid tags", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid tags: %v", err)), nil
+	}
+
+	coordinates, err := coordinatesArg(request)
+	if err != nil {
+		log.Warn("add_location: invalid coordinates", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid coordinates: %v", err)), nil
+	}
+
 	// Create location model
 	loc := model.NewLocation(name, timezone, description)
+	if !hours.IsEmpty() {
+		loc.BusinessHours = hours
+	}
+	loc.Weekend = weekend
+	loc.Tags = tags
+	loc.Coordinates = coordinates
 
 	// Validate
 	if err := loc.Validate(); err != nil {
@@ -67,12 +126,16 @@ func handleAddLocation(ctx context.Context, request mcp.CallToolRequest, log *sl
 		"success": true,
 		"message": fmt.Sprintf("Location '%s' added successfully", loc.Name),
 		"location": map[string]interface{}{
-			"id":          loc.ID,
-			"name":        loc.Name,
-			"timezone":    loc.Timezone,
-			"description": loc.Description,
-			"created_at":  loc.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			"updated_at":  loc.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"id":             loc.ID,
+			"name":           loc.Name,
+			"timezone":       loc.Timezone,
+			"description":    loc.Description,
+			"business_hours": loc.BusinessHours,
+			"weekend":        loc.EffectiveWeekend(),
+			"tags":           loc.Tags,
+			"coordinates":    loc.Coordinates,
+			"created_at":     loc.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"updated_at":     loc.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		},
 	}
 
@@ -123,6 +186,35 @@ func handleRemoveLocation(ctx context.Context, request mcp.CallToolRequest, log
 	return mcp.NewToolResultText(string(responseJSON)), nil
 }
 
+// newUpdateLocationTool returns the update_location tool definition
+func newUpdateLocationTool() mcp.Tool {
+	return mcp.NewTool("update_location",
+		mcp.WithDescription("Update a location's timezone, description, business hours, weekend, tags or coordinates. Arguments left out keep their current values; clear_coordinates removes the coordinates"),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Location name to update"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("New IANA timezone (optional)"),
+		),
+		mcp.WithString("description",
+			mcp.Description("New description; an empty string clears it (optional)"),
+		),
+		businessHoursProperty("New business hours replacing the current ones; an empty object clears them (optional)"),
+		weekendProperty("New weekend days replacing the current ones; an empty list means no weekend (optional)"),
+		tagsProperty("New tags replacing the current ones; an empty list clears them (optional)"),
+		mcp.WithNumber("latitude",
+			mcp.Description("New latitude in decimal degrees, given with longitude (optional)"),
+		),
+		mcp.WithNumber("longitude",
+			mcp.Description("New longitude in decimal degrees, given with latitude (optional)"),
+		),
+		mcp.WithBoolean("clear_coordinates",
+			mcp.Description("Remove the stored latitude and longitude (optional)"),
+		),
+	)
+}
+
 // handleUpdateLocation handles the update_location tool
 func handleUpdateLocation(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, repo repository.LocationRepository) (*mcp.CallToolResult, error) {
 	// Extract arguments
@@ -133,12 +225,44 @@ func handleUpdateLocation(ctx context.Context, request mcp.CallToolRequest, log
 	}
 
 	timezone := request.GetString("timezone", "")
+	// An empty description clears it, so only a missing one keeps the stored value
+	_, hasDescription := request.GetArguments()["description"]
 	description := request.GetString("description", "")
 
+	hours, err := businessHoursArg(request)
+	if err != nil {
+		log.Warn("update_location: invalid business hours", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid business_hours: %v", err)), nil
+	}
+
+	weekend, err := weekendArg(request)
+	if err != nil {
+		log.Warn("update_location: invalid weekend", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid weekend: %v", err)), nil
+	}
+
+	tags, err := tagsArg(request)
+	if err != nil {
+		log.Warn("update_location: invalid tags", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid tags: %v", err)), nil
+	}
+
+	coordinates, err := coordinatesArg(request)
+	if err != nil {
+		log.Warn("update_location: invalid coordinates", "name", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid coordinates: %v", err)), nil
+	}
+
+	clearCoordinates := request.GetBool("clear_coordinates", false)
+	if clearCoordinates && coordinates != nil {
+		log.Warn("update_location: invalid coordinates", "name", name, "error", model.ErrCoordinatesCleared)
+		return mcp.NewToolResultError("Invalid coordinates: latitude and longitude cannot be given with clear_coordinates"), nil
+	}
+
 	// At least one field must be provided
-	if timezone == "" && description == "" {
+	if timezone == "" && !hasDescription && hours == nil && weekend == nil && tags == nil && coordinates == nil && !clearCoordinates {
 		log.Warn("update_location: no fields to update", "name", name)
-		return mcp.NewToolResultError("At least one of 'timezone' or 'description' must be provided"), nil
+		return mcp.NewToolResultError("At least one of 'timezone', 'description', 'business_hours', 'weekend', 'tags', 'latitude' and 'longitude' or 'clear_coordinates' must be provided"), nil
 	}
 
 	// Get existing location
@@ -168,7 +292,49 @@ func handleUpdateLocation(ctx context.Context, request mcp.CallToolRequest, log
 		}
 		existing.Timezone = timezone
 	}
-	existing.Description = description
+
+	// Replace the description when provided; an empty string clears it
+	if hasDescription {
+		existing.Description = description
+	}
+
+	// Replace business hours when provided; an empty schedule clears them
+	if hours != nil {
+		if err := hours.Validate(); err != nil {
+			log.Warn("update_location: invalid business hours", "name", name, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid business_hours: %v", err)), nil
+		}
+		existing.BusinessHours = hours
+		if hours.IsEmpty() {
+			existing.BusinessHours = nil
+		}
+	}
+
+	// Replace weekend days when provided; an empty list means no weekend
+	if weekend != nil {
+		if err := model.ValidateWeekend(weekend); err != nil {
+			log.Warn("update_location: invalid weekend", "name", name, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid weekend: %v", err)), nil
+		}
+		existing.Weekend = weekend
+	}
+
+	// Replace tags when provided; an empty list clears them
+	if tags != nil {
+		if err := model.ValidateTags(tags); err != nil {
+			log.Warn("update_location: invalid tags", "name", name, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Invalid tags: %v", err)), nil
+		}
+		existing.Tags = tags
+	}
+
+	// Replace the position when provided, or remove it
+	if coordinates != nil {
+		existing.Coordinates = coordinates
+	}
+	if clearCoordinates {
+		existing.Coordinates = nil
+	}
 
 	// Update in repository
 	if err := repo.Update(ctx, name, existing); err != nil {
@@ -192,12 +358,16 @@ func handleUpdateLocation(ctx context.Context, request mcp.CallToolRequest, log
 		"success": true,
 		"message": fmt.Sprintf("Location '%s' updated successfully", name),
 		"location": map[string]interface{}{
-			"id":          existing.ID,
-			"name":        existing.Name,
-			"timezone":    existing.Timezone,
-			"description": existing.Description,
-			"created_at":  existing.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			"updated_at":  existing.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"id":             existing.ID,
+			"name":           existing.Name,
+			"timezone":       existing.Timezone,
+			"description":    existing.Description,
+			"business_hours": existing.BusinessHours,
+			"weekend":        existing.EffectiveWeekend(),
+			"tags":           existing.Tags,
+			"coordinates":    existing.Coordinates,
+			"created_at":     existing.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"updated_at":     existing.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		},
 	}
 
@@ -225,12 +395,16 @@ func handleListLocations(ctx context.Context, request mcp.CallToolRequest, log *
 	locationList := make([]map[string]interface{}, len(locations))
 	for i, loc := range locations {
 		locationList[i] = map[string]interface{}{
-			"id":          loc.ID,
-			"name":        loc.Name,
-			"timezone":    loc.Timezone,
-			"description": loc.Description,
-			"created_at":  loc.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-			"updated_at":  loc.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"id":             loc.ID,
+			"name":           loc.Name,
+			"timezone":       loc.Timezone,
+			"description":    loc.Description,
+			"business_hours": loc.BusinessHours,
+			"weekend":        loc.EffectiveWeekend(),
+			"tags":           loc.Tags,
+			"coordinates":    loc.Coordinates,
+			"created_at":     loc.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			"updated_at":     loc.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		}
 	}
 
@@ -249,6 +423,20 @@ func handleListLocations(ctx context.Context, request mcp.CallToolRequest, log *
 	return mcp.NewToolResultText(string(responseJSON)), nil
 }
 
+// newGetLocationTimeTool returns the get_location_time tool definition
+func newGetLocationTimeTool() mcp.Tool {
+	return mcp.NewTool("get_location_time",
+		mcp.WithDescription("Get the current time for a named location, with its open/closed status when business hours are set"),
+		mcp.WithString("name",
+			mcp.Required(),
+			mcp.Description("Location name"),
+		),
+		formatProperty("rfc3339"),
+		relativeToProperty(),
+		localeProperty("Locale for month and day names, 12/24-hour clock and date order; adds the time in the long style of the locale as 'localized'"),
+	)
+}
+
 // handleGetLocationTime handles the get_location_time tool
 func handleGetLocationTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, repo repository.LocationRepository) (*mcp.CallToolResult, error) {
 	// Extract arguments
@@ -259,6 +447,11 @@ func handleGetLocationTime(ctx context.Context, request mcp.CallToolRequest, log
 	}
 
 	format := request.GetString("format", "rfc3339")
+	locale, err := toolLocale(request)
+	if err != nil {
+		log.Warn("get_location_time: invalid locale", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid locale: %v", err)), nil
+	}
 
 	// Get location from repository
 	loc, err := repo.GetByName(ctx, name)
@@ -287,19 +480,13 @@ func handleGetLocationTime(ctx context.Context, request mcp.CallToolRequest, log
 
 	// Get current time in location's timezone
 	now := time.Now().In(tz)
+	status := model.NewOpenStatus(loc.BusinessHours, tz, now)
 
 	// Format the time
-	var formatted string
-	switch format {
-	case "rfc3339", "iso8601":
-		formatted = now.Format("2006-01-02T15:04:05Z07:00")
-	case "unix":
-		formatted = fmt.Sprintf("%d", now.Unix())
-	case "unixmilli":
-		formatted = fmt.Sprintf("%d", now.UnixMilli())
-	default:
-		// Custom format
-		formatted = now.Format(format)
+	formatted, err := locale.Format(now, format)
+	if err != nil {
+		log.Warn("get_location_time: invalid format", "format", format, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid format '%s': %v", format, err)), nil
 	}
 
 	log.Info("get_location_time executed",
@@ -307,15 +494,31 @@ func handleGetLocationTime(ctx context.Context, request mcp.CallToolRequest, log
 		"timezone", loc.Timezone,
 		"format", format,
 		"time", formatted,
+		"status", status.Status,
 	)
 
 	response := map[string]interface{}{
 		"success":      true,
 		"location":     loc.Name,
 		"timezone":     loc.Timezone,
-		"current_time": now.Format("2006-01-02T15:04:05Z07:00"),
+		"current_time": now.Format(time.RFC3339),
 		"unix_time":    now.Unix(),
 		"formatted":    formatted,
+		"status":       status,
+	}
+	if locale != nil {
+		response["locale"] = locale.Tag
+		response["localized"], _ = locale.Format(now, timefmt.DefaultStyle)
+	}
+
+	// Describe the location's offset relative to the caller's zone
+	if relativeTo := request.GetString("relative_to", ""); relativeTo != "" {
+		caller, err := zone.NewResolver(repo).Resolve(ctx, relativeTo)
+		if err != nil {
+			return zoneErrorResult(log, "get_location_time", relativeTo, err), nil
+		}
+		response["relative_to"] = relativeTo
+		response["relative_offset"] = model.RelativeOffset(now, caller.TZ)
 	}
 
 	responseJSON, err := json.Marshal(response)
@@ -326,3 +529,135 @@ func handleGetLocationTime(ctx context.Context, request mcp.CallToolRequest, log
 
 	return mcp.NewToolResultText(string(responseJSON)), nil
 }
+
+// businessHoursProperty returns the schema option for a business_hours argument
+func businessHoursProperty(description string) mcp.ToolOption {
+	interval := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"open":  map[string]any{"type": "string", "description": "Local opening time (HH:MM)"},
+			"close": map[string]any{"type": "string", "description": "Local closing time (HH:MM, '24:00' for midnight; before open runs past midnight)"},
+		},
+		"required": []string{"open", "close"},
+	}
+	intervals := map[string]any{"type": "array", "items": interval}
+
+	return mcp.WithObject("business_hours",
+		mcp.Description(description),
+		mcp.Properties(map[string]any{
+			"weekly": map[string]any{
+				"type":                 "object",
+				"description":          "Opening intervals keyed by weekday name (monday to sunday); missing days are closed",
+				"additionalProperties": intervals,
+			},
+			"overrides": map[string]any{
+				"type":        "array",
+				"description": "Date-specific hours replacing the weekly ones; no intervals means closed all day",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"date":      map[string]any{"type": "string", "description": "Local date (YYYY-MM-DD)"},
+						"intervals": intervals,
+						"note":      map[string]any{"type": "string", "description": "Optional note such as a holiday name"},
+					},
+					"required": []string{"date"},
+				},
+			},
+		}),
+	)
+}
+
+// businessHoursArg decodes the optional business_hours argument, returning nil when absent
+func businessHoursArg(request mcp.CallToolRequest) (*model.BusinessHours, error) {
+	raw, ok := request.GetArguments()["business_hours"]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var hours model.BusinessHours
+	if err := json.Unmarshal(data, &hours); err != nil {
+		return nil, err
+	}
+	hours.Normalize()
+	return &hours, nil
+}
+
+// weekendProperty returns the schema option for a weekend argument
+func weekendProperty(description string) mcp.ToolOption {
+	return mcp.WithArray("weekend",
+		mcp.Description(description),
+		mcp.WithStringItems(),
+	)
+}
+
+// weekendArg decodes the optional weekend argument, returning nil when absent
+func weekendArg(request mcp.CallToolRequest) ([]string, error) {
+	days, err := stringListArg(request, "weekend")
+	if err != nil {
+		return nil, err
+	}
+	return model.NormalizeWeekend(days), nil
+}
+
+// tagsProperty returns the schema option for a tags argument
+func tagsProperty(description string) mcp.ToolOption {
+	return mcp.WithArray("tags",
+		mcp.Description(description),
+		mcp.WithStringItems(),
+	)
+}
+
+// tagsArg decodes the optional tags argument, returning nil when absent
+func tagsArg(request mcp.CallToolRequest) ([]string, error) {
+	tags, err := stringListArg(request, "tags")
+	if err != nil {
+		return nil, err
+	}
+	return model.NormalizeTags(tags), nil
+}
+
+// coordinatesArg decodes the optional latitude and longitude arguments,
+// returning nil when both are absent
+func coordinatesArg(request mcp.CallToolRequest) (*model.Coordinates, error) {
+	args := request.GetArguments()
+	_, hasLatitude := args["latitude"]
+	_, hasLongitude := args["longitude"]
+	if !hasLatitude && !hasLongitude {
+		return nil, nil
+	}
+	if hasLatitude != hasLongitude {
+		return nil, errors.New("latitude and longitude must be given together")
+	}
+
+	coordinates := &model.Coordinates{
+		Latitude:  request.GetFloat("latitude", 0),
+		Longitude: request.GetFloat("longitude", 0),
+	}
+	if err := model.ValidateCoordinates(coordinates); err != nil {
+		return nil, err
+	}
+	return coordinates, nil
+}
+
+// stringListArg decodes an optional array of strings argument, returning nil
+// when absent and an empty list when empty
+func stringListArg(request mcp.CallToolRequest, name string) ([]string, error) {
+	raw, ok := request.GetArguments()[name]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	values := []string{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -2,7 +2,9 @@ package mcpserver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -77,6 +79,101 @@ func TestHandleAddLocation(t *testing.T) {
 			},
 			shouldError: false,
 		},
+		{
+			name: "add with business hours",
+			arguments: map[string]interface{}{
+				"name":     "shop",
+				"timezone": "Europe/Paris",
+				"business_hours": map[string]interface{}{
+					"weekly": map[string]interface{}{
+						"monday": []interface{}{map[string]interface{}{"open": "09:00", "close": "18:00"}},
+					},
+				},
+			},
+			mockCreate: func(ctx context.Context, loc *model.Location) error {
+				if loc.BusinessHours == nil || len(loc.BusinessHours.Weekly["monday"]) != 1 {
+					return errors.New("business hours not passed to repository")
+				}
+				return nil
+			},
+			shouldError: false,
+		},
+		{
+			name: "add with tags",
+			arguments: map[string]interface{}{
+				"name":     "berlin",
+				"timezone": "Europe/Berlin",
+				"tags":     []interface{}{" EMEA", "engineering"},
+			},
+			mockCreate: func(ctx context.Context, loc *model.Location) error {
+				if strings.Join(loc.Tags, ",") != "emea,engineering" {
+					return errors.New("tags not passed to repository")
+				}
+				return nil
+			},
+			shouldError: false,
+		},
+		{
+			name: "duplicate tags",
+			arguments: map[string]interface{}{
+				"name":     "berlin",
+				"timezone": "Europe/Berlin",
+				"tags":     []interface{}{"emea", "EMEA"},
+			},
+			shouldError:  true,
+			errorMessage: "Validation failed: duplicate tag",
+		},
+		{
+			name: "add with coordinates",
+			arguments: map[string]interface{}{
+				"name":      "tromso",
+				"timezone":  "Europe/Oslo",
+				"latitude":  69.6492,
+				"longitude": 18.9553,
+			},
+			mockCreate: func(ctx context.Context, loc *model.Location) error {
+				if loc.Coordinates == nil || loc.Coordinates.Latitude != 69.6492 || loc.Coordinates.Longitude != 18.9553 {
+					return errors.New("coordinates not passed to repository")
+				}
+				return nil
+			},
+			shouldError: false,
+		},
+		{
+			name: "latitude without longitude",
+			arguments: map[string]interface{}{
+				"name":     "tromso",
+				"timezone": "Europe/Oslo",
+				"latitude": 69.6492,
+			},
+			shouldError:  true,
+			errorMessage: "Invalid coordinates: latitude and longitude must be given together",
+		},
+		{
+			name: "latitude out of range",
+			arguments: map[string]interface{}{
+				"name":      "tromso",
+				"timezone":  "Europe/Oslo",
+				"latitude":  -91,
+				"longitude": 0,
+			},
+			shouldError:  true,
+			errorMessage: "Invalid coordinates: latitude must be between -90 and 90",
+		},
+		{
+			name: "invalid business hours",
+			arguments: map[string]interface{}{
+				"name":     "shop",
+				"timezone": "Europe/Paris",
+				"business_hours": map[string]interface{}{
+					"weekly": map[string]interface{}{
+						"monday": []interface{}{map[string]interface{}{"open": "9am", "close": "18:00"}},
+					},
+				},
+			},
+			shouldError:  true,
+			errorMessage: "Validation failed",
+		},
 		{
 			name: "missing name parameter",
 			arguments: map[string]interface{}{
@@ -350,6 +447,74 @@ func TestHandleUpdateLocation(t *testing.T) {
 			shouldError:  true,
 			errorMessage: "Invalid timezone",
 		},
+		{
+			name: "successful update coordinates",
+			arguments: map[string]interface{}{
+				"name":      "hq",
+				"latitude":  40.7128,
+				"longitude": -74.006,
+			},
+			mockGetByName: func(ctx context.Context, name string) (*model.Location, error) {
+				loc := *existingLocation
+				return &loc, nil
+			},
+			mockUpdate: func(ctx context.Context, name string, loc *model.Location) error {
+				if loc.Coordinates == nil || loc.Coordinates.Longitude != -74.006 || loc.Description != "Old description" {
+					return errors.New("coordinates not passed to repository")
+				}
+				return nil
+			},
+			shouldError: false,
+		},
+		{
+			name: "timezone keeps description",
+			arguments: map[string]interface{}{
+				"name":     "hq",
+				"timezone": "Europe/Paris",
+			},
+			mockGetByName: func(ctx context.Context, name string) (*model.Location, error) {
+				loc := *existingLocation
+				return &loc, nil
+			},
+			mockUpdate: func(ctx context.Context, name string, loc *model.Location) error {
+				if loc.Timezone != "Europe/Paris" || loc.Description != "Old description" {
+					return errors.New("description not kept")
+				}
+				return nil
+			},
+			shouldError: false,
+		},
+		{
+			name: "clear description and coordinates",
+			arguments: map[string]interface{}{
+				"name":              "hq",
+				"description":       "",
+				"clear_coordinates": true,
+			},
+			mockGetByName: func(ctx context.Context, name string) (*model.Location, error) {
+				loc := *existingLocation
+				loc.Coordinates = &model.Coordinates{Latitude: 40.7128, Longitude: -74.006}
+				return &loc, nil
+			},
+			mockUpdate: func(ctx context.Context, name string, loc *model.Location) error {
+				if loc.Description != "" || loc.Coordinates != nil {
+					return errors.New("description and coordinates not cleared")
+				}
+				return nil
+			},
+			shouldError: false,
+		},
+		{
+			name: "coordinates with clear coordinates",
+			arguments: map[string]interface{}{
+				"name":              "hq",
+				"latitude":          40.7128,
+				"longitude":         -74.006,
+				"clear_coordinates": true,
+			},
+			shouldError:  true,
+			errorMessage: "cannot be given with clear_coordinates",
+		},
 	}
 
 	for _, tt := range tests {
@@ -603,3 +768,152 @@ func TestHandleGetLocationTime(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleGetLocationTimeStatus(t *testing.T) {
+	allDay := []model.OpeningInterval{{Open: "00:00", Close: "24:00"}}
+	weekly := map[string][]model.OpeningInterval{}
+	for _, day := range []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"} {
+		weekly[day] = allDay
+	}
+
+	tests := []struct {
+		name   string
+		hours  *model.BusinessHours
+		status string
+	}{
+		{name: "always open", hours: &model.BusinessHours{Weekly: weekly}, status: model.StatusOpen},
+		{name: "no business hours", status: model.StatusUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			mockRepo := &mockLocationRepository{
+				getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+					return &model.Location{Name: "store", Timezone: "Asia/Tokyo", BusinessHours: tt.hours}, nil
+				},
+			}
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"name": "store"},
+				},
+			}
+
+			result, err := handleGetLocationTime(context.Background(), request, logger, mockRepo)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var resp struct {
+				Status model.OpenStatus `json:"status"`
+			}
+			if err := json.Unmarshal([]byte(resultText(t, result)), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if resp.Status.Status != tt.status {
+				t.Errorf("expected status %s, got %s", tt.status, resp.Status.Status)
+			}
+		})
+	}
+}
+
+func TestHandleGetLocationTimeLocale(t *testing.T) {
+	logger, _ := testutil.NewTestLogger()
+	mockRepo := &mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			return &model.Location{Name: "store", Timezone: "Asia/Tokyo"}, nil
+		},
+	}
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{"name": "store", "locale": "en-GB"},
+		},
+	}
+
+	result, err := handleGetLocationTime(context.Background(), request, logger, mockRepo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var resp struct {
+		Formatted string `json:"formatted"`
+		Locale    string `json:"locale"`
+		Localized string `json:"localized"`
+	}
+	if err := json.Unmarshal([]byte(resultText(t, result)), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.Locale != "en-GB" || !strings.HasSuffix(resp.Localized, " JST") {
+		t.Errorf("expected an en-GB long time in JST, got %q in %q", resp.Localized, resp.Locale)
+	}
+	if _, err := time.Parse(time.RFC3339, resp.Formatted); err != nil {
+		t.Errorf("expected formatted to stay RFC 3339, got %s", resp.Formatted)
+	}
+
+	request.Params.Arguments = map[string]interface{}{"name": "store", "locale": "tlh"}
+	result, err = handleGetLocationTime(context.Background(), request, logger, mockRepo)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsError {
+		t.Errorf("expected an error for an unsupported locale, got %s", resultText(t, result))
+	}
+}
+
+func TestHandleGetLocationTimeRelativeTo(t *testing.T) {
+	logger, _ := testutil.NewTestLogger()
+	mockRepo := &mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			switch name {
+			case "store":
+				return &model.Location{Name: "store", Timezone: "Asia/Tokyo"}, nil
+			case "home":
+				return &model.Location{Name: "home", Timezone: "Asia/Kolkata"}, nil
+			}
+			return nil, repository.ErrLocationNotFound
+		},
+	}
+
+	tests := []struct {
+		relativeTo string
+		want       string
+		shouldErr  bool
+	}{
+		{relativeTo: "home", want: "3 hours and 30 minutes ahead"},
+		{relativeTo: "Asia/Tokyo", want: "same time"},
+		{relativeTo: "nowhere", shouldErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.relativeTo, func(t *testing.T) {
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"name": "store", "relative_to": tt.relativeTo},
+				},
+			}
+
+			result, err := handleGetLocationTime(context.Background(), request, logger, mockRepo)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			text := resultText(t, result)
+			if tt.shouldErr {
+				if !result.IsError || !strings.Contains(text, "Invalid timezone or location") {
+					t.Errorf("expected a zone error, got %s", text)
+				}
+				return
+			}
+
+			var resp struct {
+				RelativeTo     string `json:"relative_to"`
+				RelativeOffset string `json:"relative_offset"`
+			}
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			if resp.RelativeTo != tt.relativeTo || resp.RelativeOffset != tt.want {
+				t.Errorf("expected %q relative to %s, got %q", tt.want, tt.relativeTo, resp.RelativeOffset)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newFindMeetingSlotsTool returns the find_meeting_slots tool definition
+func newFindMeetingSlotsTool() mcp.Tool {
+	hoursProperties := map[string]any{
+		"start": map[string]any{"type": "string", "description": "Local start of the working day (HH:MM)"},
+		"end":   map[string]any{"type": "string", "description": "Local end of the working day (HH:MM, '24:00' for midnight)"},
+	}
+
+	return mcp.NewTool("find_meeting_slots",
+		mcp.WithDescription("Find meeting times that fall within the working hours of every location, ranked by how far they sit from the start and end of each participant's day, with each participant's local time"),
+		mcp.WithArray("locations",
+			mcp.Required(),
+			mcp.Description(fmt.Sprintf("Saved location names or IANA timezones of the participants (1 to %d)", model.MaxMeetingLocations)),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("start_date",
+			mcp.Required(),
+			mcp.Description("First date to search (YYYY-MM-DD)"),
+		),
+		mcp.WithString("end_date",
+			mcp.Description(fmt.Sprintf("Last date to search, inclusive (YYYY-MM-DD, default: start_date, at most %d days)", model.MaxMeetingDays)),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("Timezone or saved location the dates are in (default: UTC)"),
+		),
+		mcp.WithNumber("duration_minutes",
+			mcp.Description(fmt.Sprintf("Meeting length in minutes (default: %d)", model.DefaultMeetingDuration)),
+		),
+		mcp.WithNumber("step_minutes",
+			mcp.Description(fmt.Sprintf("Spacing between candidate start times: 5, 10, 15, 20, 30 or 60 (default: %d)", model.DefaultMeetingStep)),
+		),
+		mcp.WithObject("working_hours",
+			mcp.Description(fmt.Sprintf("Working hours for every location (default: %s-%s)", model.DefaultWorkdayStart, model.DefaultWorkdayEnd)),
+			mcp.Properties(hoursProperties),
+		),
+		mcp.WithObject("location_hours",
+			mcp.Description("Working hours for specific locations, keyed by the name used in locations"),
+			mcp.AdditionalProperties(map[string]any{
+				"type":       "object",
+				"properties": hoursProperties,
+				"required":   []string{"start", "end"},
+			}),
+		),
+		mcp.WithBoolean("include_weekends",
+			mcp.Description("Include Saturdays and Sundays (default: false)"),
+		),
+		mcp.WithNumber("max_results",
+			mcp.Description(fmt.Sprintf("Maximum number of slots to return (default: %d, max: %d)", model.DefaultMeetingResults, model.MaxMeetingResults)),
+		),
+	)
+}
+
+// handleFindMeetingSlots handles the find_meeting_slots tool
+func handleFindMeetingSlots(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	var req model.MeetingSlotsRequest
+	if err := request.BindArguments(&req); err != nil {
+		log.Warn("find_meeting_slots: invalid arguments", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("find_meeting_slots: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	rangeZone, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "find_meeting_slots", req.Timezone, err), nil
+	}
+
+	attendees := make([]model.MeetingAttendee, len(req.Locations))
+	for i, name := range req.Locations {
+		z, err := resolver.Resolve(ctx, name)
+		if err != nil {
+			return zoneErrorResult(log, "find_meeting_slots", name, err), nil
+		}
+		attendees[i] = model.MeetingAttendee{Name: name, Location: z.Location, TZ: z.TZ}
+	}
+
+	from, to := req.Range(rangeZone.TZ)
+	response := model.NewMeetingSlotsResponse(&req, attendees, from, to)
+
+	log.Info("find_meeting_slots executed",
+		"locations", req.Locations,
+		"start", response.RangeStart,
+		"end", response.RangeEnd,
+		"candidates", response.TotalCandidates,
+		"count", response.Count,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.MeetingSlotsResponse
+	}{true, response})
+	if err != nil {
+		log.Error("find_meeting_slots: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
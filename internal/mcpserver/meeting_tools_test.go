@@ -0,0 +1,115 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleFindMeetingSlots(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"london": "Europe/London", "new-york": "America/New_York"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.MeetingSlotsResponse)
+	}{
+		{
+			name: "London and New York between DST changes",
+			arguments: map[string]interface{}{
+				"locations":        []interface{}{"london", "new-york"},
+				"start_date":       "2025-03-17",
+				"duration_minutes": float64(60),
+				"working_hours":    map[string]interface{}{"start": "08:00", "end": "16:00"},
+			},
+			check: func(t *testing.T, resp *model.MeetingSlotsResponse) {
+				// New York is on EDT and London on GMT: 12:00-16:00Z overlap
+				if resp.TotalCandidates != 7 {
+					t.Errorf("expected 7 candidates, got %d", resp.TotalCandidates)
+				}
+				best := resp.Slots[0]
+				if best.Start != "2025-03-17T13:30:00Z" || best.MinBufferMinutes != 90 {
+					t.Errorf("unexpected best slot: %s buffer %d", best.Start, best.MinBufferMinutes)
+				}
+				if best.Participants[1].Start != "2025-03-17T09:30:00-04:00" {
+					t.Errorf("unexpected New York local start: %s", best.Participants[1].Start)
+				}
+			},
+		},
+		{
+			name: "dates in a location's timezone",
+			arguments: map[string]interface{}{
+				"locations":        []interface{}{"Asia/Tokyo"},
+				"start_date":       "2025-03-17",
+				"timezone":         "Asia/Tokyo",
+				"include_weekends": true,
+			},
+			check: func(t *testing.T, resp *model.MeetingSlotsResponse) {
+				if resp.RangeStart != "2025-03-17T00:00:00+09:00" || resp.TotalCandidates != 16 {
+					t.Errorf("unexpected range %s with %d candidates", resp.RangeStart, resp.TotalCandidates)
+				}
+			},
+		},
+		{
+			name:         "missing locations",
+			arguments:    map[string]interface{}{"start_date": "2025-03-17"},
+			shouldError:  true,
+			errorMessage: "Validation failed",
+		},
+		{
+			name:         "invalid arguments",
+			arguments:    map[string]interface{}{"locations": "london", "start_date": "2025-03-17"},
+			shouldError:  true,
+			errorMessage: "Invalid arguments",
+		},
+		{
+			name:         "unknown location",
+			arguments:    map[string]interface{}{"locations": []interface{}{"atlantis"}, "start_date": "2025-03-17"},
+			shouldError:  true,
+			errorMessage: "atlantis",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleFindMeetingSlots(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.MeetingSlotsResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
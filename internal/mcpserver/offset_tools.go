@@ -0,0 +1,85 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newOffsetTimelineTool returns the get_offset_timeline tool definition
+func newOffsetTimelineTool() mcp.Tool {
+	return mcp.NewTool("get_offset_timeline",
+		mcp.WithDescription("Compare two timezones or saved locations over a date range and return the periods during which their offset differs, e.g. London is 5 hours ahead of New York except for two short periods around the DST changes when it is 4 hours ahead"),
+		mcp.WithString("zone",
+			mcp.Required(),
+			mcp.Description("IANA timezone or saved location name whose offset is compared"),
+		),
+		mcp.WithString("other",
+			mcp.Required(),
+			mcp.Description("IANA timezone or saved location name it is compared against"),
+		),
+		mcp.WithString("start",
+			mcp.Description("Start of the range: RFC3339, unix seconds, '2006-01-02 15:04', or '2006-01-02' (default: now)"),
+		),
+		mcp.WithString("end",
+			mcp.Description("End of the range, exclusive (default: one year after start, at most 100 years)"),
+		),
+	)
+}
+
+// handleOffsetTimeline handles the get_offset_timeline tool
+func handleOffsetTimeline(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.OffsetTimelineRequest{
+		Zone:  request.GetString("zone", ""),
+		Other: request.GetString("other", ""),
+		Start: request.GetString("start", ""),
+		End:   request.GetString("end", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("get_offset_timeline: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	target, err := resolver.Resolve(ctx, req.Zone)
+	if err != nil {
+		return zoneErrorResult(log, "get_offset_timeline", req.Zone, err), nil
+	}
+	other, err := resolver.Resolve(ctx, req.Other)
+	if err != nil {
+		return zoneErrorResult(log, "get_offset_timeline", req.Other, err), nil
+	}
+
+	start, end, err := req.Range(target.TZ, time.Now())
+	if err != nil {
+		log.Warn("get_offset_timeline: invalid range", "start", req.Start, "end", req.End, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid range: %v", err)), nil
+	}
+
+	response := model.NewOffsetTimelineResponse(target.TZ, target.Location, other.TZ, other.Location, start, end)
+
+	log.Info("get_offset_timeline executed",
+		"zone", req.Zone,
+		"other", req.Other,
+		"usual", response.UsualDifference,
+		"changes", response.Changes,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.OffsetTimelineResponse
+	}{true, response})
+	if err != nil {
+		log.Error("get_offset_timeline: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
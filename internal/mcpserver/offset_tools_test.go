@@ -0,0 +1,119 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleOffsetTimeline(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"london": "Europe/London", "nyc": "America/New_York"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.OffsetTimelineResponse)
+	}{
+		{
+			name: "saved locations over a year",
+			arguments: map[string]interface{}{
+				"zone":  "london",
+				"other": "nyc",
+				"start": "2025-01-01",
+				"end":   "2026-01-01",
+			},
+			check: func(t *testing.T, resp *model.OffsetTimelineResponse) {
+				if resp.UsualDifferenceSeconds != 5*3600 || len(resp.Periods) != 5 {
+					t.Fatalf("expected 5 periods around +05:00, got %d around %s", len(resp.Periods), resp.UsualDifference)
+				}
+				spring := resp.Periods[1]
+				if spring.Start != "2025-03-09T07:00:00Z" || spring.Difference != "+04:00" || spring.Usual {
+					t.Errorf("unexpected spring period: %+v", spring)
+				}
+				if !strings.HasPrefix(resp.Summary, "london is 5 hours ahead of nyc, except 4 hours ahead from 2025-03-09 to 2025-03-30") {
+					t.Errorf("unexpected summary: %s", resp.Summary)
+				}
+			},
+		},
+		{
+			name: "behind",
+			arguments: map[string]interface{}{
+				"zone":  "nyc",
+				"other": "london",
+				"start": "2025-06-01",
+				"end":   "2025-07-01",
+			},
+			check: func(t *testing.T, resp *model.OffsetTimelineResponse) {
+				if resp.Changes != 0 || resp.UsualDifference != "-05:00" || resp.Periods[0].Description != "5 hours behind" {
+					t.Errorf("unexpected timeline: %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "missing other",
+			arguments:    map[string]interface{}{"zone": "london"},
+			shouldError:  true,
+			errorMessage: "zone and other are both required",
+		},
+		{
+			name:         "unknown other",
+			arguments:    map[string]interface{}{"zone": "london", "other": "branch"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'branch'",
+		},
+		{
+			name: "reversed range",
+			arguments: map[string]interface{}{
+				"zone":  "london",
+				"other": "nyc",
+				"start": "2025-01-01",
+				"end":   "2024-01-01",
+			},
+			shouldError:  true,
+			errorMessage: "end must be after start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleOffsetTimeline(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.OffsetTimelineResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newParseTimeTool returns the parse_time tool definition
+func newParseTimeTool() mcp.Tool {
+	return mcp.NewTool("parse_time",
+		mcp.WithDescription("Parse a timestamp in any supported format and report the normalized instant, which format was detected and whether the value is ambiguous. Detects RFC 3339/ISO 8601, RFC 1123/5322, RFC 850/822, ANSI C and date(1) output, Apache access log and syslog timestamps, unix epoch seconds/ms/µs/ns by magnitude, and date-only or time-only values"),
+		mcp.WithString("value",
+			mcp.Required(),
+			mcp.Description("Timestamp to parse, e.g. 'Tue, 10 Jun 2025 14:30:15 GMT', '1749580215123' or '2025-06-10 14:30'"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location for values without an offset (default: UTC)"),
+		),
+	)
+}
+
+// handleParseTime handles the parse_time tool
+func handleParseTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.TimeParseRequest{
+		Value:    request.GetString("value", ""),
+		Timezone: request.GetString("timezone", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("parse_time: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "parse_time", req.Timezone, err), nil
+	}
+
+	result, err := timeparse.Detect(req.Value, z.TZ, time.Now())
+	if err != nil {
+		log.Warn("parse_time: invalid time", "value", req.Value, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid value '%s': %v", req.Value, err)), nil
+	}
+	response := model.NewTimeParseResponse(req.Value, z.TZ, z.Location, result)
+
+	log.Info("parse_time executed",
+		"value", req.Value,
+		"format", response.Format,
+		"utc", response.UTC,
+		"ambiguous", response.Ambiguous,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.TimeParseResponse
+	}{true, response})
+	if err != nil {
+		log.Error("parse_time: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,106 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleParseTime(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.TimeParseResponse)
+	}{
+		{
+			name:      "access log timestamp",
+			arguments: map[string]interface{}{"value": "10/Jun/2025:14:30:15 -0700"},
+			check: func(t *testing.T, resp *model.TimeParseResponse) {
+				if resp.Format != "clf" || resp.UTC != "2025-06-10T21:30:15Z" || resp.Timezone != "UTC" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:      "date in a zone",
+			arguments: map[string]interface{}{"value": "June 10, 2025", "timezone": "Asia/Tokyo"},
+			check: func(t *testing.T, resp *model.TimeParseResponse) {
+				if resp.Format != "date" || !resp.Local || resp.Time != "2025-06-10T00:00:00+09:00" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:      "compact date or epoch",
+			arguments: map[string]interface{}{"value": "20250610"},
+			check: func(t *testing.T, resp *model.TimeParseResponse) {
+				if resp.Format != "unix_seconds" || !resp.Ambiguous || len(resp.Alternatives) != 1 || resp.Alternatives[0].Time != "2025-06-10T00:00:00Z" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "missing value",
+			arguments:    map[string]interface{}{},
+			shouldError:  true,
+			errorMessage: "Validation failed: value is required",
+		},
+		{
+			name:         "unrecognized value",
+			arguments:    map[string]interface{}{"value": "the day after tomorrow"},
+			shouldError:  true,
+			errorMessage: "Invalid value 'the day after tomorrow'",
+		},
+		{
+			name:         "unknown timezone",
+			arguments:    map[string]interface{}{"value": "2025-06-10", "timezone": "Mars/Olympus"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'Mars/Olympus'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			resolver := zone.NewResolver(&mockLocationRepository{})
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleParseTime(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.TimeParseResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newExpandRecurrenceTool returns the expand_recurrence tool definition
+func newExpandRecurrenceTool() mcp.Tool {
+	return mcp.NewTool("expand_recurrence",
+		mcp.WithDescription("Expand an RFC 5545 recurrence set (DTSTART with RRULE, RDATE and EXDATE) into occurrences on a timezone's wall clock. Instances whose local time is skipped by a DST change are dropped and listed in skipped; repeated local times use their first occurrence"),
+		mcp.WithString("dtstart",
+			mcp.Required(),
+			mcp.Description("First occurrence, e.g. '20250303T090000' or '2025-03-03T09:00:00'; values without an offset are local to timezone"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location the recurrence is defined in (default: UTC)"),
+		),
+		mcp.WithString("rrule",
+			mcp.Description("Recurrence rule, e.g. 'FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10'"),
+		),
+		mcp.WithArray("rdate",
+			mcp.Description(fmt.Sprintf("Extra occurrences as date-times or dates (at most %d)", model.MaxRecurrenceDates)),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("exdate",
+			mcp.Description(fmt.Sprintf("Excluded occurrences as date-times, or dates to exclude whole days (at most %d)", model.MaxRecurrenceDates)),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("from",
+			mcp.Description("Start of the window, inclusive (default: dtstart)"),
+		),
+		mcp.WithString("to",
+			mcp.Description("End of the window, exclusive (default: open)"),
+		),
+		mcp.WithNumber("max_results",
+			mcp.Description(fmt.Sprintf("Maximum number of occurrences to return (default: %d, max: %d)", model.DefaultRecurrenceResults, model.MaxRecurrenceResults)),
+		),
+	)
+}
+
+// handleExpandRecurrence handles the expand_recurrence tool
+func handleExpandRecurrence(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	var req model.RecurrenceExpandRequest
+	if err := request.BindArguments(&req); err != nil {
+		log.Warn("expand_recurrence: invalid arguments", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid arguments: %v", err)), nil
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("expand_recurrence: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "expand_recurrence", req.Timezone, err), nil
+	}
+
+	set := req.Set(z.TZ)
+	from, to := req.Window(z.TZ)
+	expansion, err := set.Expand(from, to, req.MaxResults)
+	if err != nil {
+		log.Warn("expand_recurrence: expansion failed", "rrule", req.RRule, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Expansion failed: %v", err)), nil
+	}
+	response := model.NewRecurrenceExpandResponse(set, z.Location, from, to, expansion)
+
+	log.Info("expand_recurrence executed",
+		"timezone", response.Timezone,
+		"rrule", response.RRule,
+		"count", response.Count,
+		"skipped", len(response.Skipped),
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.RecurrenceExpandResponse
+	}{true, response})
+	if err != nil {
+		log.Error("expand_recurrence: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,118 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleExpandRecurrence(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.RecurrenceExpandResponse)
+	}{
+		{
+			name: "repeated local time uses the first occurrence",
+			arguments: map[string]interface{}{
+				"dtstart":  "2025-10-31T01:30:00",
+				"timezone": "America/New_York",
+				"rrule":    "FREQ=DAILY;COUNT=4",
+			},
+			check: func(t *testing.T, resp *model.RecurrenceExpandResponse) {
+				if resp.Count != 4 {
+					t.Fatalf("expected 4 occurrences, got %d", resp.Count)
+				}
+				o := resp.Occurrences[2]
+				if o.Time != "2025-11-02T01:30:00-04:00" || !o.Repeated {
+					t.Errorf("expected first 01:30 on 2 November, got %+v", o)
+				}
+				if resp.Occurrences[3].Offset != "-05:00" {
+					t.Errorf("expected EST after the change, got %+v", resp.Occurrences[3])
+				}
+			},
+		},
+		{
+			name: "window and limit",
+			arguments: map[string]interface{}{
+				"dtstart":     "20250106T090000",
+				"timezone":    "Europe/Berlin",
+				"rrule":       "FREQ=MONTHLY;BYDAY=-1FR",
+				"exdate":      []interface{}{"20250530"},
+				"from":        "2025-05-01",
+				"max_results": float64(2),
+			},
+			check: func(t *testing.T, resp *model.RecurrenceExpandResponse) {
+				if resp.Count != 2 || !resp.Truncated {
+					t.Fatalf("expected 2 truncated occurrences, got %d (truncated %v)", resp.Count, resp.Truncated)
+				}
+				if resp.Occurrences[0].LocalTime != "2025-06-27 09:00:00" || resp.Occurrences[0].Weekday != "Friday" {
+					t.Errorf("expected last Friday of June, got %+v", resp.Occurrences[0])
+				}
+			},
+		},
+		{
+			name:         "missing dtstart",
+			arguments:    map[string]interface{}{"rrule": "FREQ=DAILY"},
+			shouldError:  true,
+			errorMessage: "Validation failed: dtstart is required",
+		},
+		{
+			name:         "invalid rule",
+			arguments:    map[string]interface{}{"dtstart": "20250106T090000", "rrule": "FREQ=WEEKLY;BYDAY=1MO"},
+			shouldError:  true,
+			errorMessage: "Validation failed: invalid RRULE",
+		},
+		{
+			name:         "unknown timezone",
+			arguments:    map[string]interface{}{"dtstart": "20250106T090000", "rrule": "FREQ=DAILY", "timezone": "Mars/Olympus"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'Mars/Olympus'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			resolver := zone.NewResolver(&mockLocationRepository{})
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleExpandRecurrence(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.RecurrenceExpandResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
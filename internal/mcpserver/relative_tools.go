@@ -0,0 +1,120 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/humanize"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newRelativeTimeTool returns the relative_time tool definition
+func newRelativeTimeTool() mcp.Tool {
+	return mcp.NewTool("relative_time",
+		mcp.WithDescription("Describe an instant relative to now or to a reference instant in words, e.g. 'in 3 hours', '2 days ago' or 'tomorrow at 09:00 Tokyo time'"),
+		mcp.WithString("time",
+			mcp.Required(),
+			mcp.Description("Instant to describe in any format parse_time detects, e.g. RFC3339, unix epoch or '2025-06-11 09:00'"),
+		),
+		mcp.WithString("reference",
+			mcp.Description("Instant to describe it relative to, in the same formats (default: now)"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone or saved location for times without an offset and for calendar phrases (default: UTC)"),
+		),
+		mcp.WithBoolean("calendar",
+			mcp.Description("Describe instants on the previous, same or next day as 'yesterday', 'today' or 'tomorrow' at a time, unless within the hour (default: true)"),
+		),
+		granularityProperty(),
+		precisionProperty(),
+	)
+}
+
+// granularityProperty returns the schema option for a granularity argument
+func granularityProperty() mcp.ToolOption {
+	return mcp.WithString("granularity",
+		mcp.Description("Finest unit shown (default: second)"),
+		mcp.Enum(humanize.Units()...),
+	)
+}
+
+// precisionProperty returns the schema option for a precision argument
+func precisionProperty() mcp.ToolOption {
+	return mcp.WithNumber("precision",
+		mcp.Description(fmt.Sprintf("Most units shown, e.g. 2 for 'in 1 day and 3 hours' (1-%d, default: 1)", model.MaxRelativePrecision)),
+	)
+}
+
+// humanizeOptions returns the granularity and precision arguments of a request
+func humanizeOptions(request mcp.CallToolRequest) model.HumanizeOptions {
+	return model.HumanizeOptions{
+		Granularity: request.GetString("granularity", ""),
+		Precision:   request.GetInt("precision", 0),
+	}
+}
+
+// handleRelativeTime handles the relative_time tool
+func handleRelativeTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.RelativeTimeRequest{
+		Time:            request.GetString("time", ""),
+		Reference:       request.GetString("reference", ""),
+		Timezone:        request.GetString("timezone", ""),
+		Calendar:        request.GetBool("calendar", true),
+		HumanizeOptions: humanizeOptions(request),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("relative_time: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
+	if err != nil {
+		return zoneErrorResult(log, "relative_time", req.Timezone, err), nil
+	}
+
+	now := time.Now()
+	t, err := timeparse.Parse(req.Time, z.TZ, now)
+	if err != nil {
+		log.Warn("relative_time: invalid time", "time", req.Time, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid time '%s': %v", req.Time, err)), nil
+	}
+	ref, err := timeparse.Parse(req.Reference, z.TZ, now)
+	if err != nil {
+		log.Warn("relative_time: invalid reference", "reference", req.Reference, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid reference '%s': %v", req.Reference, err)), nil
+	}
+	response := model.NewRelativeTimeResponse(&req, t.In(z.TZ), ref.In(z.TZ), z.Location)
+
+	log.Info("relative_time executed",
+		"time", response.Time.Time,
+		"reference", response.Reference.Time,
+		"granularity", req.Granularity,
+		"humanized", response.Humanized,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.RelativeTimeResponse
+	}{true, response})
+	if err != nil {
+		log.Error("relative_time: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// relativeToProperty returns the schema option for a relative_to argument
+func relativeToProperty() mcp.ToolOption {
+	return mcp.WithString("relative_to",
+		mcp.Description("Caller's IANA timezone or saved location; adds how far the location's clock is ahead of or behind it as 'relative_offset', e.g. '9 hours ahead'"),
+	)
+}
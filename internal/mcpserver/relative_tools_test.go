@@ -0,0 +1,138 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleRelativeTime(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"tokyo": "Asia/Tokyo"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.RelativeTimeResponse)
+	}{
+		{
+			name: "tomorrow in a zone",
+			arguments: map[string]interface{}{
+				"time":      "2025-06-11 09:00",
+				"reference": "2025-06-10T15:00:00+09:00",
+				"timezone":  "Asia/Tokyo",
+			},
+			check: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "tomorrow at 09:00 Tokyo time" || resp.Direction != model.RelativeFuture {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name: "saved location labels calendar phrases",
+			arguments: map[string]interface{}{
+				"time":      "2025-06-10T00:30:00Z",
+				"reference": "2025-06-10T12:00:00Z",
+				"timezone":  "tokyo",
+			},
+			check: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "today at 09:30 tokyo time" || resp.Time.Location != "tokyo" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name: "without calendar phrases",
+			arguments: map[string]interface{}{
+				"time":      "2025-06-10T09:00:00Z",
+				"reference": "2025-06-10T12:00:00Z",
+				"calendar":  false,
+			},
+			check: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "3 hours ago" || resp.Seconds != -3*3600 || resp.Direction != model.RelativePast {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name: "granularity and precision",
+			arguments: map[string]interface{}{
+				"time":        "2025-09-25T18:00:00Z",
+				"reference":   "2025-06-10T12:00:00Z",
+				"granularity": "day",
+				"precision":   float64(3),
+			},
+			check: func(t *testing.T, resp *model.RelativeTimeResponse) {
+				if resp.Humanized != "in 3 months, 2 weeks and 1 day" {
+					t.Errorf("unexpected description %q", resp.Humanized)
+				}
+			},
+		},
+		{
+			name:         "missing time",
+			arguments:    map[string]interface{}{},
+			shouldError:  true,
+			errorMessage: "Validation failed: time is required",
+		},
+		{
+			name:         "invalid precision",
+			arguments:    map[string]interface{}{"time": "now", "precision": float64(10)},
+			shouldError:  true,
+			errorMessage: "Validation failed: precision must be between 1 and 7",
+		},
+		{
+			name:         "invalid time",
+			arguments:    map[string]interface{}{"time": "whenever"},
+			shouldError:  true,
+			errorMessage: "Invalid time 'whenever'",
+		},
+		{
+			name:         "unknown timezone",
+			arguments:    map[string]interface{}{"time": "now", "timezone": "Mars/Olympus"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'Mars/Olympus'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleRelativeTime(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.RelativeTimeResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
@@ -4,17 +4,24 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
+	"github.com/yourorg/timeservice/internal/businessday"
 	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/zone"
 	"github.com/yourorg/timeservice/pkg/metrics"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timefmt"
+	"github.com/yourorg/timeservice/pkg/timeparse"
 	"github.com/yourorg/timeservice/pkg/version"
 )
 
 // NewServer creates and configures a new MCP server with time-related tools
-func NewServer(log *slog.Logger, locationRepo repository.LocationRepository) *server.MCPServer {
+func NewServer(log *slog.Logger, locationRepo repository.LocationRepository, holidayRepo repository.HolidayRepository, fiscalRepo repository.FiscalCalendarRepository) *server.MCPServer {
 	// Create server with capabilities and options
 	mcpServer := server.NewMCPServer(
 		version.ServiceName,
@@ -24,57 +31,32 @@ func NewServer(log *slog.Logger, locationRepo repository.LocationRepository) *se
 		server.WithRecovery(),
 	)
 
+	// Timezone arguments also accept saved location names
+	resolver := zone.NewResolver(locationRepo)
+
 	// Register get_current_time tool
 	getCurrentTimeTool := mcp.NewTool("get_current_time",
 		mcp.WithDescription("Get the current server time in various formats and timezones"),
-		mcp.WithString("format",
-			mcp.Description("Time format: iso8601, unix, unixmilli, rfc3339, or custom Go format (e.g., '2006-01-02 15:04')"),
-		),
+		formatProperty("iso8601"),
+		localeProperty("Locale for month and day names, 12/24-hour clock and date order; without a format, returns the time in the long style of the locale"),
 		mcp.WithString("timezone",
-			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London). Defaults to UTC"),
+			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London) or saved location name. Defaults to UTC"),
 		),
 	)
 
 	mcpServer.AddTool(getCurrentTimeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleGetCurrentTime(ctx, request, log)
+		return handleGetCurrentTime(ctx, request, log, resolver)
 	})
 
 	// Register add_time_offset tool
-	addTimeOffsetTool := mcp.NewTool("add_time_offset",
-		mcp.WithDescription("Add a time offset (hours and/or minutes) to the current time"),
-		mcp.WithNumber("hours",
-			mcp.Description("Hours to add (can be negative for subtraction)"),
-		),
-		mcp.WithNumber("minutes",
-			mcp.Description("Minutes to add (can be negative for subtraction)"),
-		),
-		mcp.WithString("format",
-			mcp.Description("Output format: iso8601, unix, unixmilli, rfc3339, or custom Go format (e.g., '2006-01-02 15:04')"),
-		),
-		mcp.WithString("timezone",
-			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London). Defaults to UTC"),
-		),
-	)
+	addTimeOffsetTool := newAddTimeOffsetTool()
 
 	mcpServer.AddTool(addTimeOffsetTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleAddTimeOffset(ctx, request, log)
+		return handleAddTimeOffset(ctx, request, log, resolver)
 	})
 
 	// Register location management tools
-	addLocationTool := mcp.NewTool("add_location",
-		mcp.WithDescription("Add a named location with timezone"),
-		mcp.WithString("name",
-			mcp.Required(),
-			mcp.Description("Location name (alphanumeric, hyphens, and underscores only)"),
-		),
-		mcp.WithString("timezone",
-			mcp.Required(),
-			mcp.Description("IANA timezone (e.g., America/New_York, Europe/London, Asia/Tokyo)"),
-		),
-		mcp.WithString("description",
-			mcp.Description("Optional description of the location"),
-		),
-	)
+	addLocationTool := newAddLocationTool()
 
 	mcpServer.AddTool(addLocationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return handleAddLocation(ctx, request, log, locationRepo)
@@ -92,19 +74,7 @@ func NewServer(log *slog.Logger, locationRepo repository.LocationRepository) *se
 		return handleRemoveLocation(ctx, request, log, locationRepo)
 	})
 
-	updateLocationTool := mcp.NewTool("update_location",
-		mcp.WithDescription("Update a location's timezone or description"),
-		mcp.WithString("name",
-			mcp.Required(),
-			mcp.Description("Location name to update"),
-		),
-		mcp.WithString("timezone",
-			mcp.Description("New IANA timezone (optional)"),
-		),
-		mcp.WithString("description",
-			mcp.Description("New description (optional)"),
-		),
-	)
+	updateLocationTool := newUpdateLocationTool()
 
 	mcpServer.AddTool(updateLocationTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return handleUpdateLocation(ctx, request, log, locationRepo)
@@ -118,32 +88,164 @@ func NewServer(log *slog.Logger, locationRepo repository.LocationRepository) *se
 		return handleListLocations(ctx, request, log, locationRepo)
 	})
 
-	getLocationTimeTool := mcp.NewTool("get_location_time",
-		mcp.WithDescription("Get the current time for a named location"),
-		mcp.WithString("name",
-			mcp.Required(),
-			mcp.Description("Location name"),
-		),
-		mcp.WithString("format",
-			mcp.Description("Time format: rfc3339, iso8601, unix, unixmilli, or custom Go format (default: rfc3339)"),
-		),
-	)
+	getLocationTimeTool := newGetLocationTimeTool()
 
 	mcpServer.AddTool(getLocationTimeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return handleGetLocationTime(ctx, request, log, locationRepo)
 	})
 
+	sunTimesTool := newSunTimesTool()
+
+	mcpServer.AddTool(sunTimesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetSunTimes(ctx, request, log, locationRepo)
+	})
+
+	// Register time conversion tools
+	convertTimeTool := newConvertTimeTool()
+
+	mcpServer.AddTool(convertTimeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleConvertTime(ctx, request, log, resolver)
+	})
+
+	parseTimeTool := newParseTimeTool()
+
+	mcpServer.AddTool(parseTimeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleParseTime(ctx, request, log, resolver)
+	})
+
+	relativeTimeTool := newRelativeTimeTool()
+
+	mcpServer.AddTool(relativeTimeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleRelativeTime(ctx, request, log, resolver)
+	})
+
+	bucketTimeTool := newBucketTimeTool()
+
+	mcpServer.AddTool(bucketTimeTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleBucketTime(ctx, request, log, resolver)
+	})
+
+	convertCalendarTool := newConvertCalendarTool()
+
+	mcpServer.AddTool(convertCalendarTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleConvertCalendar(ctx, request, log, resolver)
+	})
+
+	timeDifferenceTool := newTimeDifferenceTool()
+
+	mcpServer.AddTool(timeDifferenceTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleTimeDifference(ctx, request, log, resolver)
+	})
+
+	dstTransitionsTool := newDSTTransitionsTool()
+
+	mcpServer.AddTool(dstTransitionsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetDSTTransitions(ctx, request, log, resolver)
+	})
+
+	offsetTimelineTool := newOffsetTimelineTool()
+
+	mcpServer.AddTool(offsetTimelineTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleOffsetTimeline(ctx, request, log, resolver)
+	})
+
+	listTimezonesTool := newListTimezonesTool()
+
+	mcpServer.AddTool(listTimezonesTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListTimezones(ctx, request, log)
+	})
+
+	lookupTimezoneTool := newLookupTimezoneTool()
+
+	mcpServer.AddTool(lookupTimezoneTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLookupTimezone(ctx, request, log, resolver)
+	})
+
+	worldClockTool := newWorldClockTool()
+
+	mcpServer.AddTool(worldClockTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleWorldClock(ctx, request, log, resolver)
+	})
+
+	findMeetingSlotsTool := newFindMeetingSlotsTool()
+
+	mcpServer.AddTool(findMeetingSlotsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleFindMeetingSlots(ctx, request, log, resolver)
+	})
+
+	expandRecurrenceTool := newExpandRecurrenceTool()
+
+	mcpServer.AddTool(expandRecurrenceTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleExpandRecurrence(ctx, request, log, resolver)
+	})
+
+	combineIntervalsTool := newCombineIntervalsTool()
+
+	mcpServer.AddTool(combineIntervalsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleCombineIntervals(ctx, request, log, resolver)
+	})
+
+	nextCronRunsTool := newNextCronRunsTool()
+
+	mcpServer.AddTool(nextCronRunsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleNextCronRuns(ctx, request, log, resolver)
+	})
+
+	isHolidayTool := newIsHolidayTool()
+
+	mcpServer.AddTool(isHolidayTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleIsHoliday(ctx, request, log, locationRepo, holidayRepo)
+	})
+
+	calc := businessday.NewCalculator(locationRepo, holidayRepo)
+
+	addBusinessDaysTool := newAddBusinessDaysTool()
+
+	mcpServer.AddTool(addBusinessDaysTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleAddBusinessDays(ctx, request, log, calc)
+	})
+
+	countBusinessDaysTool := newCountBusinessDaysTool()
+
+	mcpServer.AddTool(countBusinessDaysTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleCountBusinessDays(ctx, request, log, calc)
+	})
+
+	getFiscalDateTool := newGetFiscalDateTool()
+
+	mcpServer.AddTool(getFiscalDateTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetFiscalDate(ctx, request, log, resolver, fiscalRepo)
+	})
+
+	listFiscalPeriodsTool := newListFiscalPeriodsTool()
+
+	mcpServer.AddTool(listFiscalPeriodsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListFiscalPeriods(ctx, request, log, resolver, fiscalRepo)
+	})
+
+	convertTimeScaleTool := newConvertTimeScaleTool()
+
+	mcpServer.AddTool(convertTimeScaleTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleConvertTimeScale(ctx, request, log)
+	})
+
+	getLeapSecondsTool := newGetLeapSecondsTool()
+
+	mcpServer.AddTool(getLeapSecondsTool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetLeapSeconds(ctx, request, log)
+	})
+
 	log.Info("MCP server initialized",
 		"name", version.ServiceName,
 		"version", version.Version,
-		"tools", []string{"get_current_time", "add_time_offset", "add_location", "remove_location", "update_location", "list_locations", "get_location_time"},
+		"tools", []string{"get_current_time", "add_time_offset", "add_location", "remove_location", "update_location", "list_locations", "get_location_time", "get_sun_times", "convert_time", "parse_time", "relative_time", "bucket_time", "convert_calendar", "time_difference", "get_dst_transitions", "get_offset_timeline", "list_timezones", "lookup_timezone", "world_clock", "find_meeting_slots", "expand_recurrence", "combine_intervals", "next_cron_runs", "is_holiday", "add_business_days", "count_business_days", "get_fiscal_date", "list_fiscal_periods", "convert_time_scale", "get_leap_seconds"},
 	)
 
 	return mcpServer
 }
 
 // NewServerWithMetrics creates and configures a new MCP server with metrics tracking
-func NewServerWithMetrics(log *slog.Logger, m *metrics.Metrics, locationRepo repository.LocationRepository) *server.MCPServer {
+func NewServerWithMetrics(log *slog.Logger, m *metrics.Metrics, locationRepo repository.LocationRepository, holidayRepo repository.HolidayRepository, fiscalRepo repository.FiscalCalendarRepository) *server.MCPServer {
 	// Create server with capabilities and options
 	mcpServer := server.NewMCPServer(
 		version.ServiceName,
@@ -153,57 +255,32 @@ func NewServerWithMetrics(log *slog.Logger, m *metrics.Metrics, locationRepo rep
 		server.WithRecovery(),
 	)
 
+	// Timezone arguments also accept saved location names
+	resolver := zone.NewResolver(locationRepo)
+
 	// Register get_current_time tool with metrics
 	getCurrentTimeTool := mcp.NewTool("get_current_time",
 		mcp.WithDescription("Get the current server time in various formats and timezones"),
-		mcp.WithString("format",
-			mcp.Description("Time format: iso8601, unix, unixmilli, rfc3339, or custom Go format (e.g., '2006-01-02 15:04')"),
-		),
+		formatProperty("iso8601"),
+		localeProperty("Locale for month and day names, 12/24-hour clock and date order; without a format, returns the time in the long style of the locale"),
 		mcp.WithString("timezone",
-			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London). Defaults to UTC"),
+			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London) or saved location name. Defaults to UTC"),
 		),
 	)
 
 	mcpServer.AddTool(getCurrentTimeTool, wrapWithMetrics("get_current_time", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleGetCurrentTime(ctx, request, log)
+		return handleGetCurrentTime(ctx, request, log, resolver)
 	}))
 
 	// Register add_time_offset tool with metrics
-	addTimeOffsetTool := mcp.NewTool("add_time_offset",
-		mcp.WithDescription("Add a time offset (hours and/or minutes) to the current time"),
-		mcp.WithNumber("hours",
-			mcp.Description("Hours to add (can be negative for subtraction)"),
-		),
-		mcp.WithNumber("minutes",
-			mcp.Description("Minutes to add (can be negative for subtraction)"),
-		),
-		mcp.WithString("format",
-			mcp.Description("Output format: iso8601, unix, unixmilli, rfc3339, or custom Go format (e.g., '2006-01-02 15:04')"),
-		),
-		mcp.WithString("timezone",
-			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London). Defaults to UTC"),
-		),
-	)
+	addTimeOffsetTool := newAddTimeOffsetTool()
 
 	mcpServer.AddTool(addTimeOffsetTool, wrapWithMetrics("add_time_offset", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		return handleAddTimeOffset(ctx, request, log)
+		return handleAddTimeOffset(ctx, request, log, resolver)
 	}))
 
 	// Register add_location tool
-	addLocationTool := mcp.NewTool("add_location",
-		mcp.WithDescription("Add a named location with timezone"),
-		mcp.WithString("name",
-			mcp.Required(),
-			mcp.Description("Location name (alphanumeric, hyphens, and underscores only)"),
-		),
-		mcp.WithString("timezone",
-			mcp.Required(),
-			mcp.Description("IANA timezone (e.g., America/New_York, Europe/London, Asia/Tokyo)"),
-		),
-		mcp.WithString("description",
-			mcp.Description("Optional description of the location"),
-		),
-	)
+	addLocationTool := newAddLocationTool()
 
 	mcpServer.AddTool(addLocationTool, wrapWithMetrics("add_location", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return handleAddLocation(ctx, request, log, locationRepo)
@@ -223,19 +300,7 @@ func NewServerWithMetrics(log *slog.Logger, m *metrics.Metrics, locationRepo rep
 	}))
 
 	// Register update_location tool
-	updateLocationTool := mcp.NewTool("update_location",
-		mcp.WithDescription("Update a location's timezone or description"),
-		mcp.WithString("name",
-			mcp.Required(),
-			mcp.Description("Location name to update"),
-		),
-		mcp.WithString("timezone",
-			mcp.Description("New IANA timezone (optional)"),
-		),
-		mcp.WithString("description",
-			mcp.Description("New description (optional)"),
-		),
-	)
+	updateLocationTool := newUpdateLocationTool()
 
 	mcpServer.AddTool(updateLocationTool, wrapWithMetrics("update_location", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return handleUpdateLocation(ctx, request, log, locationRepo)
@@ -251,30 +316,205 @@ func NewServerWithMetrics(log *slog.Logger, m *metrics.Metrics, locationRepo rep
 	}))
 
 	// Register get_location_time tool
-	getLocationTimeTool := mcp.NewTool("get_location_time",
-		mcp.WithDescription("Get the current time for a named location"),
-		mcp.WithString("name",
-			mcp.Required(),
-			mcp.Description("Location name"),
-		),
-		mcp.WithString("format",
-			mcp.Description("Time format: rfc3339, iso8601, unix, unixmilli, or custom Go format (default: rfc3339)"),
-		),
-	)
+	getLocationTimeTool := newGetLocationTimeTool()
 
 	mcpServer.AddTool(getLocationTimeTool, wrapWithMetrics("get_location_time", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		return handleGetLocationTime(ctx, request, log, locationRepo)
 	}))
 
+	sunTimesTool := newSunTimesTool()
+
+	mcpServer.AddTool(sunTimesTool, wrapWithMetrics("get_sun_times", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetSunTimes(ctx, request, log, locationRepo)
+	}))
+
+	// Register convert_time tool
+	convertTimeTool := newConvertTimeTool()
+
+	mcpServer.AddTool(convertTimeTool, wrapWithMetrics("convert_time", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleConvertTime(ctx, request, log, resolver)
+	}))
+
+	parseTimeTool := newParseTimeTool()
+
+	mcpServer.AddTool(parseTimeTool, wrapWithMetrics("parse_time", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleParseTime(ctx, request, log, resolver)
+	}))
+
+	relativeTimeTool := newRelativeTimeTool()
+
+	mcpServer.AddTool(relativeTimeTool, wrapWithMetrics("relative_time", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleRelativeTime(ctx, request, log, resolver)
+	}))
+
+	bucketTimeTool := newBucketTimeTool()
+
+	mcpServer.AddTool(bucketTimeTool, wrapWithMetrics("bucket_time", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleBucketTime(ctx, request, log, resolver)
+	}))
+
+	convertCalendarTool := newConvertCalendarTool()
+
+	mcpServer.AddTool(convertCalendarTool, wrapWithMetrics("convert_calendar", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleConvertCalendar(ctx, request, log, resolver)
+	}))
+
+	// Register time_difference tool
+	timeDifferenceTool := newTimeDifferenceTool()
+
+	mcpServer.AddTool(timeDifferenceTool, wrapWithMetrics("time_difference", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleTimeDifference(ctx, request, log, resolver)
+	}))
+
+	dstTransitionsTool := newDSTTransitionsTool()
+
+	mcpServer.AddTool(dstTransitionsTool, wrapWithMetrics("get_dst_transitions", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetDSTTransitions(ctx, request, log, resolver)
+	}))
+
+	offsetTimelineTool := newOffsetTimelineTool()
+
+	mcpServer.AddTool(offsetTimelineTool, wrapWithMetrics("get_offset_timeline", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleOffsetTimeline(ctx, request, log, resolver)
+	}))
+
+	listTimezonesTool := newListTimezonesTool()
+
+	mcpServer.AddTool(listTimezonesTool, wrapWithMetrics("list_timezones", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListTimezones(ctx, request, log)
+	}))
+
+	lookupTimezoneTool := newLookupTimezoneTool()
+
+	mcpServer.AddTool(lookupTimezoneTool, wrapWithMetrics("lookup_timezone", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleLookupTimezone(ctx, request, log, resolver)
+	}))
+
+	worldClockTool := newWorldClockTool()
+
+	mcpServer.AddTool(worldClockTool, wrapWithMetrics("world_clock", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleWorldClock(ctx, request, log, resolver)
+	}))
+
+	findMeetingSlotsTool := newFindMeetingSlotsTool()
+
+	mcpServer.AddTool(findMeetingSlotsTool, wrapWithMetrics("find_meeting_slots", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleFindMeetingSlots(ctx, request, log, resolver)
+	}))
+
+	expandRecurrenceTool := newExpandRecurrenceTool()
+
+	mcpServer.AddTool(expandRecurrenceTool, wrapWithMetrics("expand_recurrence", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleExpandRecurrence(ctx, request, log, resolver)
+	}))
+
+	combineIntervalsTool := newCombineIntervalsTool()
+
+	mcpServer.AddTool(combineIntervalsTool, wrapWithMetrics("combine_intervals", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleCombineIntervals(ctx, request, log, resolver)
+	}))
+
+	nextCronRunsTool := newNextCronRunsTool()
+
+	mcpServer.AddTool(nextCronRunsTool, wrapWithMetrics("next_cron_runs", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleNextCronRuns(ctx, request, log, resolver)
+	}))
+
+	isHolidayTool := newIsHolidayTool()
+
+	mcpServer.AddTool(isHolidayTool, wrapWithMetrics("is_holiday", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleIsHoliday(ctx, request, log, locationRepo, holidayRepo)
+	}))
+
+	calc := businessday.NewCalculator(locationRepo, holidayRepo)
+
+	addBusinessDaysTool := newAddBusinessDaysTool()
+
+	mcpServer.AddTool(addBusinessDaysTool, wrapWithMetrics("add_business_days", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleAddBusinessDays(ctx, request, log, calc)
+	}))
+
+	countBusinessDaysTool := newCountBusinessDaysTool()
+
+	mcpServer.AddTool(countBusinessDaysTool, wrapWithMetrics("count_business_days", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleCountBusinessDays(ctx, request, log, calc)
+	}))
+
+	getFiscalDateTool := newGetFiscalDateTool()
+
+	mcpServer.AddTool(getFiscalDateTool, wrapWithMetrics("get_fiscal_date", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetFiscalDate(ctx, request, log, resolver, fiscalRepo)
+	}))
+
+	listFiscalPeriodsTool := newListFiscalPeriodsTool()
+
+	mcpServer.AddTool(listFiscalPeriodsTool, wrapWithMetrics("list_fiscal_periods", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleListFiscalPeriods(ctx, request, log, resolver, fiscalRepo)
+	}))
+
+	convertTimeScaleTool := newConvertTimeScaleTool()
+
+	mcpServer.AddTool(convertTimeScaleTool, wrapWithMetrics("convert_time_scale", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleConvertTimeScale(ctx, request, log)
+	}))
+
+	getLeapSecondsTool := newGetLeapSecondsTool()
+
+	mcpServer.AddTool(getLeapSecondsTool, wrapWithMetrics("get_leap_seconds", m, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handleGetLeapSeconds(ctx, request, log)
+	}))
+
 	log.Info("MCP server initialized",
 		"name", version.ServiceName,
 		"version", version.Version,
-		"tools", []string{"get_current_time", "add_time_offset", "add_location", "remove_location", "update_location", "list_locations", "get_location_time"},
+		"tools", []string{"get_current_time", "add_time_offset", "add_location", "remove_location", "update_location", "list_locations", "get_location_time", "get_sun_times", "convert_time", "parse_time", "relative_time", "bucket_time", "convert_calendar", "time_difference", "get_dst_transitions", "get_offset_timeline", "list_timezones", "lookup_timezone", "world_clock", "find_meeting_slots", "expand_recurrence", "combine_intervals", "next_cron_runs", "is_holiday", "add_business_days", "count_business_days", "get_fiscal_date", "list_fiscal_periods", "convert_time_scale", "get_leap_seconds"},
 	)
 
 	return mcpServer
 }
 
+// newAddTimeOffsetTool defines the add_time_offset tool
+func newAddTimeOffsetTool() mcp.Tool {
+	return mcp.NewTool("add_time_offset",
+		mcp.WithDescription("Add a calendar-aware offset to the current time or a given base time. Years, months, weeks and days follow the wall clock of the timezone (DST-safe); hours, minutes and seconds are elapsed time"),
+		mcp.WithString("base",
+			mcp.Description("Base instant in any format parse_time detects, e.g. RFC3339, RFC1123, unix epoch, '2006-01-02 15:04' or '15:04' (default: now)"),
+		),
+		mcp.WithNumber("years",
+			mcp.Description("Calendar years to add (can be negative for subtraction)"),
+		),
+		mcp.WithNumber("months",
+			mcp.Description("Calendar months to add (can be negative for subtraction)"),
+		),
+		mcp.WithNumber("weeks",
+			mcp.Description("Calendar weeks to add (can be negative for subtraction)"),
+		),
+		mcp.WithNumber("days",
+			mcp.Description("Calendar days to add (can be negative for subtraction)"),
+		),
+		mcp.WithNumber("hours",
+			mcp.Description("Hours to add (can be negative for subtraction)"),
+		),
+		mcp.WithNumber("minutes",
+			mcp.Description("Minutes to add (can be negative for subtraction)"),
+		),
+		mcp.WithNumber("seconds",
+			mcp.Description("Seconds to add (can be negative for subtraction)"),
+		),
+		mcp.WithString("duration",
+			mcp.Description("ISO 8601 duration (e.g., P1M, P1DT12H, -PT90M); cannot be combined with individual units"),
+		),
+		mcp.WithString("overflow",
+			mcp.Description("When a month or year lands past the end of the month (e.g., Jan 31 + 1 month): clamp (default) to the last day, or rollover into the next month"),
+		),
+		formatProperty("iso8601"),
+		localeProperty("Locale for month and day names, 12/24-hour clock and date order; without a format, returns the result in the long style of the locale"),
+		mcp.WithString("timezone",
+			mcp.Description("IANA timezone (e.g., America/New_York, UTC, Europe/London) or saved location name. Defaults to UTC"),
+		),
+	)
+}
+
 // wrapWithMetrics wraps a tool handler with metrics tracking
 func wrapWithMetrics(toolName string, m *metrics.Metrics, handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -301,34 +541,70 @@ func wrapWithMetrics(toolName string, m *metrics.Metrics, handler func(context.C
 	}
 }
 
+// formatProperty returns the schema option for a format argument
+func formatProperty(defaultFormat string) mcp.ToolOption {
+	return mcp.WithString("format",
+		mcp.Description(fmt.Sprintf("Time format: a preset (%s), a strftime pattern (e.g., '%%Y-%%m-%%d %%H:%%M') or a Go layout (e.g., '2006-01-02 15:04'). Default: %s",
+			strings.Join(timefmt.Presets(), ", "), defaultFormat)),
+	)
+}
+
+// localeProperty returns the schema option for a locale argument
+func localeProperty(description string) mcp.ToolOption {
+	return mcp.WithString("locale",
+		mcp.Description(fmt.Sprintf("%s. Supported: %s; other regions fall back to the language (e.g., de-AT uses de-DE)",
+			description, strings.Join(timefmt.LocaleTags(), ", "))),
+	)
+}
+
+// toolLocale returns the locale argument of a request, or nil if it has none
+func toolLocale(request mcp.CallToolRequest) (*timefmt.Locale, error) {
+	tag := request.GetString("locale", "")
+	if tag == "" {
+		return nil, nil
+	}
+	return timefmt.ParseLocale(tag)
+}
+
+// toolFormat returns the format argument of a request. Without one, a request
+// with a locale uses the default localized style.
+func toolFormat(request mcp.CallToolRequest, locale *timefmt.Locale, defaultFormat string) string {
+	format := request.GetString("format", "")
+	if format != "" {
+		return format
+	}
+	if locale != nil {
+		return timefmt.DefaultStyle
+	}
+	return defaultFormat
+}
+
 // handleGetCurrentTime handles the get_current_time tool
-func handleGetCurrentTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger) (*mcp.CallToolResult, error) {
+func handleGetCurrentTime(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
 	// Extract arguments using helper methods with defaults
-	format := request.GetString("format", "iso8601")
-	tzName := request.GetString("timezone", "UTC")
+	tzName := request.GetString("timezone", "")
+	if tzName == "" {
+		tzName = "UTC"
+	}
+	locale, err := toolLocale(request)
+	if err != nil {
+		log.Warn("get_current_time: invalid locale", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid locale: %v", err)), nil
+	}
+	format := toolFormat(request, locale, "iso8601")
 
-	// Load the timezone
-	loc, err := time.LoadLocation(tzName)
+	// Resolve the timezone or saved location
+	z, err := resolver.Resolve(ctx, tzName)
 	if err != nil {
-		log.Error("invalid timezone", "timezone", tzName, "error", err)
-		return mcp.NewToolResultError(fmt.Sprintf("Invalid timezone '%s': %v", tzName, err)), nil
+		return zoneErrorResult(log, "get_current_time", tzName, err), nil
 	}
 
 	// Get current time in the specified timezone
-	now := time.Now().In(loc)
-	var result string
-
-	// Format the time based on the requested format
-	switch format {
-	case "iso8601", "rfc3339":
-		result = now.Format(time.RFC3339)
-	case "unix":
-		result = fmt.Sprintf("%d", now.Unix())
-	case "unixmilli":
-		result = fmt.Sprintf("%d", now.UnixMilli())
-	default:
-		// Treat as custom Go format
-		result = now.Format(format)
+	now := time.Now().In(z.TZ)
+	result, err := locale.Format(now, format)
+	if err != nil {
+		log.Warn("get_current_time: invalid format", "format", format, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid format '%s': %v", format, err)), nil
 	}
 
 	log.Info("get_current_time executed",
@@ -341,47 +617,96 @@ func handleGetCurrentTime(ctx context.Context, request mcp.CallToolRequest, log
 }
 
 // handleAddTimeOffset handles the add_time_offset tool
-func handleAddTimeOffset(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger) (*mcp.CallToolResult, error) {
+func handleAddTimeOffset(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
 	// Extract arguments - GetFloat returns float64
-	hours := request.GetFloat("hours", 0)
-	minutes := request.GetFloat("minutes", 0)
-	format := request.GetString("format", "iso8601")
-	tzName := request.GetString("timezone", "UTC")
+	req := model.AddTimeRequest{
+		Base:     request.GetString("base", ""),
+		Timezone: request.GetString("timezone", "UTC"),
+		Hours:    request.GetFloat("hours", 0),
+		Minutes:  request.GetFloat("minutes", 0),
+		Seconds:  request.GetFloat("seconds", 0),
+		Duration: request.GetString("duration", ""),
+		Overflow: request.GetString("overflow", ""),
+	}
 
-	// Load the timezone
-	loc, err := time.LoadLocation(tzName)
+	// Calendar units are whole numbers; reading them as floats catches fractions
+	ints := []struct {
+		name string
+		dst  *int
+	}{
+		{"years", &req.Years},
+		{"months", &req.Months},
+		{"weeks", &req.Weeks},
+		{"days", &req.Days},
+	}
+	for _, p := range ints {
+		n, err := integerArg(request, p.name)
+		if err != nil {
+			log.Warn("add_time_offset: validation failed", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+		}
+		*p.dst = n
+	}
+
+	locale, err := toolLocale(request)
+	if err != nil {
+		log.Warn("add_time_offset: invalid locale", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid locale: %v", err)), nil
+	}
+	format := toolFormat(request, locale, "iso8601")
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("add_time_offset: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	z, err := resolver.Resolve(ctx, req.Timezone)
 	if err != nil {
-		log.Error("invalid timezone", "timezone", tzName, "error", err)
-		return mcp.NewToolResultError(fmt.Sprintf("Invalid timezone '%s': %v", tzName, err)), nil
+		return zoneErrorResult(log, "add_time_offset", req.Timezone, err), nil
 	}
+	loc := z.TZ
 
-	// Calculate the offset duration
-	// Multiply in float64 space before converting to Duration to handle fractional hours/minutes
-	offset := time.Duration(hours*float64(time.Hour)) + time.Duration(minutes*float64(time.Minute))
+	base, err := timeparse.Parse(req.Base, loc, time.Now())
+	if err != nil {
+		log.Warn("add_time_offset: invalid base time", "base", req.Base, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid base '%s': %v", req.Base, err)), nil
+	}
 
-	// Get current time in the specified timezone and add offset
-	result := time.Now().In(loc).Add(offset)
+	// Calendar units follow the wall clock of the timezone, clock units are elapsed time
+	result := req.Apply(base, loc)
 
 	// Format the result
-	var timeStr string
-	switch format {
-	case "iso8601", "rfc3339":
-		timeStr = result.Format(time.RFC3339)
-	case "unix":
-		timeStr = fmt.Sprintf("%d", result.Unix())
-	case "unixmilli":
-		timeStr = fmt.Sprintf("%d", result.UnixMilli())
-	default:
-		timeStr = result.Format(format)
+	timeStr, err := locale.Format(result, format)
+	if err != nil {
+		log.Warn("add_time_offset: invalid format", "format", format, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid format '%s': %v", format, err)), nil
 	}
 
 	log.Info("add_time_offset executed",
-		"hours", hours,
-		"minutes", minutes,
+		"base", base.Format(time.RFC3339),
+		"years", req.Years,
+		"months", req.Months,
+		"weeks", req.Weeks,
+		"days", req.Days,
+		"hours", req.Hours,
+		"minutes", req.Minutes,
+		"seconds", req.Seconds,
+		"duration", req.Duration,
+		"overflow", req.Overflow,
 		"format", format,
-		"timezone", tzName,
+		"timezone", req.Timezone,
 		"result", timeStr,
 	)
 
 	return mcp.NewToolResultText(timeStr), nil
 }
+
+// integerArg returns an optional whole-number argument, or 0 when it is absent
+func integerArg(request mcp.CallToolRequest, name string) (int, error) {
+	v := request.GetFloat(name, 0)
+	if v != math.Trunc(v) || math.Abs(v) > math.MaxInt32 {
+		return 0, fmt.Errorf("%s must be an integer", name)
+	}
+	return int(v), nil
+}
@@ -3,6 +3,8 @@ package mcpserver
 import (
 	"context"
 	"errors"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,7 +17,7 @@ func TestNewServer(t *testing.T) {
 	logger, logHandler := testutil.NewTestLogger()
 
 	// Pass nil repository for testing without database
-	server := NewServer(logger, nil)
+	server := NewServer(logger, nil, nil, nil)
 
 	if server == nil {
 		t.Fatal("expected server to be created")
@@ -91,7 +93,7 @@ func TestHandleGetCurrentTime(t *testing.T) {
 				},
 			}
 
-			result, err := handleGetCurrentTime(ctx, request, logger)
+			result, err := handleGetCurrentTime(ctx, request, logger, newTestResolver(nil))
 
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -106,8 +108,9 @@ func TestHandleGetCurrentTime(t *testing.T) {
 				if !result.IsError {
 					t.Error("expected result to be an error")
 				}
-				// Verify error was logged
-				logHandler.AssertErrorCount(t, 1)
+				// An unknown timezone is a client error, logged as a warning
+				logHandler.AssertErrorCount(t, 0)
+				logHandler.AssertInfoCount(t, 1)
 			} else {
 				// For success, verify result contains text
 				if result.IsError {
@@ -125,6 +128,26 @@ func TestHandleGetCurrentTime(t *testing.T) {
 	}
 }
 
+func TestHandleGetCurrentTimeSavedLocation(t *testing.T) {
+	logger, _ := testutil.NewTestLogger()
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"format":   "%Z",
+				"timezone": "tokyo-office",
+			},
+		},
+	}
+
+	result, err := handleGetCurrentTime(context.Background(), request, logger, newTestResolver(map[string]string{"tokyo-office": "Asia/Tokyo"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text := resultText(t, result); result.IsError || text != "JST" {
+		t.Errorf("expected the time in JST, got %q", text)
+	}
+}
+
 func TestHandleGetCurrentTimeDefaults(t *testing.T) {
 	logger, _ := testutil.NewTestLogger()
 	ctx := context.Background()
@@ -136,7 +159,7 @@ func TestHandleGetCurrentTimeDefaults(t *testing.T) {
 		},
 	}
 
-	result, err := handleGetCurrentTime(ctx, request, logger)
+	result, err := handleGetCurrentTime(ctx, request, logger, newTestResolver(nil))
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -188,7 +211,7 @@ func TestHandleGetCurrentTimeCustomFormats(t *testing.T) {
 				},
 			}
 
-			result, err := handleGetCurrentTime(ctx, request, logger)
+			result, err := handleGetCurrentTime(ctx, request, logger, newTestResolver(nil))
 
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -212,6 +235,132 @@ func TestHandleGetCurrentTimeCustomFormats(t *testing.T) {
 	}
 }
 
+func TestHandleGetCurrentTimeFormatPresets(t *testing.T) {
+	tests := []struct {
+		format      string
+		pattern     string
+		shouldError bool
+	}{
+		{format: "isoweek", pattern: `^\d{4}-W\d{2}-[1-7]$`},
+		{format: "ordinal", pattern: `^\d{4}-\d{3}$`},
+		{format: "http", pattern: ` GMT$`},
+		{format: "unixnano", pattern: `^\d{19}$`},
+		{format: "julian", pattern: `^24\d{5}(\.\d+)?$`},
+		{format: "%H:%M", pattern: `^\d{2}:\d{2}$`},
+		{format: "%H:%Q", shouldError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: map[string]interface{}{"format": tt.format},
+				},
+			}
+
+			result, err := handleGetCurrentTime(context.Background(), request, logger, newTestResolver(nil))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError || !strings.Contains(text, "Invalid format") {
+					t.Errorf("expected invalid format error, got %q", text)
+				}
+				return
+			}
+			if !regexp.MustCompile(tt.pattern).MatchString(text) {
+				t.Errorf("expected %q to match %s", text, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestHandleGetCurrentTimeLocale(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		pattern      string
+		errorMessage string
+	}{
+		{
+			name:      "locale without format uses the long style",
+			arguments: map[string]interface{}{"locale": "de", "timezone": "Europe/Berlin"},
+			pattern:   `^\d{1,2}\. \S+ \d{4} um \d{2}:\d{2}:\d{2} CES?T$`,
+		},
+		{
+			name:      "style with locale",
+			arguments: map[string]interface{}{"locale": "ja-JP", "format": "date_long"},
+			pattern:   `^\d{4}年\d{1,2}月\d{1,2}日$`,
+		},
+		{
+			name:      "strftime names follow the locale",
+			arguments: map[string]interface{}{"locale": "fr", "format": "%A"},
+			pattern:   `^(lundi|mardi|mercredi|jeudi|vendredi|samedi|dimanche)$`,
+		},
+		{
+			name:      "presets ignore the locale",
+			arguments: map[string]interface{}{"locale": "zh", "format": "unix"},
+			pattern:   `^\d+$`,
+		},
+		{
+			name:         "unsupported locale",
+			arguments:    map[string]interface{}{"locale": "xx-YY"},
+			errorMessage: "Invalid locale",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Arguments: tt.arguments},
+			}
+
+			result, err := handleGetCurrentTime(context.Background(), request, logger, newTestResolver(nil))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.errorMessage != "" {
+				if !result.IsError || !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+			if result.IsError || !regexp.MustCompile(tt.pattern).MatchString(text) {
+				t.Errorf("expected %q to match %s", text, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestHandleAddTimeOffsetLocale(t *testing.T) {
+	logger, _ := testutil.NewTestLogger()
+	request := mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Arguments: map[string]interface{}{
+				"base":     "2025-06-10T14:30:00Z",
+				"days":     float64(1),
+				"locale":   "es",
+				"format":   "full",
+				"timezone": "Europe/Madrid",
+			},
+		},
+	}
+
+	result, err := handleAddTimeOffset(context.Background(), request, logger, newTestResolver(nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if text := resultText(t, result); text != "miércoles, 11 de junio de 2025, 16:30:00 CEST" {
+		t.Errorf("unexpected localized result %q", text)
+	}
+}
+
 func TestHandleAddTimeOffset(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -303,7 +452,7 @@ func TestHandleAddTimeOffset(t *testing.T) {
 				},
 			}
 
-			result, err := handleAddTimeOffset(ctx, request, logger)
+			result, err := handleAddTimeOffset(ctx, request, logger, newTestResolver(nil))
 
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -318,8 +467,9 @@ func TestHandleAddTimeOffset(t *testing.T) {
 				if !result.IsError {
 					t.Error("expected result to be an error")
 				}
-				// Verify error was logged
-				logHandler.AssertErrorCount(t, 1)
+				// An unknown timezone is a client error, logged as a warning
+				logHandler.AssertErrorCount(t, 0)
+				logHandler.AssertInfoCount(t, 1)
 			} else {
 				if result.IsError {
 					t.Errorf("expected successful result, got error: %v", result.Content)
@@ -347,7 +497,7 @@ func TestHandleAddTimeOffsetDefaults(t *testing.T) {
 		},
 	}
 
-	result, err := handleAddTimeOffset(ctx, request, logger)
+	result, err := handleAddTimeOffset(ctx, request, logger, newTestResolver(nil))
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -398,7 +548,7 @@ func TestHandleAddTimeOffsetFormats(t *testing.T) {
 				},
 			}
 
-			result, err := handleAddTimeOffset(ctx, request, logger)
+			result, err := handleAddTimeOffset(ctx, request, logger, newTestResolver(nil))
 
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -453,7 +603,7 @@ func TestHandleAddTimeOffsetCustomFormats(t *testing.T) {
 				},
 			}
 
-			result, err := handleAddTimeOffset(ctx, request, logger)
+			result, err := handleAddTimeOffset(ctx, request, logger, newTestResolver(nil))
 
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
@@ -478,6 +628,137 @@ func TestHandleAddTimeOffsetCustomFormats(t *testing.T) {
 }
 
 // TestNewServerWithMetrics verifies that NewServerWithMetrics creates a server with metrics tracking
+func TestHandleAddTimeOffsetCalendar(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		want         string
+		shouldError  bool
+		errorMessage string
+	}{
+		{
+			name: "one day across spring forward keeps wall clock",
+			arguments: map[string]interface{}{
+				"base":     "2025-03-08 12:00",
+				"days":     1,
+				"timezone": "America/New_York",
+			},
+			want: "2025-03-09T12:00:00-04:00",
+		},
+		{
+			name: "saved location across spring forward",
+			arguments: map[string]interface{}{
+				"base":     "2025-03-08 12:00",
+				"days":     1,
+				"timezone": "nyc-office",
+			},
+			want: "2025-03-09T12:00:00-04:00",
+		},
+		{
+			name:         "fractional days",
+			arguments:    map[string]interface{}{"days": 1.5},
+			shouldError:  true,
+			errorMessage: "Validation failed: days must be an integer",
+		},
+		{
+			name: "whole days as floats",
+			arguments: map[string]interface{}{
+				"base":  "2025-01-31T09:00:00Z",
+				"weeks": float64(1),
+				"days":  float64(-2),
+			},
+			want: "2025-02-05T09:00:00Z",
+		},
+		{
+			name:         "unknown location",
+			arguments:    map[string]interface{}{"days": 1, "timezone": "paris-office"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'paris-office'",
+		},
+		{
+			name: "month end clamps by default",
+			arguments: map[string]interface{}{
+				"base":   "2025-01-31T09:00:00Z",
+				"months": 1,
+			},
+			want: "2025-02-28T09:00:00Z",
+		},
+		{
+			name: "month end rolls over",
+			arguments: map[string]interface{}{
+				"base":     "2025-01-31T09:00:00Z",
+				"months":   1,
+				"overflow": "rollover",
+			},
+			want: "2025-03-03T09:00:00Z",
+		},
+		{
+			name: "iso 8601 duration",
+			arguments: map[string]interface{}{
+				"base":     "2024-02-29T00:00:00Z",
+				"duration": "P1Y2W",
+			},
+			want: "2025-03-14T00:00:00Z",
+		},
+		{
+			name: "years weeks and seconds",
+			arguments: map[string]interface{}{
+				"base":    "2025-01-01T00:00:00Z",
+				"years":   -1,
+				"weeks":   1,
+				"seconds": 90,
+			},
+			want: "2024-01-08T00:01:30Z",
+		},
+		{
+			name: "duration combined with units",
+			arguments: map[string]interface{}{
+				"duration": "PT1H",
+				"hours":    1,
+			},
+			shouldError:  true,
+			errorMessage: "duration cannot be combined",
+		},
+		{
+			name:         "invalid base",
+			arguments:    map[string]interface{}{"base": "yesterday"},
+			shouldError:  true,
+			errorMessage: "Invalid base 'yesterday'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleAddTimeOffset(context.Background(), request, logger, newTestResolver(map[string]string{"nyc-office": "America/New_York"}))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if text != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, text)
+			}
+		})
+	}
+}
+
 func TestNewServerWithMetrics(t *testing.T) {
 	logger, logHandler := testutil.NewTestLogger()
 
@@ -485,7 +766,7 @@ func TestNewServerWithMetrics(t *testing.T) {
 	m := metrics.New("test_mcpserver")
 
 	// Pass nil repository for testing without database
-	server := NewServerWithMetrics(logger, m, nil)
+	server := NewServerWithMetrics(logger, m, nil, nil, nil)
 
 	if server == nil {
 		t.Fatal("expected server to be created")
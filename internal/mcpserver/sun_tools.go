@@ -0,0 +1,83 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newSunTimesTool returns the get_sun_times tool definition
+func newSunTimesTool() mcp.Tool {
+	return mcp.NewTool("get_sun_times",
+		mcp.WithDescription("Get sunrise, sunset, solar noon, civil/nautical/astronomical twilight and day length for a saved location with coordinates, in the location's timezone. Reports polar day and polar night when the sun does not rise or set"),
+		mcp.WithString("location",
+			mcp.Required(),
+			mcp.Description("Saved location name; it must have a latitude and longitude"),
+		),
+		mcp.WithString("date",
+			mcp.Description("Date (YYYY-MM-DD, default: today in the location's timezone)"),
+		),
+	)
+}
+
+// handleGetSunTimes handles the get_sun_times tool
+func handleGetSunTimes(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, repo repository.LocationRepository) (*mcp.CallToolResult, error) {
+	name := request.GetString("location", "")
+	if name == "" {
+		log.Warn("get_sun_times: missing required parameter", "parameter", "location")
+		return mcp.NewToolResultError("Parameter 'location' is required"), nil
+	}
+
+	loc, err := repo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrLocationNotFound) {
+			log.Warn("get_sun_times: location not found", "location", name)
+			return mcp.NewToolResultError(fmt.Sprintf("Location '%s' not found", name)), nil
+		}
+		log.Error("get_sun_times: failed to get location", "location", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to get location: %v", err)), nil
+	}
+
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		log.Error("get_sun_times: failed to load timezone", "location", name, "timezone", loc.Timezone, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid timezone '%s': %v", loc.Timezone, err)), nil
+	}
+
+	date, err := model.ParseSunDate(request.GetString("date", ""), tz, time.Now())
+	if err != nil {
+		log.Warn("get_sun_times: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	response, err := model.NewSunResponse(loc, tz, date)
+	if err != nil {
+		log.Warn("get_sun_times: sun times unavailable", "location", name, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Location '%s' has no coordinates; set latitude and longitude with update_location", loc.Name)), nil
+	}
+
+	log.Info("get_sun_times executed",
+		"location", loc.Name,
+		"date", response.Date,
+		"polar_day", response.PolarDay,
+		"polar_night", response.PolarNight,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.SunResponse
+	}{true, response})
+	if err != nil {
+		log.Error("get_sun_times: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
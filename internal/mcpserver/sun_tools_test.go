@@ -0,0 +1,119 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleGetSunTimes(t *testing.T) {
+	locations := map[string]*model.Location{
+		"sydney": {Name: "sydney", Timezone: "Australia/Sydney", Coordinates: &model.Coordinates{Latitude: -33.8688, Longitude: 151.2093}},
+		"tromso": {Name: "tromso", Timezone: "Europe/Oslo", Coordinates: &model.Coordinates{Latitude: 69.6492, Longitude: 18.9553}},
+		"hq":     {Name: "hq", Timezone: "America/New_York"},
+	}
+	repo := &mockLocationRepository{
+		getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+			if loc, ok := locations[name]; ok {
+				return loc, nil
+			}
+			return nil, repository.ErrLocationNotFound
+		},
+	}
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.SunResponse)
+	}{
+		{
+			name:      "sydney midsummer",
+			arguments: map[string]interface{}{"location": "sydney", "date": "2025-12-21"},
+			check: func(t *testing.T, resp *model.SunResponse) {
+				if !strings.HasPrefix(resp.Sunrise, "2025-12-21T05:4") || !strings.HasSuffix(resp.Sunset, "+11:00") {
+					t.Errorf("unexpected sunrise %s or sunset %s", resp.Sunrise, resp.Sunset)
+				}
+				if resp.Timezone != "Australia/Sydney" || resp.PolarDay || resp.Nautical.Dawn == "" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:      "polar night",
+			arguments: map[string]interface{}{"location": "tromso", "date": "2025-12-21"},
+			check: func(t *testing.T, resp *model.SunResponse) {
+				if !resp.PolarNight || resp.Sunrise != "" || resp.DayLength != "0s" {
+					t.Errorf("expected polar night, got %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "missing location",
+			arguments:    map[string]interface{}{},
+			shouldError:  true,
+			errorMessage: "Parameter 'location' is required",
+		},
+		{
+			name:         "unknown location",
+			arguments:    map[string]interface{}{"location": "nowhere"},
+			shouldError:  true,
+			errorMessage: "Location 'nowhere' not found",
+		},
+		{
+			name:         "no coordinates",
+			arguments:    map[string]interface{}{"location": "hq"},
+			shouldError:  true,
+			errorMessage: "Location 'hq' has no coordinates",
+		},
+		{
+			name:         "invalid date",
+			arguments:    map[string]interface{}{"location": "sydney", "date": "tomorrow"},
+			shouldError:  true,
+			errorMessage: "Validation failed: date must be in YYYY-MM-DD format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleGetSunTimes(context.Background(), request, logger, repo)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.SunResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
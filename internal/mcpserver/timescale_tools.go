@@ -0,0 +1,127 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timescale"
+)
+
+// timeScaleNames returns the supported time scale names for tool enums
+func timeScaleNames() []string {
+	scales := timescale.Scales()
+	names := make([]string, len(scales))
+	for i, s := range scales {
+		names[i] = string(s)
+	}
+	return names
+}
+
+// newConvertTimeScaleTool returns the convert_time_scale tool definition
+func newConvertTimeScaleTool() mcp.Tool {
+	return mcp.NewTool("convert_time_scale",
+		mcp.WithDescription("Convert a time between UTC, TAI (atomic time), GPS time, TT (terrestrial time) and Unix time using the leap second table, e.g. a GPS week and time of week from telemetry to UTC. UTC times during a leap second are written 23:59:60. Times are converted from 1972 onwards"),
+		mcp.WithString("scale",
+			mcp.Description("Time scale the time is read on (default: utc)"),
+			mcp.Enum(timeScaleNames()...),
+		),
+		mcp.WithString("time",
+			mcp.Description("Time to convert (default: now). UTC and Unix take any format parse_time detects; GPS also takes seconds since 1980-01-06; TAI, GPS and TT otherwise take a clock reading with no zone, e.g. '2017-01-01T00:00:37.5'"),
+		),
+		mcp.WithNumber("week",
+			mcp.Description("GPS week number since 1980-01-06, with scale gps, instead of time"),
+		),
+		mcp.WithNumber("seconds_of_week",
+			mcp.Description(fmt.Sprintf("Seconds into the GPS week, 0 to under %d", model.SecondsPerGPSWeek)),
+		),
+		mcp.WithArray("to",
+			mcp.Description("Time scales to convert to (default: all)"),
+			mcp.WithStringItems(),
+		),
+	)
+}
+
+// newGetLeapSecondsTool returns the get_leap_seconds tool definition
+func newGetLeapSecondsTool() mcp.Tool {
+	return mcp.NewTool("get_leap_seconds",
+		mcp.WithDescription("Get the current TAI−UTC, GPS−UTC and TT−UTC offsets, the last leap second, the next scheduled leap second if the leap second table lists one, and every leap second since 1972. Reports when the table has expired, so a leap second may have been announced since"),
+	)
+}
+
+// handleConvertTimeScale handles the convert_time_scale tool
+func handleConvertTimeScale(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger) (*mcp.CallToolResult, error) {
+	to, err := stringListArg(request, "to")
+	if err != nil {
+		log.Warn("convert_time_scale: invalid to", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid to: %v", err)), nil
+	}
+	req := model.TimeScaleRequest{
+		Scale:         request.GetString("scale", ""),
+		Time:          request.GetString("time", ""),
+		Week:          request.GetInt("week", 0),
+		SecondsOfWeek: request.GetFloat("seconds_of_week", 0),
+		To:            to,
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("convert_time_scale: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	reading, err := req.Reading(time.Now())
+	if err != nil {
+		log.Warn("convert_time_scale: invalid time", "scale", req.Scale, "time", req.Time, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid %s time '%s': %v", req.Scale, req.Time, err)), nil
+	}
+
+	response, err := model.NewTimeScaleResponse(timescale.Default(), reading, req.Targets())
+	if err != nil {
+		log.Warn("convert_time_scale: conversion failed", "scale", req.Scale, "time", req.Time, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Conversion failed: %v", err)), nil
+	}
+
+	log.Info("convert_time_scale executed",
+		"scale", response.Input.Scale,
+		"time", response.Input.Time,
+		"count", len(response.Readings),
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.TimeScaleResponse
+	}{true, response})
+	if err != nil {
+		log.Error("convert_time_scale: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
+
+// handleGetLeapSeconds handles the get_leap_seconds tool
+func handleGetLeapSeconds(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger) (*mcp.CallToolResult, error) {
+	response := model.NewLeapSecondsResponse(timescale.Default(), time.Now())
+
+	log.Info("get_leap_seconds executed",
+		"tai_minus_utc", response.TAIMinusUTC,
+		"next", response.NextStatus,
+		"source", response.Table.Source,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.LeapSecondsResponse
+	}{true, response})
+	if err != nil {
+		log.Error("get_leap_seconds: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,120 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleConvertTimeScale(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.TimeScaleResponse)
+	}{
+		{
+			name: "gps week and time of week to utc",
+			arguments: map[string]interface{}{
+				"scale":           "gps",
+				"week":            float64(2347),
+				"seconds_of_week": 259218.25,
+				"to":              []interface{}{"utc", "tai"},
+			},
+			check: func(t *testing.T, resp *model.TimeScaleResponse) {
+				if len(resp.Readings) != 2 || resp.Readings[0].Time != "2025-01-01T00:00:00.25Z" || resp.Readings[1].Time != "2025-01-01T00:00:37.25" {
+					t.Errorf("unexpected readings: %+v", resp.Readings)
+				}
+			},
+		},
+		{
+			name:      "leap second to tt",
+			arguments: map[string]interface{}{"time": "2016-12-31T23:59:60Z", "to": []interface{}{"tt"}},
+			check: func(t *testing.T, resp *model.TimeScaleResponse) {
+				if !resp.Input.LeapSecond || len(resp.Readings) != 1 || resp.Readings[0].Time != "2017-01-01T00:01:08.184" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+			},
+		},
+		{
+			name:         "week on another scale",
+			arguments:    map[string]interface{}{"scale": "tai", "week": float64(2000)},
+			shouldError:  true,
+			errorMessage: "Validation failed: week and seconds_of_week give a GPS time",
+		},
+		{
+			name:         "unparseable tai reading",
+			arguments:    map[string]interface{}{"scale": "tai", "time": "tomorrow"},
+			shouldError:  true,
+			errorMessage: "Invalid tai time 'tomorrow'",
+		},
+		{
+			name:         "before 1972",
+			arguments:    map[string]interface{}{"time": "1969-07-20T20:17:00Z"},
+			shouldError:  true,
+			errorMessage: "Conversion failed: time scales are only converted from 1972-01-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleConvertTimeScale(context.Background(), request, logger)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.TimeScaleResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
+
+func TestHandleGetLeapSeconds(t *testing.T) {
+	logger, _ := testutil.NewTestLogger()
+	result, err := handleGetLeapSeconds(context.Background(), mcp.CallToolRequest{}, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	text := resultText(t, result)
+	if result.IsError {
+		t.Fatalf("expected success, got error: %s", text)
+	}
+	var resp model.LeapSecondsResponse
+	if err := json.Unmarshal([]byte(text), &resp); err != nil {
+		t.Fatalf("failed to unmarshal response: %v", err)
+	}
+	if resp.TAIMinusUTC < 37 || resp.Last == nil || len(resp.LeapSeconds) < 27 || resp.NextStatus == "" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
@@ -0,0 +1,62 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newListTimezonesTool returns the list_timezones tool definition
+func newListTimezonesTool() mcp.Tool {
+	return mcp.NewTool("list_timezones",
+		mcp.WithDescription("List or search IANA timezone names with their current offset, abbreviation, DST usage and alias status"),
+		mcp.WithString("search",
+			mcp.Description("Case-insensitive text to match anywhere in the name; spaces match underscores (e.g., 'new york')"),
+		),
+		mcp.WithString("prefix",
+			mcp.Description("Case-insensitive name prefix (e.g., 'America/', 'Europe/')"),
+		),
+		mcp.WithBoolean("canonical_only",
+			mcp.Description("Exclude links (aliases such as US/Eastern) and list canonical zones only"),
+		),
+	)
+}
+
+// handleListTimezones handles the list_timezones tool
+func handleListTimezones(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger) (*mcp.CallToolResult, error) {
+	req := model.TimezoneListRequest{
+		Search:        request.GetString("search", ""),
+		Prefix:        request.GetString("prefix", ""),
+		CanonicalOnly: request.GetBool("canonical_only", false),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("list_timezones: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	response := model.NewTimezoneListResponse(&req, time.Now())
+
+	log.Info("list_timezones executed",
+		"search", req.Search,
+		"prefix", req.Prefix,
+		"count", response.Count,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.TimezoneListResponse
+	}{true, response})
+	if err != nil {
+		log.Error("list_timezones: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,100 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleListTimezones(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.TimezoneListResponse)
+	}{
+		{
+			name:      "search",
+			arguments: map[string]interface{}{"search": "tokyo"},
+			check: func(t *testing.T, resp *model.TimezoneListResponse) {
+				if resp.Count != 1 || resp.Timezones[0].Name != "Asia/Tokyo" {
+					t.Fatalf("expected only Asia/Tokyo, got %+v", resp.Timezones)
+				}
+				if resp.Timezones[0].Offset != "+09:00" || resp.Timezones[0].ObservesDST {
+					t.Errorf("unexpected entry: %+v", resp.Timezones[0])
+				}
+			},
+		},
+		{
+			name:      "links included by default",
+			arguments: map[string]interface{}{"prefix": "US/"},
+			check: func(t *testing.T, resp *model.TimezoneListResponse) {
+				if resp.Count == 0 {
+					t.Fatal("expected US/ aliases")
+				}
+				for _, tz := range resp.Timezones {
+					if tz.Canonical || tz.LinkTarget == "" {
+						t.Errorf("expected %s to be a link", tz.Name)
+					}
+				}
+			},
+		},
+		{
+			name:      "canonical only",
+			arguments: map[string]interface{}{"prefix": "US/", "canonical_only": true},
+			check: func(t *testing.T, resp *model.TimezoneListResponse) {
+				if resp.Count != 0 {
+					t.Errorf("expected no canonical US/ zones, got %d", resp.Count)
+				}
+			},
+		},
+		{
+			name:         "search too long",
+			arguments:    map[string]interface{}{"search": strings.Repeat("x", 101)},
+			shouldError:  true,
+			errorMessage: "100 characters or less",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleListTimezones(context.Background(), request, logger)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.TimezoneListResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
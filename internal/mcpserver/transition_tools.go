@@ -0,0 +1,76 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newDSTTransitionsTool returns the get_dst_transitions tool definition
+func newDSTTransitionsTool() mcp.Tool {
+	return mcp.NewTool("get_dst_transitions",
+		mcp.WithDescription("List the DST and UTC offset transitions of a timezone or saved location within a date range"),
+		mcp.WithString("zone",
+			mcp.Required(),
+			mcp.Description("IANA timezone or saved location name"),
+		),
+		mcp.WithString("start",
+			mcp.Description("Start of the range: RFC3339, unix seconds, '2006-01-02 15:04', or '2006-01-02' (default: now)"),
+		),
+		mcp.WithString("end",
+			mcp.Description("End of the range, exclusive (default: one year after start, at most 100 years)"),
+		),
+	)
+}
+
+// handleGetDSTTransitions handles the get_dst_transitions tool
+func handleGetDSTTransitions(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.TransitionsRequest{
+		Zone:  request.GetString("zone", ""),
+		Start: request.GetString("start", ""),
+		End:   request.GetString("end", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("get_dst_transitions: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	target, err := resolver.Resolve(ctx, req.Zone)
+	if err != nil {
+		return zoneErrorResult(log, "get_dst_transitions", req.Zone, err), nil
+	}
+
+	start, end, err := req.Range(target.TZ, time.Now())
+	if err != nil {
+		log.Warn("get_dst_transitions: invalid range", "start", req.Start, "end", req.End, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid range: %v", err)), nil
+	}
+
+	response := model.NewTransitionsResponse(target.TZ, target.Location, start, end)
+
+	log.Info("get_dst_transitions executed",
+		"zone", req.Zone,
+		"start", response.Start,
+		"end", response.End,
+		"count", len(response.Transitions),
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.TransitionsResponse
+	}{true, response})
+	if err != nil {
+		log.Error("get_dst_transitions: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
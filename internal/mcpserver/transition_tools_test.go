@@ -0,0 +1,123 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleGetDSTTransitions(t *testing.T) {
+	resolver := newTestResolver(map[string]string{"hq": "America/New_York"})
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.TransitionsResponse)
+	}{
+		{
+			name: "saved location over a year",
+			arguments: map[string]interface{}{
+				"zone":  "hq",
+				"start": "2025-01-01",
+				"end":   "2026-01-01",
+			},
+			check: func(t *testing.T, resp *model.TransitionsResponse) {
+				if resp.Location != "hq" || resp.Timezone != "America/New_York" {
+					t.Errorf("unexpected zone: %s (%s)", resp.Location, resp.Timezone)
+				}
+				if len(resp.Transitions) != 2 {
+					t.Fatalf("expected 2 transitions, got %d", len(resp.Transitions))
+				}
+				spring := resp.Transitions[0]
+				if spring.Instant != "2025-03-09T07:00:00Z" || spring.LocalTime != "2025-03-09T03:00:00-04:00" {
+					t.Errorf("unexpected spring forward: %+v", spring)
+				}
+				if spring.OldOffset != "-05:00" || spring.NewOffset != "-04:00" || !spring.IsDST {
+					t.Errorf("unexpected spring forward offsets: %+v", spring)
+				}
+			},
+		},
+		{
+			name: "historical range",
+			arguments: map[string]interface{}{
+				"zone":  "Europe/Moscow",
+				"start": "2011-01-01",
+				"end":   "2015-01-01",
+			},
+			check: func(t *testing.T, resp *model.TransitionsResponse) {
+				// Moscow moved to permanent +04:00 in 2011 and back to +03:00 in 2014
+				if len(resp.Transitions) != 2 {
+					t.Fatalf("expected 2 transitions, got %d: %+v", len(resp.Transitions), resp.Transitions)
+				}
+				if resp.Transitions[1].NewOffset != "+03:00" {
+					t.Errorf("expected return to +03:00, got %+v", resp.Transitions[1])
+				}
+			},
+		},
+		{
+			name:         "missing zone",
+			arguments:    map[string]interface{}{},
+			shouldError:  true,
+			errorMessage: "timezone or location is required",
+		},
+		{
+			name:         "unknown zone",
+			arguments:    map[string]interface{}{"zone": "branch"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'branch'",
+		},
+		{
+			name: "reversed range",
+			arguments: map[string]interface{}{
+				"zone":  "UTC",
+				"start": "2025-01-01",
+				"end":   "2024-01-01",
+			},
+			shouldError:  true,
+			errorMessage: "end must be after start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleGetDSTTransitions(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.TransitionsResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
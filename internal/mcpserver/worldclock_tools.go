@@ -0,0 +1,91 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// newWorldClockTool returns the world_clock tool definition
+func newWorldClockTool() mcp.Tool {
+	return mcp.NewTool("world_clock",
+		mcp.WithDescription("Get the current time at all or selected saved locations in one call, sorted by UTC offset, with '+1 day'/'-1 day' markers where the date differs from a reference zone"),
+		mcp.WithArray("names",
+			mcp.Description("Saved location names to include (default: all)"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithArray("tags",
+			mcp.Description("Include only locations with any of these tags, e.g. ['emea']"),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("reference",
+			mcp.Description("IANA timezone or saved location the day markers and relative offsets are relative to (default: UTC)"),
+		),
+	)
+}
+
+// handleWorldClock handles the world_clock tool
+func handleWorldClock(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	names, err := stringListArg(request, "names")
+	if err != nil {
+		log.Warn("world_clock: invalid names", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid names: %v", err)), nil
+	}
+	tags, err := stringListArg(request, "tags")
+	if err != nil {
+		log.Warn("world_clock: invalid tags", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid tags: %v", err)), nil
+	}
+	req := model.WorldClockRequest{
+		Names:     names,
+		Tags:      tags,
+		Reference: request.GetString("reference", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("world_clock: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	ref, err := resolver.Resolve(ctx, req.Reference)
+	if err != nil {
+		return zoneErrorResult(log, "world_clock", req.Reference, err), nil
+	}
+
+	locations, err := resolver.SavedLocations(ctx)
+	if err != nil {
+		log.Error("world_clock: failed to list locations", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list locations: %v", err)), nil
+	}
+	if err := req.CheckNames(locations); err != nil {
+		log.Warn("world_clock: location not found", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid names: %v", err)), nil
+	}
+
+	response := model.NewWorldClockResponse(&req, time.Now(), ref.TZ, ref.Location, locations)
+
+	log.Info("world_clock executed",
+		"reference", ref.Name,
+		"names", len(req.Names),
+		"tags", len(req.Tags),
+		"count", response.Count,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.WorldClockResponse
+	}{true, response})
+	if err != nil {
+		log.Error("world_clock: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
@@ -0,0 +1,140 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleWorldClock(t *testing.T) {
+	saved := []*model.Location{
+		{Name: "sydney", Timezone: "Australia/Sydney", Tags: []string{"apac"}},
+		{Name: "berlin", Timezone: "Europe/Berlin", Tags: []string{"emea"}},
+		{Name: "la", Timezone: "America/Los_Angeles", Tags: []string{"amer"}},
+	}
+
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		listFunc     func(ctx context.Context) ([]*model.Location, error)
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.WorldClockResponse)
+	}{
+		{
+			name:      "all locations",
+			arguments: map[string]interface{}{},
+			check: func(t *testing.T, resp *model.WorldClockResponse) {
+				var order []string
+				for _, e := range resp.Locations {
+					order = append(order, e.Location)
+				}
+				if got := strings.Join(order, ","); got != "la,berlin,sydney" {
+					t.Errorf("locations = %s, want la,berlin,sydney", got)
+				}
+				for _, e := range resp.Locations {
+					if e.Day == "" && e.DayOffset != 0 {
+						t.Errorf("%s: missing day label for offset %d", e.Location, e.DayOffset)
+					}
+				}
+			},
+		},
+		{
+			name:      "names, tags and reference",
+			arguments: map[string]interface{}{"names": []interface{}{"sydney", "berlin", "la"}, "tags": []interface{}{"EMEA", "apac"}, "reference": "la"},
+			check: func(t *testing.T, resp *model.WorldClockResponse) {
+				if resp.Count != 2 || resp.Reference.Location != "la" {
+					t.Errorf("unexpected response: %+v", resp)
+				}
+				if resp.Locations[1].DayOffset < 0 || resp.Locations[1].DayOffset > 1 {
+					t.Errorf("sydney cannot be behind la, got %d days", resp.Locations[1].DayOffset)
+				}
+			},
+		},
+		{
+			name:         "unknown name",
+			arguments:    map[string]interface{}{"names": []interface{}{"paris"}},
+			shouldError:  true,
+			errorMessage: "location not found: paris",
+		},
+		{
+			name:         "invalid names",
+			arguments:    map[string]interface{}{"names": "sydney"},
+			shouldError:  true,
+			errorMessage: "Invalid names",
+		},
+		{
+			name:         "unknown reference",
+			arguments:    map[string]interface{}{"reference": "Mars/Olympus"},
+			shouldError:  true,
+			errorMessage: "Invalid timezone or location 'Mars/Olympus'",
+		},
+		{
+			name:      "repository error",
+			arguments: map[string]interface{}{},
+			listFunc: func(ctx context.Context) ([]*model.Location, error) {
+				return nil, errors.New("database unavailable")
+			},
+			shouldError:  true,
+			errorMessage: "Failed to list locations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			repo := &mockLocationRepository{
+				getByNameFunc: func(ctx context.Context, name string) (*model.Location, error) {
+					if name == "la" {
+						return saved[2], nil
+					}
+					return nil, repository.ErrLocationNotFound
+				},
+				listFunc: tt.listFunc,
+			}
+			if repo.listFunc == nil {
+				repo.listFunc = func(ctx context.Context) ([]*model.Location, error) {
+					return saved, nil
+				}
+			}
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleWorldClock(context.Background(), request, logger, zone.NewResolver(repo))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.WorldClockResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
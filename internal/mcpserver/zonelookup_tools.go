@@ -0,0 +1,74 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// newLookupTimezoneTool returns the lookup_timezone tool definition
+func newLookupTimezoneTool() mcp.Tool {
+	return mcp.NewTool("lookup_timezone",
+		mcp.WithDescription("Find the IANA timezones behind an abbreviation (e.g., PST, IST) or UTC offset (e.g., +05:30), ranked by saved locations and common usage, with ambiguity flagged"),
+		mcp.WithString("query",
+			mcp.Required(),
+			mcp.Description("Timezone abbreviation or UTC offset (e.g., 'IST', 'CEST', '+05:30', 'UTC-8')"),
+		),
+		mcp.WithString("at",
+			mcp.Description("Instant to evaluate the abbreviation or offset at, in any format parse_time detects, read in UTC when it has no offset (default: now)"),
+		),
+	)
+}
+
+// handleLookupTimezone handles the lookup_timezone tool
+func handleLookupTimezone(ctx context.Context, request mcp.CallToolRequest, log *slog.Logger, resolver *zone.Resolver) (*mcp.CallToolResult, error) {
+	req := model.ZoneLookupRequest{
+		Query: request.GetString("query", ""),
+		At:    request.GetString("at", ""),
+	}
+
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		log.Warn("lookup_timezone: validation failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Validation failed: %v", err)), nil
+	}
+
+	at, err := timeparse.Parse(req.At, time.UTC, time.Now())
+	if err != nil {
+		log.Warn("lookup_timezone: invalid time", "at", req.At, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Invalid at '%s': %v", req.At, err)), nil
+	}
+
+	locations, err := resolver.SavedLocations(ctx)
+	if err != nil {
+		log.Error("lookup_timezone: failed to list locations", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("Failed to list locations: %v", err)), nil
+	}
+
+	response := model.NewZoneLookupResponse(req.ZoneQuery(), at, locations)
+
+	log.Info("lookup_timezone executed",
+		"query", response.Query,
+		"at", response.At,
+		"count", response.Count,
+		"ambiguous", response.Ambiguous,
+	)
+
+	responseJSON, err := json.Marshal(struct {
+		Success bool `json:"success"`
+		*model.ZoneLookupResponse
+	}{true, response})
+	if err != nil {
+		log.Error("lookup_timezone: failed to marshal response", "error", err)
+		return mcp.NewToolResultError("Failed to format response"), nil
+	}
+
+	return mcp.NewToolResultText(string(responseJSON)), nil
+}
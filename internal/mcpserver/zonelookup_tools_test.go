@@ -0,0 +1,118 @@
+package mcpserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/yourorg/timeservice/internal/testutil"
+	"github.com/yourorg/timeservice/internal/zone"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestHandleLookupTimezone(t *testing.T) {
+	tests := []struct {
+		name         string
+		arguments    map[string]interface{}
+		listFunc     func(ctx context.Context) ([]*model.Location, error)
+		shouldError  bool
+		errorMessage string
+		check        func(t *testing.T, resp *model.ZoneLookupResponse)
+	}{
+		{
+			name:      "ambiguous abbreviation in summer",
+			arguments: map[string]interface{}{"query": "IST", "at": "2025-07-01T00:00:00Z"},
+			check: func(t *testing.T, resp *model.ZoneLookupResponse) {
+				if !resp.Ambiguous {
+					t.Errorf("expected IST to be ambiguous, offsets %v", resp.Offsets)
+				}
+				// Israel is on IDT in July, so only India and Ireland use IST
+				want := map[string]string{"Asia/Kolkata": "current", "Europe/Dublin": "current", "Asia/Jerusalem": "seasonal"}
+				for _, m := range resp.Matches[:3] {
+					if m.Status != want[m.Timezone] {
+						t.Errorf("expected %s to be %s, got %s", m.Timezone, want[m.Timezone], m.Status)
+					}
+				}
+			},
+		},
+		{
+			name:      "saved location outranks preference table",
+			arguments: map[string]interface{}{"query": "CST", "at": "2025-01-15T00:00:00Z"},
+			listFunc: func(ctx context.Context) ([]*model.Location, error) {
+				return []*model.Location{{Name: "beijing", Timezone: "Asia/Shanghai"}}, nil
+			},
+			check: func(t *testing.T, resp *model.ZoneLookupResponse) {
+				if resp.Matches[0].Timezone != "Asia/Shanghai" {
+					t.Errorf("expected Asia/Shanghai first, got %s", resp.Matches[0].Timezone)
+				}
+				if resp.Matches[1].Timezone != "America/Chicago" {
+					t.Errorf("expected America/Chicago second, got %s", resp.Matches[1].Timezone)
+				}
+			},
+		},
+		{
+			name:      "offset",
+			arguments: map[string]interface{}{"query": "UTC-8", "at": "2025-01-15T00:00:00Z"},
+			check: func(t *testing.T, resp *model.ZoneLookupResponse) {
+				if resp.Query != "-08:00" || resp.Matches[0].Timezone != "America/Los_Angeles" {
+					t.Errorf("expected America/Los_Angeles for -08:00, got %s %+v", resp.Query, resp.Matches[0])
+				}
+			},
+		},
+		{
+			name:         "invalid query",
+			arguments:    map[string]interface{}{"query": "Eastern Time"},
+			shouldError:  true,
+			errorMessage: "query must be a timezone abbreviation",
+		},
+		{
+			name:      "repository error",
+			arguments: map[string]interface{}{"query": "EST"},
+			listFunc: func(ctx context.Context) ([]*model.Location, error) {
+				return nil, errors.New("database unavailable")
+			},
+			shouldError:  true,
+			errorMessage: "Failed to list locations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := testutil.NewTestLogger()
+			resolver := zone.NewResolver(&mockLocationRepository{listFunc: tt.listFunc})
+			request := mcp.CallToolRequest{
+				Params: mcp.CallToolParams{
+					Arguments: tt.arguments,
+				},
+			}
+
+			result, err := handleLookupTimezone(context.Background(), request, logger, resolver)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			text := resultText(t, result)
+			if tt.shouldError {
+				if !result.IsError {
+					t.Fatalf("expected error result, got success: %s", text)
+				}
+				if !strings.Contains(text, tt.errorMessage) {
+					t.Errorf("expected error containing %q, got %q", tt.errorMessage, text)
+				}
+				return
+			}
+
+			if result.IsError {
+				t.Fatalf("expected success, got error: %s", text)
+			}
+			var resp model.ZoneLookupResponse
+			if err := json.Unmarshal([]byte(text), &resp); err != nil {
+				t.Fatalf("failed to unmarshal response: %v", err)
+			}
+			tt.check(t, &resp)
+		})
+	}
+}
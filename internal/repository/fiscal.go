@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/metrics"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// Fiscal calendar repository errors
+var (
+	ErrFiscalCalendarNotFound = errors.New("fiscal calendar not found")
+	ErrFiscalCalendarExists   = errors.New("fiscal calendar already exists")
+)
+
+// FiscalCalendarRepository defines the interface for fiscal calendar data access.
+// Calendars are referenced by name (case-insensitive).
+type FiscalCalendarRepository interface {
+	Create(ctx context.Context, cal *model.FiscalCalendar) error
+	GetByName(ctx context.Context, name string) (*model.FiscalCalendar, error)
+	Update(ctx context.Context, name string, cal *model.FiscalCalendar) error
+	Delete(ctx context.Context, name string) error
+	List(ctx context.Context) ([]*model.FiscalCalendar, error)
+}
+
+// sqliteFiscalCalendarRepository implements FiscalCalendarRepository for SQLite
+type sqliteFiscalCalendarRepository struct {
+	db      *sql.DB
+	metrics *metrics.Metrics
+}
+
+// NewFiscalCalendarRepository creates a new SQLite-backed fiscal calendar repository
+func NewFiscalCalendarRepository(db *sql.DB, m *metrics.Metrics) FiscalCalendarRepository {
+	return &sqliteFiscalCalendarRepository{
+		db:      db,
+		metrics: m,
+	}
+}
+
+// fiscalCalendarColumns are the columns scanned by scanFiscalCalendar
+const fiscalCalendarColumns = `id, name, COALESCE(description, ''), start_month, pattern, week_start,
+	COALESCE(year_end, ''), year_label, created_at, updated_at`
+
+// Create inserts a new fiscal calendar
+func (r *sqliteFiscalCalendarRepository) Create(ctx context.Context, cal *model.FiscalCalendar) (err error) {
+	start := time.Now()
+	defer func() { r.record("fiscal_calendar_create", start, err) }()
+
+	if err := cal.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO fiscal_calendars (name, description, start_month, pattern, week_start, year_end, year_label, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, cal.Name, cal.Description, cal.StartMonth, cal.Pattern, cal.WeekStart, nullString(cal.YearEnd), cal.YearLabel, now, now)
+	if err != nil {
+		if isSQLiteConstraintError(err) {
+			return ErrFiscalCalendarExists
+		}
+		return fmt.Errorf("failed to insert fiscal calendar: %w", err)
+	}
+
+	if cal.ID, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("failed to get insert id: %w", err)
+	}
+	cal.CreatedAt, cal.UpdatedAt = now, now
+	return nil
+}
+
+// GetByName retrieves a fiscal calendar by its name (case-insensitive)
+func (r *sqliteFiscalCalendarRepository) GetByName(ctx context.Context, name string) (cal *model.FiscalCalendar, err error) {
+	start := time.Now()
+	defer func() { r.record("fiscal_calendar_get", start, err) }()
+
+	row := r.db.QueryRowContext(ctx, `SELECT `+fiscalCalendarColumns+`
+		FROM fiscal_calendars
+		WHERE name = ? COLLATE NOCASE
+	`, name)
+	cal, err = scanFiscalCalendar(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFiscalCalendarNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fiscal calendar: %w", err)
+	}
+	return cal, nil
+}
+
+// Update replaces the settings of a fiscal calendar, keeping its name
+func (r *sqliteFiscalCalendarRepository) Update(ctx context.Context, name string, cal *model.FiscalCalendar) (err error) {
+	start := time.Now()
+	defer func() { r.record("fiscal_calendar_update", start, err) }()
+
+	// Validate the settings under the name being updated
+	check := *cal
+	check.Name = name
+	if err := check.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	now := time.Now().UTC()
+	err = r.db.QueryRowContext(ctx, `
+		UPDATE fiscal_calendars
+		SET description = ?, start_month = ?, pattern = ?, week_start = ?, year_end = ?, year_label = ?, updated_at = ?
+		WHERE name = ? COLLATE NOCASE
+		RETURNING id, name, created_at
+	`, cal.Description, cal.StartMonth, cal.Pattern, cal.WeekStart, nullString(cal.YearEnd), cal.YearLabel, now, name).
+		Scan(&cal.ID, &cal.Name, &cal.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrFiscalCalendarNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update fiscal calendar: %w", err)
+	}
+	cal.UpdatedAt = now
+	return nil
+}
+
+// Delete removes a fiscal calendar by name
+func (r *sqliteFiscalCalendarRepository) Delete(ctx context.Context, name string) (err error) {
+	start := time.Now()
+	defer func() { r.record("fiscal_calendar_delete", start, err) }()
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM fiscal_calendars WHERE name = ? COLLATE NOCASE`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete fiscal calendar: %w", err)
+	}
+	return checkAffected(result, ErrFiscalCalendarNotFound)
+}
+
+// List retrieves all fiscal calendars, ordered by name
+func (r *sqliteFiscalCalendarRepository) List(ctx context.Context) (calendars []*model.FiscalCalendar, err error) {
+	start := time.Now()
+	defer func() { r.record("fiscal_calendar_list", start, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `SELECT `+fiscalCalendarColumns+`
+		FROM fiscal_calendars
+		ORDER BY name COLLATE NOCASE
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fiscal calendars: %w", err)
+	}
+	defer rows.Close()
+
+	calendars = []*model.FiscalCalendar{}
+	for rows.Next() {
+		cal, err := scanFiscalCalendar(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fiscal calendar: %w", err)
+		}
+		calendars = append(calendars, cal)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return calendars, nil
+}
+
+// record records the duration and outcome of an operation
+func (r *sqliteFiscalCalendarRepository) record(operation string, start time.Time, err error) {
+	r.metrics.DBQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+
+	switch {
+	case err == nil:
+		r.metrics.DBQueriesTotal.WithLabelValues(operation, "success").Inc()
+	case errors.Is(err, ErrFiscalCalendarNotFound):
+		r.metrics.DBQueriesTotal.WithLabelValues(operation, "not_found").Inc()
+	default:
+		r.metrics.DBQueriesTotal.WithLabelValues(operation, "error").Inc()
+		r.metrics.DBErrorsTotal.WithLabelValues(operation).Inc()
+	}
+}
+
+// scanFiscalCalendar scans a row of fiscalCalendarColumns
+func scanFiscalCalendar(row interface{ Scan(dest ...any) error }) (*model.FiscalCalendar, error) {
+	var cal model.FiscalCalendar
+	err := row.Scan(&cal.ID, &cal.Name, &cal.Description, &cal.StartMonth, &cal.Pattern, &cal.WeekStart,
+		&cal.YearEnd, &cal.YearLabel, &cal.CreatedAt, &cal.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &cal, nil
+}
+
+// nullString stores an empty string as NULL
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
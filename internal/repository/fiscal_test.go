@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func setupFiscalRepo(t *testing.T) FiscalCalendarRepository {
+	t.Helper()
+	database := setupTestDB(t)
+	t.Cleanup(func() { database.Close() })
+
+	return NewFiscalCalendarRepository(database, testMetrics)
+}
+
+func TestFiscalCalendarCRUD(t *testing.T) {
+	repo := setupFiscalRepo(t)
+	ctx := context.Background()
+
+	retail := &model.FiscalCalendar{Name: "retail", StartMonth: 2, Pattern: "4-5-4", WeekStart: "sunday", YearEnd: "nearest", YearLabel: "start"}
+	if err := repo.Create(ctx, retail); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if retail.ID == 0 || retail.CreatedAt.IsZero() {
+		t.Errorf("expected ID and timestamps to be set, got %+v", retail)
+	}
+
+	uk := &model.FiscalCalendar{Name: "uk-tax", Description: "UK tax year", StartMonth: 4, Pattern: "monthly", WeekStart: "monday", YearLabel: "start"}
+	if err := repo.Create(ctx, uk); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := repo.Create(ctx, &model.FiscalCalendar{Name: "RETAIL", StartMonth: 1, Pattern: "monthly", WeekStart: "monday", YearLabel: "end"}); !errors.Is(err, ErrFiscalCalendarExists) {
+		t.Errorf("expected ErrFiscalCalendarExists, got %v", err)
+	}
+	if err := repo.Create(ctx, &model.FiscalCalendar{Name: "bad", StartMonth: 13}); err == nil {
+		t.Error("expected validation error for invalid start month")
+	}
+
+	got, err := repo.GetByName(ctx, "Retail")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	if got.Pattern != "4-5-4" || got.YearEnd != "nearest" || got.WeekStart != "sunday" || got.StartMonth != 2 {
+		t.Errorf("unexpected calendar: %+v", got)
+	}
+	got, err = repo.GetByName(ctx, "uk-tax")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	if got.YearEnd != "" || got.Description != "UK tax year" {
+		t.Errorf("unexpected calendar: %+v", got)
+	}
+
+	update := &model.FiscalCalendar{StartMonth: 10, Pattern: "5-4-4", WeekStart: "sunday", YearEnd: "last", YearLabel: "end"}
+	if err := repo.Update(ctx, "RETAIL", update); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if update.Name != "retail" || update.ID != retail.ID {
+		t.Errorf("expected update to keep name and ID, got %+v", update)
+	}
+	if err := repo.Update(ctx, "missing", update); !errors.Is(err, ErrFiscalCalendarNotFound) {
+		t.Errorf("expected ErrFiscalCalendarNotFound, got %v", err)
+	}
+
+	calendars, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(calendars) != 2 || calendars[0].Name != "retail" || calendars[0].Pattern != "5-4-4" || calendars[1].Name != "uk-tax" {
+		t.Errorf("unexpected calendars: %+v", calendars)
+	}
+
+	if err := repo.Delete(ctx, "retail"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := repo.GetByName(ctx, "retail"); !errors.Is(err, ErrFiscalCalendarNotFound) {
+		t.Errorf("expected ErrFiscalCalendarNotFound after delete, got %v", err)
+	}
+	if err := repo.Delete(ctx, "retail"); !errors.Is(err, ErrFiscalCalendarNotFound) {
+		t.Errorf("expected ErrFiscalCalendarNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,375 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/metrics"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// Holiday repository errors
+var (
+	ErrCalendarNotFound = errors.New("holiday calendar not found")
+	ErrHolidayNotFound  = errors.New("holiday not found")
+	ErrHolidayExists    = errors.New("holiday already exists")
+)
+
+// HolidayRepository defines the interface for holiday data access.
+// Locations are referenced by name (case-insensitive).
+type HolidayRepository interface {
+	ImportCalendar(ctx context.Context, cal *model.HolidayCalendar) error
+	ListCalendars(ctx context.Context) ([]*model.HolidayCalendar, error)
+	LocationCalendars(ctx context.Context, location string) ([]string, error)
+	SetLocationCalendars(ctx context.Context, location string, calendars []string) error
+	ListHolidays(ctx context.Context, location, from, to string) ([]*model.Holiday, error)
+	CreateCustomHoliday(ctx context.Context, location string, h *model.Holiday) error
+	UpdateCustomHoliday(ctx context.Context, location string, id int64, h *model.Holiday) error
+	DeleteCustomHoliday(ctx context.Context, location string, id int64) error
+}
+
+// sqliteHolidayRepository implements HolidayRepository for SQLite
+type sqliteHolidayRepository struct {
+	db      *sql.DB
+	metrics *metrics.Metrics
+}
+
+// querier is satisfied by both *sql.DB and *sql.Tx
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// NewHolidayRepository creates a new SQLite-backed holiday repository
+func NewHolidayRepository(db *sql.DB, m *metrics.Metrics) HolidayRepository {
+	return &sqliteHolidayRepository{
+		db:      db,
+		metrics: m,
+	}
+}
+
+// ImportCalendar creates or replaces a calendar and all of its holidays.
+// Locations keep their references to a replaced calendar.
+func (r *sqliteHolidayRepository) ImportCalendar(ctx context.Context, cal *model.HolidayCalendar) (err error) {
+	start := time.Now()
+	defer func() { r.record("holiday_import", start, err) }()
+
+	if err := cal.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO holiday_calendars (name, description, source, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (name) DO UPDATE
+		SET description = excluded.description, source = excluded.source, updated_at = excluded.updated_at
+		RETURNING id, created_at
+	`, cal.Name, cal.Description, cal.Source, now, now).Scan(&cal.ID, &cal.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to upsert calendar: %w", err)
+	}
+	cal.UpdatedAt = now
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM holidays WHERE calendar_id = ?`, cal.ID); err != nil {
+		return fmt.Errorf("failed to clear holidays: %w", err)
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO holidays (calendar_id, date, name) VALUES (?, ?, ?)`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, h := range cal.Holidays {
+		if _, err = stmt.ExecContext(ctx, cal.ID, h.Date, h.Name); err != nil {
+			return fmt.Errorf("failed to insert holiday: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit calendar: %w", err)
+	}
+	cal.HolidayCount = len(cal.Holidays)
+	return nil
+}
+
+// ListCalendars retrieves all calendars with their holiday counts, ordered by name
+func (r *sqliteHolidayRepository) ListCalendars(ctx context.Context) (calendars []*model.HolidayCalendar, err error) {
+	start := time.Now()
+	defer func() { r.record("calendar_list", start, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.id, c.name, COALESCE(c.description, ''), COALESCE(c.source, ''),
+			c.created_at, c.updated_at, COUNT(h.id)
+		FROM holiday_calendars c
+		LEFT JOIN holidays h ON h.calendar_id = c.id
+		GROUP BY c.id
+		ORDER BY c.name COLLATE NOCASE
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query calendars: %w", err)
+	}
+	defer rows.Close()
+
+	calendars = []*model.HolidayCalendar{}
+	for rows.Next() {
+		var cal model.HolidayCalendar
+		if err = rows.Scan(&cal.ID, &cal.Name, &cal.Description, &cal.Source,
+			&cal.CreatedAt, &cal.UpdatedAt, &cal.HolidayCount); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar: %w", err)
+		}
+		calendars = append(calendars, &cal)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return calendars, nil
+}
+
+// LocationCalendars retrieves the names of the calendars attached to a location
+func (r *sqliteHolidayRepository) LocationCalendars(ctx context.Context, location string) (names []string, err error) {
+	start := time.Now()
+	defer func() { r.record("location_calendars_get", start, err) }()
+
+	locationID, err := lookupLocationID(ctx, r.db, location)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.name
+		FROM location_calendars lc
+		JOIN holiday_calendars c ON c.id = lc.calendar_id
+		WHERE lc.location_id = ?
+		ORDER BY c.name COLLATE NOCASE
+	`, locationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query location calendars: %w", err)
+	}
+	defer rows.Close()
+
+	names = []string{}
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar name: %w", err)
+		}
+		names = append(names, name)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return names, nil
+}
+
+// SetLocationCalendars replaces the calendars attached to a location
+func (r *sqliteHolidayRepository) SetLocationCalendars(ctx context.Context, location string, calendars []string) (err error) {
+	start := time.Now()
+	defer func() { r.record("location_calendars_set", start, err) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	locationID, err := lookupLocationID(ctx, tx, location)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM location_calendars WHERE location_id = ?`, locationID); err != nil {
+		return fmt.Errorf("failed to clear location calendars: %w", err)
+	}
+
+	for _, name := range calendars {
+		var calendarID int64
+		err = tx.QueryRowContext(ctx, `SELECT id FROM holiday_calendars WHERE name = ? COLLATE NOCASE`, name).Scan(&calendarID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %s", ErrCalendarNotFound, name)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to query calendar: %w", err)
+		}
+		if _, err = tx.ExecContext(ctx, `INSERT INTO location_calendars (location_id, calendar_id) VALUES (?, ?)`,
+			locationID, calendarID); err != nil {
+			return fmt.Errorf("failed to attach calendar: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit location calendars: %w", err)
+	}
+	return nil
+}
+
+// ListHolidays retrieves the holidays of a location's calendars and its custom
+// holidays between from and to inclusive (YYYY-MM-DD), ordered by date
+func (r *sqliteHolidayRepository) ListHolidays(ctx context.Context, location, from, to string) (holidays []*model.Holiday, err error) {
+	start := time.Now()
+	defer func() { r.record("holiday_list", start, err) }()
+
+	locationID, err := lookupLocationID(ctx, r.db, location)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT h.id, h.date, h.name, COALESCE(c.name, ''), h.location_id IS NOT NULL
+		FROM holidays h
+		LEFT JOIN holiday_calendars c ON c.id = h.calendar_id
+		WHERE (h.location_id = ?1
+			OR h.calendar_id IN (SELECT calendar_id FROM location_calendars WHERE location_id = ?1))
+			AND h.date BETWEEN ?2 AND ?3
+		ORDER BY h.date, h.location_id IS NULL, c.name COLLATE NOCASE, h.name
+	`, locationID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query holidays: %w", err)
+	}
+	defer rows.Close()
+
+	holidays = []*model.Holiday{}
+	for rows.Next() {
+		var h model.Holiday
+		if err = rows.Scan(&h.ID, &h.Date, &h.Name, &h.Calendar, &h.Custom); err != nil {
+			return nil, fmt.Errorf("failed to scan holiday: %w", err)
+		}
+		holidays = append(holidays, &h)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return holidays, nil
+}
+
+// CreateCustomHoliday adds a holiday to a single location
+func (r *sqliteHolidayRepository) CreateCustomHoliday(ctx context.Context, location string, h *model.Holiday) (err error) {
+	start := time.Now()
+	defer func() { r.record("holiday_create", start, err) }()
+
+	if err := h.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	locationID, err := lookupLocationID(ctx, r.db, location)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `INSERT INTO holidays (location_id, date, name) VALUES (?, ?, ?)`,
+		locationID, h.Date, h.Name)
+	if err != nil {
+		if isSQLiteConstraintError(err) {
+			return ErrHolidayExists
+		}
+		return fmt.Errorf("failed to insert holiday: %w", err)
+	}
+
+	if h.ID, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("failed to get insert id: %w", err)
+	}
+	h.Calendar = ""
+	h.Custom = true
+	return nil
+}
+
+// UpdateCustomHoliday modifies a custom holiday of a location
+func (r *sqliteHolidayRepository) UpdateCustomHoliday(ctx context.Context, location string, id int64, h *model.Holiday) (err error) {
+	start := time.Now()
+	defer func() { r.record("holiday_update", start, err) }()
+
+	if err := h.Validate(); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	locationID, err := lookupLocationID(ctx, r.db, location)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `UPDATE holidays SET date = ?, name = ? WHERE id = ? AND location_id = ?`,
+		h.Date, h.Name, id, locationID)
+	if err != nil {
+		if isSQLiteConstraintError(err) {
+			return ErrHolidayExists
+		}
+		return fmt.Errorf("failed to update holiday: %w", err)
+	}
+	if err = checkAffected(result, ErrHolidayNotFound); err != nil {
+		return err
+	}
+
+	h.ID = id
+	h.Calendar = ""
+	h.Custom = true
+	return nil
+}
+
+// DeleteCustomHoliday removes a custom holiday from a location
+func (r *sqliteHolidayRepository) DeleteCustomHoliday(ctx context.Context, location string, id int64) (err error) {
+	start := time.Now()
+	defer func() { r.record("holiday_delete", start, err) }()
+
+	locationID, err := lookupLocationID(ctx, r.db, location)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM holidays WHERE id = ? AND location_id = ?`, id, locationID)
+	if err != nil {
+		return fmt.Errorf("failed to delete holiday: %w", err)
+	}
+	return checkAffected(result, ErrHolidayNotFound)
+}
+
+// record records the duration and outcome of an operation
+func (r *sqliteHolidayRepository) record(operation string, start time.Time, err error) {
+	r.metrics.DBQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+
+	switch {
+	case err == nil:
+		r.metrics.DBQueriesTotal.WithLabelValues(operation, "success").Inc()
+	case errors.Is(err, ErrLocationNotFound), errors.Is(err, ErrCalendarNotFound), errors.Is(err, ErrHolidayNotFound):
+		r.metrics.DBQueriesTotal.WithLabelValues(operation, "not_found").Inc()
+	default:
+		r.metrics.DBQueriesTotal.WithLabelValues(operation, "error").Inc()
+		r.metrics.DBErrorsTotal.WithLabelValues(operation).Inc()
+	}
+}
+
+// lookupLocationID returns the ID of a location by name (case-insensitive)
+func lookupLocationID(ctx context.Context, q querier, name string) (int64, error) {
+	var id int64
+	err := q.QueryRowContext(ctx, `SELECT id FROM locations WHERE name = ? COLLATE NOCASE`, name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrLocationNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to query location: %w", err)
+	}
+	return id, nil
+}
+
+// checkAffected returns notFound when result affected no rows
+func checkAffected(result sql.Result, notFound error) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return notFound
+	}
+	return nil
+}
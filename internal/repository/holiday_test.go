@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// setupHolidayRepos creates location and holiday repositories sharing one database
+func setupHolidayRepos(t *testing.T) (LocationRepository, HolidayRepository) {
+	t.Helper()
+	database := setupTestDB(t)
+	t.Cleanup(func() { database.Close() })
+
+	return NewLocationRepository(database, testMetrics), NewHolidayRepository(database, testMetrics)
+}
+
+func TestImportCalendar(t *testing.T) {
+	_, holidays := setupHolidayRepos(t)
+	ctx := context.Background()
+
+	cal := &model.HolidayCalendar{
+		Name:   "uk-bank",
+		Source: "uk-bank.ics",
+		Holidays: []model.Holiday{
+			{Date: "2025-12-25", Name: "Christmas Day"},
+			{Date: "2025-12-26", Name: "Boxing Day"},
+		},
+	}
+	if err := holidays.ImportCalendar(ctx, cal); err != nil {
+		t.Fatalf("ImportCalendar() error = %v", err)
+	}
+	if cal.ID == 0 || cal.HolidayCount != 2 {
+		t.Errorf("expected ID and count to be set, got %d and %d", cal.ID, cal.HolidayCount)
+	}
+
+	// Re-importing replaces the holidays and keeps the ID
+	firstID := cal.ID
+	reimport := &model.HolidayCalendar{
+		Name:        "uk-bank",
+		Description: "UK bank holidays",
+		Holidays:    []model.Holiday{{Date: "2026-01-01", Name: "New Year's Day"}},
+	}
+	if err := holidays.ImportCalendar(ctx, reimport); err != nil {
+		t.Fatalf("ImportCalendar() re-import error = %v", err)
+	}
+	if reimport.ID != firstID {
+		t.Errorf("expected re-import to keep ID %d, got %d", firstID, reimport.ID)
+	}
+
+	calendars, err := holidays.ListCalendars(ctx)
+	if err != nil {
+		t.Fatalf("ListCalendars() error = %v", err)
+	}
+	if len(calendars) != 1 {
+		t.Fatalf("expected 1 calendar, got %d", len(calendars))
+	}
+	if calendars[0].HolidayCount != 1 || calendars[0].Description != "UK bank holidays" {
+		t.Errorf("unexpected calendar after re-import: %+v", calendars[0])
+	}
+
+	if err := holidays.ImportCalendar(ctx, &model.HolidayCalendar{Name: "bad name"}); err == nil {
+		t.Error("expected validation error for invalid calendar name")
+	}
+}
+
+func TestLocationCalendars(t *testing.T) {
+	locations, holidays := setupHolidayRepos(t)
+	ctx := context.Background()
+
+	if err := locations.Create(ctx, &model.Location{Name: "london", Timezone: "Europe/London"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	for _, name := range []string{"uk-bank", "company"} {
+		if err := holidays.ImportCalendar(ctx, &model.HolidayCalendar{Name: name}); err != nil {
+			t.Fatalf("ImportCalendar() error = %v", err)
+		}
+	}
+
+	if err := holidays.SetLocationCalendars(ctx, "LONDON", []string{"uk-bank", "company"}); err != nil {
+		t.Fatalf("SetLocationCalendars() error = %v", err)
+	}
+	names, err := holidays.LocationCalendars(ctx, "london")
+	if err != nil {
+		t.Fatalf("LocationCalendars() error = %v", err)
+	}
+	if len(names) != 2 || names[0] != "company" || names[1] != "uk-bank" {
+		t.Errorf("LocationCalendars() = %v, want [company uk-bank]", names)
+	}
+
+	// An unknown calendar leaves the existing attachments in place
+	err = holidays.SetLocationCalendars(ctx, "london", []string{"uk-bank", "missing"})
+	if !errors.Is(err, ErrCalendarNotFound) {
+		t.Errorf("expected ErrCalendarNotFound, got %v", err)
+	}
+	if names, _ := holidays.LocationCalendars(ctx, "london"); len(names) != 2 {
+		t.Errorf("expected attachments to be unchanged, got %v", names)
+	}
+
+	if err := holidays.SetLocationCalendars(ctx, "london", nil); err != nil {
+		t.Fatalf("SetLocationCalendars() clear error = %v", err)
+	}
+	if names, _ := holidays.LocationCalendars(ctx, "london"); len(names) != 0 {
+		t.Errorf("expected no calendars, got %v", names)
+	}
+
+	if err := holidays.SetLocationCalendars(ctx, "nowhere", nil); !errors.Is(err, ErrLocationNotFound) {
+		t.Errorf("expected ErrLocationNotFound, got %v", err)
+	}
+	if _, err := holidays.LocationCalendars(ctx, "nowhere"); !errors.Is(err, ErrLocationNotFound) {
+		t.Errorf("expected ErrLocationNotFound, got %v", err)
+	}
+}
+
+func TestListHolidays(t *testing.T) {
+	locations, holidays := setupHolidayRepos(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"london", "paris"} {
+		if err := locations.Create(ctx, &model.Location{Name: name, Timezone: "Europe/London"}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+	cal := &model.HolidayCalendar{
+		Name: "uk-bank",
+		Holidays: []model.Holiday{
+			{Date: "2025-12-25", Name: "Christmas Day"},
+			{Date: "2025-12-26", Name: "Boxing Day"},
+			{Date: "2026-01-01", Name: "New Year's Day"},
+		},
+	}
+	if err := holidays.ImportCalendar(ctx, cal); err != nil {
+		t.Fatalf("ImportCalendar() error = %v", err)
+	}
+	if err := holidays.SetLocationCalendars(ctx, "london", []string{"uk-bank"}); err != nil {
+		t.Fatalf("SetLocationCalendars() error = %v", err)
+	}
+	custom := &model.Holiday{Date: "2025-12-24", Name: "Office closed"}
+	if err := holidays.CreateCustomHoliday(ctx, "london", custom); err != nil {
+		t.Fatalf("CreateCustomHoliday() error = %v", err)
+	}
+	if err := holidays.CreateCustomHoliday(ctx, "paris", &model.Holiday{Date: "2025-12-24", Name: "Paris only"}); err != nil {
+		t.Fatalf("CreateCustomHoliday() error = %v", err)
+	}
+
+	got, err := holidays.ListHolidays(ctx, "london", "2025-12-24", "2025-12-31")
+	if err != nil {
+		t.Fatalf("ListHolidays() error = %v", err)
+	}
+	want := []string{"Office closed", "Christmas Day", "Boxing Day"}
+	if len(got) != len(want) {
+		t.Fatalf("ListHolidays() returned %d holidays, want %d: %+v", len(got), len(want), got)
+	}
+	for i, name := range want {
+		if got[i].Name != name {
+			t.Errorf("holiday %d = %s, want %s", i, got[i].Name, name)
+		}
+	}
+	if !got[0].Custom || got[0].Calendar != "" {
+		t.Errorf("expected custom holiday without calendar, got %+v", got[0])
+	}
+	if got[1].Custom || got[1].Calendar != "uk-bank" {
+		t.Errorf("expected uk-bank holiday, got %+v", got[1])
+	}
+
+	if _, err := holidays.ListHolidays(ctx, "nowhere", "2025-01-01", "2025-12-31"); !errors.Is(err, ErrLocationNotFound) {
+		t.Errorf("expected ErrLocationNotFound, got %v", err)
+	}
+}
+
+func TestCustomHolidayCRUD(t *testing.T) {
+	locations, holidays := setupHolidayRepos(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"london", "paris"} {
+		if err := locations.Create(ctx, &model.Location{Name: name, Timezone: "Europe/London"}); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	h := &model.Holiday{Date: "2025-08-01", Name: "Summer party"}
+	if err := holidays.CreateCustomHoliday(ctx, "london", h); err != nil {
+		t.Fatalf("CreateCustomHoliday() error = %v", err)
+	}
+	if h.ID == 0 || !h.Custom {
+		t.Errorf("expected ID and custom flag to be set, got %+v", h)
+	}
+
+	dup := &model.Holiday{Date: "2025-08-01", Name: "Summer party"}
+	if err := holidays.CreateCustomHoliday(ctx, "london", dup); !errors.Is(err, ErrHolidayExists) {
+		t.Errorf("expected ErrHolidayExists, got %v", err)
+	}
+	if err := holidays.CreateCustomHoliday(ctx, "london", &model.Holiday{Date: "2025-13-01", Name: "Bad"}); err == nil {
+		t.Error("expected validation error for invalid date")
+	}
+	if err := holidays.CreateCustomHoliday(ctx, "nowhere", &model.Holiday{Date: "2025-08-01", Name: "x"}); !errors.Is(err, ErrLocationNotFound) {
+		t.Errorf("expected ErrLocationNotFound, got %v", err)
+	}
+
+	update := &model.Holiday{Date: "2025-08-08", Name: "Summer party (moved)"}
+	if err := holidays.UpdateCustomHoliday(ctx, "london", h.ID, update); err != nil {
+		t.Fatalf("UpdateCustomHoliday() error = %v", err)
+	}
+	got, _ := holidays.ListHolidays(ctx, "london", "2025-08-01", "2025-08-31")
+	if len(got) != 1 || got[0].Date != "2025-08-08" || got[0].Name != "Summer party (moved)" {
+		t.Errorf("unexpected holidays after update: %+v", got)
+	}
+
+	// Holidays of another location are not reachable through this one
+	if err := holidays.UpdateCustomHoliday(ctx, "paris", h.ID, update); !errors.Is(err, ErrHolidayNotFound) {
+		t.Errorf("expected ErrHolidayNotFound, got %v", err)
+	}
+	if err := holidays.DeleteCustomHoliday(ctx, "paris", h.ID); !errors.Is(err, ErrHolidayNotFound) {
+		t.Errorf("expected ErrHolidayNotFound, got %v", err)
+	}
+
+	if err := holidays.DeleteCustomHoliday(ctx, "london", h.ID); err != nil {
+		t.Fatalf("DeleteCustomHoliday() error = %v", err)
+	}
+	if err := holidays.DeleteCustomHoliday(ctx, "london", h.ID); !errors.Is(err, ErrHolidayNotFound) {
+		t.Errorf("expected ErrHolidayNotFound, got %v", err)
+	}
+
+	// Deleting a location removes its custom holidays
+	if err := holidays.CreateCustomHoliday(ctx, "paris", &model.Holiday{Date: "2025-07-14", Name: "Bastille Day"}); err != nil {
+		t.Fatalf("CreateCustomHoliday() error = %v", err)
+	}
+	if err := locations.Delete(ctx, "paris"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := locations.Create(ctx, &model.Location{Name: "paris", Timezone: "Europe/Paris"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if got, _ := holidays.ListHolidays(ctx, "paris", "2025-01-01", "2025-12-31"); len(got) != 0 {
+		t.Errorf("expected holidays to be removed with the location, got %+v", got)
+	}
+}
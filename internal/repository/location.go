@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -50,9 +51,23 @@ func (r *sqliteLocationRepository) Create(ctx context.Context, loc *model.Locati
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
+	hours, err := encodeBusinessHours(loc.BusinessHours)
+	if err != nil {
+		return err
+	}
+	weekend, err := encodeWeekend(loc.Weekend)
+	if err != nil {
+		return err
+	}
+	tags, err := encodeTags(loc.Tags)
+	if err != nil {
+		return err
+	}
+	latitude, longitude := encodeCoordinates(loc.Coordinates)
+
 	query := `
-		INSERT INTO locations (name, timezone, description, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO locations (name, timezone, description, business_hours, weekend, tags, latitude, longitude, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	result, err := r.db.ExecContext(
@@ -61,6 +76,11 @@ func (r *sqliteLocationRepository) Create(ctx context.Context, loc *model.Locati
 		loc.Name,
 		loc.Timezone,
 		loc.Description,
+		hours,
+		weekend,
+		tags,
+		latitude,
+		longitude,
 		loc.CreatedAt,
 		loc.UpdatedAt,
 	)
@@ -99,20 +119,37 @@ func (r *sqliteLocationRepository) GetByName(ctx context.Context, name string) (
 	operation := "get"
 
 	query := `
-		SELECT id, name, timezone, description, created_at, updated_at
+		SELECT id, name, timezone, description, business_hours, weekend, tags, latitude, longitude, created_at, updated_at
 		FROM locations
 		WHERE name = ? COLLATE NOCASE
 	`
 
 	var loc model.Location
+	var hours, weekend, tags sql.NullString
+	var latitude, longitude sql.NullFloat64
 	err := r.db.QueryRowContext(ctx, query, name).Scan(
 		&loc.ID,
 		&loc.Name,
 		&loc.Timezone,
 		&loc.Description,
+		&hours,
+		&weekend,
+		&tags,
+		&latitude,
+		&longitude,
 		&loc.CreatedAt,
 		&loc.UpdatedAt,
 	)
+	if err == nil {
+		loc.BusinessHours, err = decodeBusinessHours(hours)
+	}
+	if err == nil {
+		loc.Weekend, err = decodeWeekend(weekend)
+	}
+	if err == nil {
+		loc.Tags, err = decodeTags(tags)
+	}
+	loc.Coordinates = decodeCoordinates(latitude, longitude)
 
 	// Record metrics
 	duration := time.Since(start).Seconds()
@@ -144,10 +181,36 @@ func (r *sqliteLocationRepository) Update(ctx context.Context, name string, loc
 	if err := model.ValidateDescription(loc.Description); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
+	if err := model.ValidateBusinessHours(loc.BusinessHours); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if err := model.ValidateWeekend(loc.Weekend); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if err := model.ValidateTags(loc.Tags); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+	if err := model.ValidateCoordinates(loc.Coordinates); err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	hours, err := encodeBusinessHours(loc.BusinessHours)
+	if err != nil {
+		return err
+	}
+	weekend, err := encodeWeekend(loc.Weekend)
+	if err != nil {
+		return err
+	}
+	tags, err := encodeTags(loc.Tags)
+	if err != nil {
+		return err
+	}
+	latitude, longitude := encodeCoordinates(loc.Coordinates)
 
 	query := `
 		UPDATE locations
-		SET timezone = ?, description = ?
+		SET timezone = ?, description = ?, business_hours = ?, weekend = ?, tags = ?, latitude = ?, longitude = ?
 		WHERE name = ? COLLATE NOCASE
 	`
 
@@ -156,6 +219,11 @@ func (r *sqliteLocationRepository) Update(ctx context.Context, name string, loc
 		query,
 		loc.Timezone,
 		loc.Description,
+		hours,
+		weekend,
+		tags,
+		latitude,
+		longitude,
 		name,
 	)
 
@@ -231,7 +299,7 @@ func (r *sqliteLocationRepository) List(ctx context.Context) ([]*model.Location,
 	operation := "list"
 
 	query := `
-		SELECT id, name, timezone, description, created_at, updated_at
+		SELECT id, name, timezone, description, business_hours, weekend, tags, latitude, longitude, created_at, updated_at
 		FROM locations
 		ORDER BY name COLLATE NOCASE
 	`
@@ -252,14 +320,31 @@ func (r *sqliteLocationRepository) List(ctx context.Context) ([]*model.Location,
 	var locations []*model.Location
 	for rows.Next() {
 		var loc model.Location
+		var hours, weekend, tags sql.NullString
+		var latitude, longitude sql.NullFloat64
 		err := rows.Scan(
 			&loc.ID,
 			&loc.Name,
 			&loc.Timezone,
 			&loc.Description,
+			&hours,
+			&weekend,
+			&tags,
+			&latitude,
+			&longitude,
 			&loc.CreatedAt,
 			&loc.UpdatedAt,
 		)
+		if err == nil {
+			loc.BusinessHours, err = decodeBusinessHours(hours)
+		}
+		if err == nil {
+			loc.Weekend, err = decodeWeekend(weekend)
+		}
+		if err == nil {
+			loc.Tags, err = decodeTags(tags)
+		}
+		loc.Coordinates = decodeCoordinates(latitude, longitude)
 		if err != nil {
 			r.metrics.DBQueriesTotal.WithLabelValues(operation, "error").Inc()
 			r.metrics.DBErrorsTotal.WithLabelValues(operation).Inc()
@@ -283,6 +368,96 @@ func (r *sqliteLocationRepository) List(ctx context.Context) ([]*model.Location,
 	return locations, nil
 }
 
+// encodeBusinessHours converts business hours to their JSON column value.
+// An empty schedule is stored as NULL.
+func encodeBusinessHours(hours *model.BusinessHours) (sql.NullString, error) {
+	if hours.IsEmpty() {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(hours)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode business hours: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeBusinessHours parses the JSON column value of business hours
+func decodeBusinessHours(value sql.NullString) (*model.BusinessHours, error) {
+	if !value.Valid || value.String == "" {
+		return nil, nil
+	}
+	var hours model.BusinessHours
+	if err := json.Unmarshal([]byte(value.String), &hours); err != nil {
+		return nil, fmt.Errorf("failed to decode business hours: %w", err)
+	}
+	return &hours, nil
+}
+
+// encodeWeekend converts weekend days to their JSON column value.
+// A nil weekend (the default) is stored as NULL; an empty one as [].
+func encodeWeekend(days []string) (sql.NullString, error) {
+	if days == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(days)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode weekend: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeWeekend parses the JSON column value of weekend days
+func decodeWeekend(value sql.NullString) ([]string, error) {
+	if !value.Valid || value.String == "" {
+		return nil, nil
+	}
+	days := []string{}
+	if err := json.Unmarshal([]byte(value.String), &days); err != nil {
+		return nil, fmt.Errorf("failed to decode weekend: %w", err)
+	}
+	return days, nil
+}
+
+// encodeTags converts tags to their JSON column value; no tags are stored as NULL
+func encodeTags(tags []string) (sql.NullString, error) {
+	if len(tags) == 0 {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("failed to encode tags: %w", err)
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeTags parses the JSON column value of tags
+func decodeTags(value sql.NullString) ([]string, error) {
+	if !value.Valid || value.String == "" {
+		return nil, nil
+	}
+	var tags []string
+	if err := json.Unmarshal([]byte(value.String), &tags); err != nil {
+		return nil, fmt.Errorf("failed to decode tags: %w", err)
+	}
+	return tags, nil
+}
+
+// encodeCoordinates converts coordinates to their column values; no position is stored as NULL
+func encodeCoordinates(c *model.Coordinates) (sql.NullFloat64, sql.NullFloat64) {
+	if c == nil {
+		return sql.NullFloat64{}, sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: c.Latitude, Valid: true}, sql.NullFloat64{Float64: c.Longitude, Valid: true}
+}
+
+// decodeCoordinates builds coordinates from their column values
+func decodeCoordinates(latitude, longitude sql.NullFloat64) *model.Coordinates {
+	if !latitude.Valid || !longitude.Valid {
+		return nil
+	}
+	return &model.Coordinates{Latitude: latitude.Float64, Longitude: longitude.Float64}
+}
+
 // isSQLiteConstraintError checks if an error is a SQLite constraint violation
 // SQLite returns "UNIQUE constraint failed" for duplicate insertions
 func isSQLiteConstraintError(err error) bool {
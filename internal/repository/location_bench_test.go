@@ -77,8 +77,6 @@ func BenchmarkLocationGet(b *testing.B) {
 
 	// Use shared benchmark metrics
 	repo := NewLocationRepository(database, benchMetrics)
-	_ = "bench_get")
-	repo := NewLocationRepository(database, m)
 	ctx := context.Background()
 
 	// Create some test locations
@@ -105,8 +103,6 @@ func BenchmarkLocationList(b *testing.B) {
 
 	// Use shared benchmark metrics
 	repo := NewLocationRepository(database, benchMetrics)
-	_ = "bench_list")
-	repo := NewLocationRepository(database, m)
 	ctx := context.Background()
 
 	// Create test data with different sizes
@@ -139,8 +135,6 @@ func BenchmarkLocationUpdate(b *testing.B) {
 
 	// Use shared benchmark metrics
 	repo := NewLocationRepository(database, benchMetrics)
-	_ = "bench_update")
-	repo := NewLocationRepository(database, m)
 	ctx := context.Background()
 
 	// Create test locations
@@ -173,8 +167,6 @@ func BenchmarkLocationDelete(b *testing.B) {
 
 	// Use shared benchmark metrics
 	repo := NewLocationRepository(database, benchMetrics)
-	_ = "bench_delete")
-	repo := NewLocationRepository(database, m)
 	ctx := context.Background()
 
 	// Pre-create locations for deletion
@@ -207,8 +199,6 @@ func BenchmarkLocationConcurrent(b *testing.B) {
 
 	// Use shared benchmark metrics
 	repo := NewLocationRepository(database, benchMetrics)
-	_ = "bench_concurrent")
-	repo := NewLocationRepository(database, m)
 	ctx := context.Background()
 
 	// Pre-populate with some data
@@ -260,8 +250,6 @@ func BenchmarkLocationFullCRUDCycle(b *testing.B) {
 
 	// Use shared benchmark metrics
 	repo := NewLocationRepository(database, benchMetrics)
-	_ = "bench_crud_cycle")
-	repo := NewLocationRepository(database, m)
 	ctx := context.Background()
 
 	b.ResetTimer()
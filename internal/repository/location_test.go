@@ -558,3 +558,172 @@ func TestDescriptionHandling(t *testing.T) {
 		}
 	})
 }
+
+func TestBusinessHoursHandling(t *testing.T) {
+	repo := setupTestRepo(t)
+	ctx := context.Background()
+
+	loc := model.NewLocation("shop", "Europe/Paris", "")
+	loc.BusinessHours = &model.BusinessHours{
+		Weekly: map[string][]model.OpeningInterval{
+			"monday": {{Open: "09:00", Close: "12:00"}, {Open: "14:00", Close: "18:00"}},
+		},
+		Overrides: []model.HoursOverride{{Date: "2025-12-25", Note: "Christmas"}},
+	}
+	if err := repo.Create(ctx, loc); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	retrieved, err := repo.GetByName(ctx, "shop")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	if hours := retrieved.BusinessHours; hours == nil || len(hours.Weekly["monday"]) != 2 || hours.Overrides[0].Note != "Christmas" {
+		t.Errorf("BusinessHours = %+v, want the stored schedule", retrieved.BusinessHours)
+	}
+
+	t.Run("clear", func(t *testing.T) {
+		retrieved.BusinessHours = nil
+		if err := repo.Update(ctx, "shop", retrieved); err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		locations, err := repo.List(ctx)
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if locations[0].BusinessHours != nil {
+			t.Errorf("BusinessHours = %+v, want nil", locations[0].BusinessHours)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		retrieved.BusinessHours = &model.BusinessHours{Weekly: map[string][]model.OpeningInterval{"funday": nil}}
+		if err := repo.Update(ctx, "shop", retrieved); err == nil {
+			t.Error("Update() expected validation error")
+		}
+	})
+}
+
+func TestWeekendHandling(t *testing.T) {
+	repo := setupTestRepo(t)
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, model.NewLocation("london", "Europe/London", "")); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	loc := model.NewLocation("riyadh", "Asia/Riyadh", "")
+	loc.Weekend = []string{"friday", "saturday"}
+	if err := repo.Create(ctx, loc); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	locations, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if locations[0].Weekend != nil {
+		t.Errorf("expected default weekend to be stored as nil, got %v", locations[0].Weekend)
+	}
+	if got := strings.Join(locations[1].Weekend, ","); got != "friday,saturday" {
+		t.Errorf("Weekend = %s, want friday,saturday", got)
+	}
+
+	// An empty weekend is kept distinct from the default
+	loc.Weekend = []string{}
+	if err := repo.Update(ctx, "riyadh", loc); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	retrieved, err := repo.GetByName(ctx, "riyadh")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	if retrieved.Weekend == nil || len(retrieved.Weekend) != 0 {
+		t.Errorf("Weekend = %#v, want empty", retrieved.Weekend)
+	}
+
+	loc.Weekend = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+	if err := repo.Update(ctx, "riyadh", loc); err == nil {
+		t.Error("Update() expected validation error for a week without business days")
+	}
+}
+
+func TestTagsHandling(t *testing.T) {
+	repo := setupTestRepo(t)
+	ctx := context.Background()
+
+	loc := model.NewLocation("berlin", "Europe/Berlin", "")
+	loc.Tags = []string{"emea", "engineering"}
+	if err := repo.Create(ctx, loc); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	retrieved, err := repo.GetByName(ctx, "berlin")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	if got := strings.Join(retrieved.Tags, ","); got != "emea,engineering" {
+		t.Errorf("Tags = %s, want emea,engineering", got)
+	}
+
+	loc.Tags = []string{}
+	if err := repo.Update(ctx, "berlin", loc); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	locations, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if locations[0].Tags != nil {
+		t.Errorf("expected cleared tags to be nil, got %v", locations[0].Tags)
+	}
+
+	loc.Tags = []string{"emea", "emea"}
+	if err := repo.Update(ctx, "berlin", loc); err == nil {
+		t.Error("Update() expected validation error for duplicate tags")
+	}
+}
+
+func TestCoordinatesHandling(t *testing.T) {
+	repo := setupTestRepo(t)
+	ctx := context.Background()
+
+	loc := model.NewLocation("tromso", "Europe/Oslo", "")
+	loc.Coordinates = &model.Coordinates{Latitude: 69.6492, Longitude: 18.9553}
+	if err := repo.Create(ctx, loc); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	retrieved, err := repo.GetByName(ctx, "tromso")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	if retrieved.Coordinates == nil || *retrieved.Coordinates != *loc.Coordinates {
+		t.Errorf("Coordinates = %+v, want %+v", retrieved.Coordinates, loc.Coordinates)
+	}
+
+	loc.Coordinates = &model.Coordinates{Latitude: -33.8688, Longitude: 151.2093}
+	if err := repo.Update(ctx, "tromso", loc); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	locations, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if c := locations[0].Coordinates; c == nil || c.Latitude != -33.8688 || c.Longitude != 151.2093 {
+		t.Errorf("expected updated coordinates, got %+v", c)
+	}
+
+	// Locations without coordinates read back as nil
+	if err := repo.Create(ctx, model.NewLocation("oslo", "Europe/Oslo", "")); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if oslo, _ := repo.GetByName(ctx, "oslo"); oslo.Coordinates != nil {
+		t.Errorf("expected nil coordinates, got %+v", oslo.Coordinates)
+	}
+
+	loc.Coordinates = &model.Coordinates{Latitude: 91}
+	if err := repo.Update(ctx, "tromso", loc); err == nil {
+		t.Error("Update() expected validation error for latitude out of range")
+	}
+}
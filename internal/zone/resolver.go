@@ -0,0 +1,101 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// Resolver errors
+var (
+	ErrEmptyZone   = errors.New("timezone or location name cannot be empty")
+	ErrUnknownZone = errors.New("unknown timezone or location")
+)
+
+// Zone is a resolved timezone, optionally backed by a saved location
+type Zone struct {
+	// Name is the IANA timezone name
+	Name string
+	// Location is the saved location name, empty when resolved from an IANA name
+	Location string
+	// TZ is the loaded timezone
+	TZ *time.Location
+}
+
+// Resolver resolves IANA timezone names and saved location names to timezones
+type Resolver struct {
+	repo repository.LocationRepository
+}
+
+// NewResolver creates a new resolver backed by the given location repository.
+// A nil repository restricts resolution to IANA timezone names.
+func NewResolver(repo repository.LocationRepository) *Resolver {
+	return &Resolver{repo: repo}
+}
+
+// Resolve resolves a timezone or saved location name.
+// IANA names take precedence; anything else is looked up as a saved location.
+func (r *Resolver) Resolve(ctx context.Context, name string) (*Zone, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyZone
+	}
+
+	// Local is the server's own zone, not an IANA name
+	if name != "Local" {
+		if tz, err := time.LoadLocation(name); err == nil {
+			return &Zone{Name: tz.String(), TZ: tz}, nil
+		}
+	}
+
+	// Only names that could be saved locations are worth a database lookup
+	if r.repo == nil || model.ValidateName(name) != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownZone, name)
+	}
+
+	loc, err := r.repo.GetByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrLocationNotFound) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownZone, name)
+		}
+		return nil, fmt.Errorf("failed to look up location: %w", err)
+	}
+
+	tz, err := time.LoadLocation(loc.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("location %s has invalid timezone %s: %w", loc.Name, loc.Timezone, err)
+	}
+
+	return &Zone{Name: loc.Timezone, Location: loc.Name, TZ: tz}, nil
+}
+
+// ResolveAll resolves each name in order, stopping at the first failure
+func (r *Resolver) ResolveAll(ctx context.Context, names []string) ([]*Zone, error) {
+	zones := make([]*Zone, 0, len(names))
+	for _, name := range names {
+		z, err := r.Resolve(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, z)
+	}
+	return zones, nil
+}
+
+// SavedLocations returns every saved location, or none when the resolver has no repository
+func (r *Resolver) SavedLocations(ctx context.Context) ([]*model.Location, error) {
+	if r.repo == nil {
+		return nil, nil
+	}
+	return r.repo.List(ctx)
+}
+
+// IsClientError reports whether err was caused by bad input rather than a backend failure
+func IsClientError(err error) bool {
+	return errors.Is(err, ErrEmptyZone) || errors.Is(err, ErrUnknownZone)
+}
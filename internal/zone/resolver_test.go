@@ -0,0 +1,166 @@
+package zone
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yourorg/timeservice/internal/repository"
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// stubLocationRepository serves locations from a map for resolver tests
+type stubLocationRepository struct {
+	repository.LocationRepository
+	locations map[string]*model.Location
+	err       error
+	lookups   int
+}
+
+func (s *stubLocationRepository) GetByName(ctx context.Context, name string) (*model.Location, error) {
+	s.lookups++
+	if s.err != nil {
+		return nil, s.err
+	}
+	if loc, ok := s.locations[name]; ok {
+		return loc, nil
+	}
+	return nil, repository.ErrLocationNotFound
+}
+
+func (s *stubLocationRepository) List(ctx context.Context) ([]*model.Location, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	locations := make([]*model.Location, 0, len(s.locations))
+	for _, loc := range s.locations {
+		locations = append(locations, loc)
+	}
+	return locations, nil
+}
+
+func TestResolve(t *testing.T) {
+	repo := &stubLocationRepository{
+		locations: map[string]*model.Location{
+			"hq":  {Name: "hq", Timezone: "America/New_York"},
+			"bad": {Name: "bad", Timezone: "Invalid/Timezone"},
+		},
+	}
+	resolver := NewResolver(repo)
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		wantName     string
+		wantLocation string
+		wantErr      error
+		wantAnyErr   bool
+	}{
+		{name: "IANA timezone", input: "Asia/Tokyo", wantName: "Asia/Tokyo"},
+		{name: "UTC", input: "UTC", wantName: "UTC"},
+		{name: "trims whitespace", input: "  Europe/Berlin ", wantName: "Europe/Berlin"},
+		{name: "saved location", input: "hq", wantName: "America/New_York", wantLocation: "hq"},
+		{name: "empty", input: "  ", wantErr: ErrEmptyZone},
+		{name: "unknown location", input: "nowhere", wantErr: ErrUnknownZone},
+		{name: "server local zone", input: "Local", wantErr: ErrUnknownZone},
+		{name: "invalid name skips lookup", input: "Not/A Zone", wantErr: ErrUnknownZone},
+		{name: "location with invalid timezone", input: "bad", wantAnyErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, err := resolver.Resolve(ctx, tt.input)
+
+			if tt.wantErr != nil || tt.wantAnyErr {
+				if err == nil {
+					t.Fatalf("Resolve(%q) expected error, got %+v", tt.input, z)
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("Resolve(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Resolve(%q) unexpected error: %v", tt.input, err)
+			}
+			if z.Name != tt.wantName {
+				t.Errorf("Name = %q, want %q", z.Name, tt.wantName)
+			}
+			if z.Location != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", z.Location, tt.wantLocation)
+			}
+			if z.TZ == nil || z.TZ.String() != tt.wantName {
+				t.Errorf("TZ = %v, want %s", z.TZ, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestResolveRepositoryError(t *testing.T) {
+	repo := &stubLocationRepository{err: errors.New("database error")}
+	resolver := NewResolver(repo)
+
+	_, err := resolver.Resolve(context.Background(), "hq")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if IsClientError(err) {
+		t.Errorf("repository failure should not be a client error: %v", err)
+	}
+}
+
+func TestResolveNilRepository(t *testing.T) {
+	resolver := NewResolver(nil)
+
+	if _, err := resolver.Resolve(context.Background(), "Europe/London"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err := resolver.Resolve(context.Background(), "hq")
+	if !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("expected ErrUnknownZone, got %v", err)
+	}
+}
+
+func TestResolveAll(t *testing.T) {
+	repo := &stubLocationRepository{
+		locations: map[string]*model.Location{
+			"tokyo": {Name: "tokyo", Timezone: "Asia/Tokyo"},
+		},
+	}
+	resolver := NewResolver(repo)
+
+	zones, err := resolver.ResolveAll(context.Background(), []string{"UTC", "tokyo"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(zones) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(zones))
+	}
+	if zones[1].Location != "tokyo" || zones[1].Name != "Asia/Tokyo" {
+		t.Errorf("unexpected second zone: %+v", zones[1])
+	}
+
+	if _, err := resolver.ResolveAll(context.Background(), []string{"UTC", "missing"}); !IsClientError(err) {
+		t.Errorf("expected client error, got %v", err)
+	}
+}
+
+func TestSavedLocations(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &stubLocationRepository{
+		locations: map[string]*model.Location{"hq": {Name: "hq", Timezone: "America/New_York"}},
+	}
+	locations, err := NewResolver(repo).SavedLocations(ctx)
+	if err != nil || len(locations) != 1 {
+		t.Errorf("SavedLocations() = %v, %v, want one location", locations, err)
+	}
+
+	locations, err = NewResolver(nil).SavedLocations(ctx)
+	if err != nil || locations != nil {
+		t.Errorf("SavedLocations() without repository = %v, %v, want none", locations, err)
+	}
+}
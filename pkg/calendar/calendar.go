@@ -0,0 +1,142 @@
+// Package calendar converts dates between the Gregorian calendar and other
+// calendar systems: ISO week dates, the tabular Islamic (Hijri) calendar, the
+// Hebrew calendar, the Persian (Solar Hijri) calendar, Japanese eras and the
+// Chinese lunisolar calendar.
+//
+// Conversions go through a day number counting days since 1970-01-01, so any
+// two calendars can be converted by way of Days and the FromDays and ToDays
+// methods.
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Calendar system names
+const (
+	Gregorian = "gregorian"
+	ISOWeek   = "iso-week"
+	Islamic   = "islamic"
+	Hebrew    = "hebrew"
+	Persian   = "persian"
+	Japanese  = "japanese"
+	Chinese   = "chinese"
+)
+
+// Conversion errors
+var (
+	ErrUnknownCalendar = errors.New("unknown calendar")
+	ErrInvalidDate     = errors.New("invalid date")
+	ErrOutOfRange      = errors.New("date is outside the supported range")
+)
+
+// Date is a date in a calendar system. For ISO week dates Month holds the
+// week and Day the weekday, 1 for Monday to 7 for Sunday.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+	// LeapMonth marks a Chinese leap month, which repeats the number of the
+	// month before it
+	LeapMonth bool
+	// Era is the romanized Japanese era, e.g. "Reiwa"; Year counts from its start
+	Era string
+}
+
+// Calendar converts dates of one calendar system to and from day numbers
+type Calendar interface {
+	// Name returns the calendar system name
+	Name() string
+	// FromDays returns the date of a day number
+	FromDays(days int) (Date, error)
+	// ToDays returns the day number of a date, validating it
+	ToDays(d Date) (int, error)
+	// MonthName returns the name of the date's month, empty when the
+	// calendar has none
+	MonthName(d Date) string
+	// YearName returns a name for the date's year, such as a Japanese era
+	// year or a Chinese sexagenary year, empty when the calendar has none
+	YearName(d Date) string
+	// Format returns the date written out in the calendar's usual style
+	Format(d Date) string
+}
+
+// calendars holds every supported calendar in the order Names lists them
+var calendars = []Calendar{
+	gregorian{},
+	isoWeek{},
+	islamic{},
+	hebrew{},
+	persian{},
+	japanese{},
+	chinese{},
+}
+
+// Names returns the supported calendar system names
+func Names() []string {
+	names := make([]string, len(calendars))
+	for i, c := range calendars {
+		names[i] = c.Name()
+	}
+	return names
+}
+
+// Lookup returns the calendar system called name, ignoring case
+func Lookup(name string) (Calendar, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, c := range calendars {
+		if c.Name() == name {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q (expected one of %s)", ErrUnknownCalendar, name, strings.Join(Names(), ", "))
+}
+
+// Days returns the day number of a Gregorian date, counting days since
+// 1970-01-01. Out-of-range months and days are normalized as by time.Date.
+func Days(year int, month time.Month, day int) int {
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// DaysOf returns the day number of the calendar date of t in its location
+func DaysOf(t time.Time) int {
+	year, month, day := t.Date()
+	return Days(year, month, day)
+}
+
+// Weekday returns the day of the week of a day number
+func Weekday(days int) time.Weekday {
+	return time.Weekday(mod(days+4, 7))
+}
+
+// civil returns the Gregorian date of a day number
+func civil(days int) (int, time.Month, int) {
+	return time.Unix(int64(days)*86400, 0).UTC().Date()
+}
+
+// invalid returns an ErrInvalidDate error describing a date of calendar c
+func invalid(c Calendar, format string, args ...any) error {
+	return fmt.Errorf("%w: %s %s", ErrInvalidDate, c.Name(), fmt.Sprintf(format, args...))
+}
+
+// outOfRange returns an ErrOutOfRange error naming the supported range of c
+func outOfRange(c Calendar, supported string) error {
+	return fmt.Errorf("%w: %s dates are supported %s", ErrOutOfRange, c.Name(), supported)
+}
+
+// floorDiv divides rounding towards negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// mod returns a modulo b with the sign of b
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
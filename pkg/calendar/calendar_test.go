@@ -0,0 +1,191 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustLookup(t *testing.T, name string) Calendar {
+	t.Helper()
+	c, err := Lookup(name)
+	if err != nil {
+		t.Fatalf("Lookup(%q) error: %v", name, err)
+	}
+	return c
+}
+
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		name      string
+		calendar  string
+		gregorian time.Time
+		want      Date
+		formatted string
+	}{
+		{"iso week", ISOWeek, time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC), Date{Year: 2025, Month: 42, Day: 4}, "2025-W42-4"},
+		{"iso week in previous year", ISOWeek, time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), Date{Year: 2020, Month: 53, Day: 7}, "2020-W53-7"},
+		{"islamic", Islamic, time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC), Date{Year: 1447, Month: 4, Day: 23}, "23 Rabi al-Thani 1447 AH"},
+		{"islamic leap day", Islamic, time.Date(2024, 7, 7, 0, 0, 0, 0, time.UTC), Date{Year: 1445, Month: 12, Day: 30}, "30 Dhu al-Hijjah 1445 AH"},
+		{"hebrew new year", Hebrew, time.Date(2023, 9, 16, 0, 0, 0, 0, time.UTC), Date{Year: 5784, Month: 1, Day: 1}, "1 Tishrei 5784"},
+		{"hebrew adar ii", Hebrew, time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), Date{Year: 5784, Month: 7, Day: 14}, "14 Adar II 5784"},
+		{"hebrew common year", Hebrew, time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC), Date{Year: 5786, Month: 1, Day: 24}, "24 Tishrei 5786"},
+		{"persian nowruz", Persian, time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), Date{Year: 1403, Month: 1, Day: 1}, "1 Farvardin 1403 SH"},
+		{"persian", Persian, time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC), Date{Year: 1404, Month: 7, Day: 24}, "24 Mehr 1404 SH"},
+		{"japanese reiwa", Japanese, time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC), Date{Year: 7, Month: 10, Day: 16, Era: "Reiwa"}, "令和7年10月16日"},
+		{"japanese first year", Japanese, time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), Date{Year: 1, Month: 5, Day: 1, Era: "Reiwa"}, "令和元年5月1日"},
+		{"japanese era end", Japanese, time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), Date{Year: 31, Month: 4, Day: 30, Era: "Heisei"}, "平成31年4月30日"},
+		{"chinese new year", Chinese, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), Date{Year: 2024, Month: 1, Day: 1}, "甲辰年正月初一"},
+		{"chinese", Chinese, time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC), Date{Year: 2025, Month: 8, Day: 25}, "乙巳年八月廿五"},
+		{"chinese leap month", Chinese, time.Date(2025, 7, 25, 0, 0, 0, 0, time.UTC), Date{Year: 2025, Month: 6, Day: 1, LeapMonth: true}, "乙巳年闰六月初一"},
+		{"chinese before new year", Chinese, time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC), Date{Year: 2023, Month: 12, Day: 30}, "癸卯年腊月三十"},
+		// The new moon is at 00:02 in Beijing, so the month starts the next day
+		{"chinese new moon after midnight", Chinese, time.Date(2018, 11, 8, 0, 0, 0, 0, time.UTC), Date{Year: 2018, Month: 10, Day: 1}, "戊戌年十月初一"},
+		// 1987 has a leap sixth month
+		{"chinese 1987", Chinese, time.Date(1987, 7, 26, 0, 0, 0, 0, time.UTC), Date{Year: 1987, Month: 6, Day: 1, LeapMonth: true}, "丁卯年闰六月初一"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := mustLookup(t, tt.calendar)
+			days := DaysOf(tt.gregorian)
+
+			got, err := c.FromDays(days)
+			if err != nil {
+				t.Fatalf("FromDays error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FromDays = %+v, want %+v", got, tt.want)
+			}
+			if f := c.Format(got); f != tt.formatted {
+				t.Errorf("Format = %q, want %q", f, tt.formatted)
+			}
+
+			back, err := c.ToDays(tt.want)
+			if err != nil {
+				t.Fatalf("ToDays error: %v", err)
+			}
+			if back != days {
+				t.Errorf("ToDays = %d, want %d", back, days)
+			}
+		})
+	}
+}
+
+func TestChineseNewYears(t *testing.T) {
+	want := map[int]time.Time{
+		1954: time.Date(1954, 2, 3, 0, 0, 0, 0, time.UTC),
+		2020: time.Date(2020, 1, 25, 0, 0, 0, 0, time.UTC),
+		2021: time.Date(2021, 2, 12, 0, 0, 0, 0, time.UTC),
+		2022: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		2023: time.Date(2023, 1, 22, 0, 0, 0, 0, time.UTC),
+		2025: time.Date(2025, 1, 29, 0, 0, 0, 0, time.UTC),
+		2026: time.Date(2026, 2, 17, 0, 0, 0, 0, time.UTC),
+		2030: time.Date(2030, 2, 3, 0, 0, 0, 0, time.UTC),
+	}
+	for year, date := range want {
+		days, err := chinese{}.ToDays(Date{Year: year, Month: 1, Day: 1})
+		if err != nil {
+			t.Fatalf("ToDays(%d) error: %v", year, err)
+		}
+		if days != DaysOf(date) {
+			t.Errorf("Chinese New Year %d on day %d, want %s", year, days, date.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	// Every day for two years in every calendar, covering leap months and
+	// the ends of years
+	start := Days(2023, time.January, 1)
+	for _, c := range calendars {
+		prev := Date{}
+		for days := start; days < start+731; days++ {
+			d, err := c.FromDays(days)
+			if err != nil {
+				t.Fatalf("%s FromDays(%d) error: %v", c.Name(), days, err)
+			}
+			if d == prev {
+				t.Fatalf("%s FromDays(%d) repeats %+v", c.Name(), days, d)
+			}
+			back, err := c.ToDays(d)
+			if err != nil || back != days {
+				t.Fatalf("%s round trip of day %d via %+v gave %d, %v", c.Name(), days, d, back, err)
+			}
+			prev = d
+		}
+	}
+}
+
+func TestNamesAndLookup(t *testing.T) {
+	names := Names()
+	if len(names) != 7 || names[0] != Gregorian || names[6] != Chinese {
+		t.Errorf("Names() = %v", names)
+	}
+	if c := mustLookup(t, " Hebrew "); c.Name() != Hebrew {
+		t.Errorf("Lookup returned %s", c.Name())
+	}
+	if _, err := Lookup("mayan"); !errors.Is(err, ErrUnknownCalendar) {
+		t.Errorf("expected ErrUnknownCalendar, got %v", err)
+	}
+}
+
+func TestNames(t *testing.T) {
+	tests := []struct {
+		calendar  string
+		date      Date
+		monthName string
+		yearName  string
+	}{
+		{Gregorian, Date{Year: 2025, Month: 10, Day: 16}, "October", ""},
+		{Hebrew, Date{Year: 5784, Month: 6, Day: 1}, "Adar I", ""},
+		{Hebrew, Date{Year: 5785, Month: 6, Day: 1}, "Adar", ""},
+		{Islamic, Date{Year: 1446, Month: 9, Day: 1}, "Ramadan", ""},
+		{Japanese, Date{Year: 7, Month: 10, Day: 16, Era: "Reiwa"}, "October", "Reiwa 7"},
+		{Chinese, Date{Year: 2025, Month: 6, Day: 1, LeapMonth: true}, "闰六月", "Yi-Si (Snake)"},
+		{Chinese, Date{Year: 2024, Month: 11, Day: 1}, "冬月", "Jia-Chen (Dragon)"},
+	}
+	for _, tt := range tests {
+		c := mustLookup(t, tt.calendar)
+		if got := c.MonthName(tt.date); got != tt.monthName {
+			t.Errorf("%s MonthName(%+v) = %q, want %q", tt.calendar, tt.date, got, tt.monthName)
+		}
+		if got := c.YearName(tt.date); got != tt.yearName {
+			t.Errorf("%s YearName(%+v) = %q, want %q", tt.calendar, tt.date, got, tt.yearName)
+		}
+	}
+}
+
+func TestInvalidDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar string
+		date     Date
+		want     error
+	}{
+		{"february 30", Gregorian, Date{Year: 2025, Month: 2, Day: 30}, ErrInvalidDate},
+		{"week 53 of a 52 week year", ISOWeek, Date{Year: 2025, Month: 53, Day: 1}, ErrInvalidDate},
+		{"weekday 8", ISOWeek, Date{Year: 2025, Month: 1, Day: 8}, ErrInvalidDate},
+		{"islamic month 13", Islamic, Date{Year: 1447, Month: 13, Day: 1}, ErrInvalidDate},
+		{"hebrew month 13 in a common year", Hebrew, Date{Year: 5785, Month: 13, Day: 1}, ErrInvalidDate},
+		{"esfand 30 in a common year", Persian, Date{Year: 1404, Month: 12, Day: 30}, ErrInvalidDate},
+		{"unknown era", Japanese, Date{Year: 1, Month: 1, Day: 1, Era: "Edo"}, ErrInvalidDate},
+		{"outside the era", Japanese, Date{Year: 31, Month: 5, Day: 1, Era: "Heisei"}, ErrInvalidDate},
+		{"before the gregorian calendar", Japanese, Date{Year: 5, Month: 1, Day: 1, Era: "Meiji"}, ErrOutOfRange},
+		{"missing leap month", Chinese, Date{Year: 2024, Month: 6, Day: 1, LeapMonth: true}, ErrInvalidDate},
+		{"day 30 of a short month", Chinese, Date{Year: 2025, Month: 2, Day: 30}, ErrInvalidDate},
+		{"chinese year 2101", Chinese, Date{Year: 2101, Month: 1, Day: 1}, ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := mustLookup(t, tt.calendar).ToDays(tt.date)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ToDays(%+v) error = %v, want %v", tt.date, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := (chinese{}).FromDays(Days(1850, time.January, 1)); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("expected ErrOutOfRange for 1850, got %v", err)
+	}
+}
@@ -0,0 +1,348 @@
+package calendar
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/solar"
+)
+
+// synodicMonth is the mean time from new moon to new moon, in days
+const synodicMonth = 29.530588861
+
+// chineseRange describes the supported Chinese years, which are named by
+// the Gregorian year they start in
+const chineseRange = "for years starting in 1900 to 2100"
+
+// tropicalYear is the mean time between March equinoxes, in days
+const tropicalYear = 365.242189
+
+var (
+	chineseStems    = [...]string{"Jia", "Yi", "Bing", "Ding", "Wu", "Ji", "Geng", "Xin", "Ren", "Gui"}
+	chineseBranches = [...]string{"Zi", "Chou", "Yin", "Mao", "Chen", "Si", "Wu", "Wei", "Shen", "You", "Xu", "Hai"}
+	chineseAnimals  = [...]string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"}
+	stemsHanzi      = [...]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	branchesHanzi   = [...]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+	chineseMonths   = [...]string{"正月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "冬月", "腊月"}
+	chineseDigits   = [...]string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九", "十"}
+)
+
+// chinese is the Chinese lunisolar calendar, computed from the times of new
+// moons and solar terms in Beijing. Months start on the day of a new moon,
+// the eleventh month contains the winter solstice, and in years with 13
+// months the first month without a major solar term is a leap month.
+// Date years are the Gregorian year in which the Chinese year starts.
+// A new moon within a minute or so of midnight, as in September 2057, is too
+// close to call and may put the start of a month a day off published tables.
+type chinese struct{}
+
+func (chinese) Name() string { return Chinese }
+
+func (c chinese) FromDays(days int) (Date, error) {
+	if year, _, _ := civil(days); year < 1900 || year > 2101 {
+		return Date{}, outOfRange(c, chineseRange)
+	}
+	d := chineseFromDays(days)
+	if d.Year < 1900 || d.Year > 2100 {
+		return Date{}, outOfRange(c, chineseRange)
+	}
+	return d, nil
+}
+
+func (c chinese) ToDays(d Date) (int, error) {
+	if d.Year < 1900 || d.Year > 2100 {
+		return 0, outOfRange(c, chineseRange)
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return 0, invalid(c, "month %d, must be 1 to 12", d.Month)
+	}
+
+	newYear := chineseNewYearOnOrBefore(Days(d.Year, time.July, 1))
+	start := newMoonOnOrAfter(newYear + (d.Month-1)*29)
+	if got := chineseFromDays(start); got.Month != d.Month || got.LeapMonth != d.LeapMonth {
+		start = newMoonOnOrAfter(start + 1)
+	}
+	if got := chineseFromDays(start); got.Month != d.Month || got.LeapMonth != d.LeapMonth {
+		return 0, invalid(c, "leap month %d, %d has no such month", d.Month, d.Year)
+	}
+	if n := newMoonOnOrAfter(start+1) - start; d.Day < 1 || d.Day > n {
+		return 0, invalid(c, "day %d, month %d of %d has %d days", d.Day, d.Month, d.Year, n)
+	}
+	return start + d.Day - 1, nil
+}
+
+// MonthName returns the Chinese name of the month, with 闰 for a leap month
+func (chinese) MonthName(d Date) string {
+	name := chineseMonths[d.Month-1]
+	if d.LeapMonth {
+		name = "闰" + name
+	}
+	return name
+}
+
+// YearName returns the sexagenary name of the year and its animal, e.g. "Yi-Si (Snake)"
+func (chinese) YearName(d Date) string {
+	stem, branch := mod(d.Year-4, 10), mod(d.Year-4, 12)
+	return fmt.Sprintf("%s-%s (%s)", chineseStems[stem], chineseBranches[branch], chineseAnimals[branch])
+}
+
+// Format writes the date in Chinese, e.g. 乙巳年八月廿五
+func (c chinese) Format(d Date) string {
+	stem, branch := mod(d.Year-4, 10), mod(d.Year-4, 12)
+	return stemsHanzi[stem] + branchesHanzi[branch] + "年" + c.MonthName(d) + chineseDay(d.Day)
+}
+
+// chineseDay returns the traditional name of a day of the month, 初一 to 三十
+func chineseDay(day int) string {
+	switch {
+	case day <= 10:
+		return "初" + chineseDigits[day]
+	case day < 20:
+		return "十" + chineseDigits[day-10]
+	case day == 20:
+		return "二十"
+	case day < 30:
+		return "廿" + chineseDigits[day-20]
+	}
+	return "三十"
+}
+
+// chineseFromDays returns the Chinese date of a day number
+func chineseFromDays(days int) Date {
+	s1 := winterSolsticeOnOrBefore(days)
+	s2 := winterSolsticeOnOrBefore(s1 + 370)
+	m12 := newMoonOnOrAfter(s1 + 1)
+	nextM11 := newMoonBefore(s2 + 1)
+	m := newMoonBefore(days + 1)
+	leapYear := lunations(m12, nextM11) == 12
+
+	month := lunations(m12, m)
+	if leapYear && priorLeapMonth(m12, m) {
+		month--
+	}
+	month = mod(month-1, 12) + 1
+
+	newYear := chineseNewYearOnOrBefore(days)
+	year, _, _ := civil(newYear)
+	return Date{
+		Year:      year,
+		Month:     month,
+		Day:       days - m + 1,
+		LeapMonth: leapYear && noMajorSolarTerm(m) && !priorLeapMonth(m12, newMoonBefore(m)),
+	}
+}
+
+// chineseNewYearOnOrBefore returns the day number of the Chinese New Year
+// on or before a day
+func chineseNewYearOnOrBefore(days int) int {
+	if newYear := chineseNewYearInSui(days); days >= newYear {
+		return newYear
+	}
+	return chineseNewYearInSui(days - 180)
+}
+
+// chineseNewYearInSui returns the day number of the Chinese New Year in the
+// solar year (sui) from the winter solstice on or before a day to the next
+func chineseNewYearInSui(days int) int {
+	s1 := winterSolsticeOnOrBefore(days)
+	s2 := winterSolsticeOnOrBefore(s1 + 370)
+	m12 := newMoonOnOrAfter(s1 + 1)
+	m13 := newMoonOnOrAfter(m12 + 1)
+	nextM11 := newMoonBefore(s2 + 1)
+	// A leap month between the solstice and the new year delays it a month
+	if lunations(m12, nextM11) == 12 && (noMajorSolarTerm(m12) || noMajorSolarTerm(m13)) {
+		return newMoonOnOrAfter(m13 + 1)
+	}
+	return m13
+}
+
+// priorLeapMonth reports whether there is a month without a major solar
+// term from the month starting on day start to the month starting on day m
+func priorLeapMonth(start, m int) bool {
+	for ; m >= start; m = newMoonBefore(m) {
+		if noMajorSolarTerm(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// noMajorSolarTerm reports whether the month starting on day m passes no
+// major solar term, a multiple of 30° of solar longitude
+func noMajorSolarTerm(m int) bool {
+	return majorSolarTerm(m) == majorSolarTerm(newMoonOnOrAfter(m+1))
+}
+
+// majorSolarTerm returns the index of the last major solar term before a day starts
+func majorSolarTerm(days int) int {
+	return int(math.Floor(solar.Longitude(chinaMidnight(days)) / 30))
+}
+
+// lunations returns the whole number of months between two new moon days
+func lunations(from, to int) int {
+	return int(math.Round(float64(to-from) / synodicMonth))
+}
+
+// winterSolsticeOnOrBefore returns the day of the last winter solstice in
+// Beijing on or before a day
+func winterSolsticeOnOrBefore(days int) int {
+	t := chinaMidnight(days + 1)
+	const rate = tropicalYear / 360
+	estimate := addDays(t, -rate*math.Mod(solar.Longitude(t)-270+360, 360))
+	// Correct for the sun moving faster in the northern winter
+	estimate = addDays(estimate, -rate*(math.Mod(solar.Longitude(estimate)-270+540, 360)-180))
+
+	day := chinaDay(estimate) - 1
+	for {
+		if l := solar.Longitude(chinaMidnight(day + 1)); l > 270 && l < 300 {
+			return day
+		}
+		day++
+	}
+}
+
+// newMoonOnOrAfter returns the day in Beijing of the first new moon on or after a day
+func newMoonOnOrAfter(days int) int {
+	t := chinaMidnight(days)
+	k := lunation(t) - 1
+	for newMoon(k).Before(t) {
+		k++
+	}
+	return chinaDay(newMoon(k))
+}
+
+// newMoonBefore returns the day in Beijing of the last new moon before a day
+func newMoonBefore(days int) int {
+	t := chinaMidnight(days)
+	k := lunation(t) + 1
+	for !newMoon(k).Before(t) {
+		k--
+	}
+	return chinaDay(newMoon(k))
+}
+
+// chinaOffset returns the UTC offset in seconds of Beijing on a day: the
+// mean solar time of Beijing until 1929 and UTC+8 since
+func chinaOffset(days int) int {
+	if year, _, _ := civil(days); year < 1929 {
+		return 27940
+	}
+	return 8 * 3600
+}
+
+// chinaMidnight returns the instant a day starts in Beijing
+func chinaMidnight(days int) time.Time {
+	return time.Unix(int64(days*86400-chinaOffset(days)), 0).UTC()
+}
+
+// chinaDay returns the day number of an instant in Beijing
+func chinaDay(t time.Time) int {
+	days := floorDiv(int(t.Unix())+8*3600, 86400)
+	return floorDiv(int(t.Unix())+chinaOffset(days), 86400)
+}
+
+// addDays moves t by fractional days
+func addDays(t time.Time, days float64) time.Time {
+	return t.Add(time.Duration(days * 24 * float64(time.Hour)))
+}
+
+// lunation returns the number of the lunation in progress at t, counting
+// from the new moon of 6 January 2000
+func lunation(t time.Time) int {
+	return int(math.Floor((julianDay(t) - 2451550.09766) / synodicMonth))
+}
+
+// julianDay returns the Julian day of t
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400 + 2440587.5
+}
+
+// newMoon returns the instant of new moon k, counting from 6 January 2000,
+// using the periodic terms of Meeus, Astronomical Algorithms chapter 49
+func newMoon(k int) time.Time {
+	kf := float64(k)
+	c := kf / 1236.85
+	jde := 2451550.09766 + synodicMonth*kf + c*c*(0.00015437+c*(-0.000000150+c*0.00000000073))
+
+	e := 1 - c*(0.002516+c*0.0000074)
+	m := radians(2.5534 + 29.10535670*kf - c*c*(0.0000014+c*0.00000011))
+	mp := radians(201.5643 + 385.81693528*kf + c*c*(0.0107582+c*(0.00001238-c*0.000000058)))
+	f := radians(160.7108 + 390.67050284*kf - c*c*(0.0016118+c*(0.00000227-c*0.000000011)))
+	omega := radians(124.7746 - 1.56375588*kf + c*c*(0.0020672+c*0.00000215))
+
+	jde += -0.40720*math.Sin(mp) +
+		0.17241*e*math.Sin(m) +
+		0.01608*math.Sin(2*mp) +
+		0.01039*math.Sin(2*f) +
+		0.00739*e*math.Sin(mp-m) -
+		0.00514*e*math.Sin(mp+m) +
+		0.00208*e*e*math.Sin(2*m) -
+		0.00111*math.Sin(mp-2*f) -
+		0.00057*math.Sin(mp+2*f) +
+		0.00056*e*math.Sin(2*mp+m) -
+		0.00042*math.Sin(3*mp) +
+		0.00042*e*math.Sin(m+2*f) +
+		0.00038*e*math.Sin(m-2*f) -
+		0.00024*e*math.Sin(2*mp-m) -
+		0.00017*math.Sin(omega) -
+		0.00007*math.Sin(mp+2*m) +
+		0.00004*math.Sin(2*mp-2*f) +
+		0.00004*math.Sin(3*m) +
+		0.00003*math.Sin(mp+m-2*f) +
+		0.00003*math.Sin(2*mp+2*f) -
+		0.00003*math.Sin(mp+m+2*f) +
+		0.00003*math.Sin(mp-m+2*f) -
+		0.00002*math.Sin(mp-m-2*f) -
+		0.00002*math.Sin(3*mp+m) +
+		0.00002*math.Sin(4*mp)
+
+	// Planetary arguments
+	planetary := [...][3]float64{
+		{0.000325, 299.77, 0.107408}, {0.000165, 251.88, 0.016321}, {0.000164, 251.83, 26.651886},
+		{0.000126, 349.42, 36.412478}, {0.000110, 84.66, 18.206239}, {0.000062, 141.74, 53.303771},
+		{0.000060, 207.14, 2.453732}, {0.000056, 154.84, 7.306860}, {0.000047, 34.52, 27.261239},
+		{0.000042, 207.19, 0.121824}, {0.000040, 291.34, 1.844379}, {0.000037, 161.72, 24.198154},
+		{0.000035, 239.56, 25.513099}, {0.000023, 331.55, 3.592518},
+	}
+	for i, p := range planetary {
+		argument := p[1] + p[2]*kf
+		if i == 0 {
+			argument -= 0.009173 * c * c
+		}
+		jde += p[0] * math.Sin(radians(argument))
+	}
+
+	// Convert from terrestrial to universal time
+	jd := jde - deltaT(2000+kf/12.3685)/86400
+	return time.Unix(int64(math.Round((jd-2440587.5)*86400)), 0).UTC()
+}
+
+// deltaT returns the difference between terrestrial and universal time in
+// seconds, using the polynomials of Espenak and Meeus
+func deltaT(year float64) float64 {
+	switch {
+	case year < 1920:
+		t := year - 1900
+		return -2.79 + t*(1.494119+t*(-0.0598939+t*(0.0061966-t*0.000197)))
+	case year < 1941:
+		t := year - 1920
+		return 21.20 + t*(0.84493+t*(-0.076100+t*0.0020936))
+	case year < 1961:
+		t := year - 1950
+		return 29.07 + t*(0.407+t*(-1.0/233+t/2547))
+	case year < 1986:
+		t := year - 1975
+		return 45.45 + t*(1.067+t*(-1.0/260-t/718))
+	case year < 2005:
+		t := year - 2000
+		return 63.86 + t*(0.3345+t*(-0.060374+t*(0.0017275+t*(0.000651814+t*0.00002373599))))
+	case year < 2050:
+		t := year - 2000
+		return 62.92 + t*(0.32217+t*0.005589)
+	}
+	u := (year - 1820) / 100
+	return -20 + 32*u*u - 0.5628*(2150-year)
+}
+
+func radians(d float64) float64 { return d * math.Pi / 180 }
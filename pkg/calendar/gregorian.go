@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// gregorian is the proleptic Gregorian calendar for years 1 to 9999
+type gregorian struct{}
+
+func (gregorian) Name() string { return Gregorian }
+
+func (c gregorian) FromDays(days int) (Date, error) {
+	year, month, day := civil(days)
+	if year < 1 || year > 9999 {
+		return Date{}, outOfRange(c, "for years 1 to 9999")
+	}
+	return Date{Year: year, Month: int(month), Day: day}, nil
+}
+
+func (c gregorian) ToDays(d Date) (int, error) {
+	if d.Year < 1 || d.Year > 9999 {
+		return 0, outOfRange(c, "for years 1 to 9999")
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return 0, invalid(c, "month %d, must be 1 to 12", d.Month)
+	}
+	if n := daysInGregorianMonth(d.Year, time.Month(d.Month)); d.Day < 1 || d.Day > n {
+		return 0, invalid(c, "day %d, %s %d has %d days", d.Day, time.Month(d.Month), d.Year, n)
+	}
+	return Days(d.Year, time.Month(d.Month), d.Day), nil
+}
+
+func (gregorian) MonthName(d Date) string {
+	return time.Month(d.Month).String()
+}
+
+func (gregorian) YearName(Date) string { return "" }
+
+func (c gregorian) Format(d Date) string {
+	return fmt.Sprintf("%d %s %d", d.Day, c.MonthName(d), d.Year)
+}
+
+// daysInGregorianMonth returns the length of a Gregorian month
+func daysInGregorianMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// isoWeek is the ISO 8601 week date calendar, whose years start on the
+// Monday of the week containing 4 January
+type isoWeek struct{}
+
+func (isoWeek) Name() string { return ISOWeek }
+
+func (c isoWeek) FromDays(days int) (Date, error) {
+	year, month, day := civil(days)
+	if year < 1 || year > 9999 {
+		return Date{}, outOfRange(c, "for years 1 to 9999")
+	}
+	isoYear, week := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return Date{Year: isoYear, Month: week, Day: isoWeekday(days)}, nil
+}
+
+func (c isoWeek) ToDays(d Date) (int, error) {
+	if d.Year < 1 || d.Year > 9999 {
+		return 0, outOfRange(c, "for years 1 to 9999")
+	}
+	if n := isoWeeksInYear(d.Year); d.Month < 1 || d.Month > n {
+		return 0, invalid(c, "week %d, %d has %d weeks", d.Month, d.Year, n)
+	}
+	if d.Day < 1 || d.Day > 7 {
+		return 0, invalid(c, "weekday %d, must be 1 (Monday) to 7 (Sunday)", d.Day)
+	}
+	return isoYearStart(d.Year) + (d.Month-1)*7 + d.Day - 1, nil
+}
+
+func (isoWeek) MonthName(Date) string { return "" }
+
+func (isoWeek) YearName(Date) string { return "" }
+
+func (isoWeek) Format(d Date) string {
+	return fmt.Sprintf("%04d-W%02d-%d", d.Year, d.Month, d.Day)
+}
+
+// isoWeekday returns the ISO weekday of a day number, 1 for Monday to 7 for Sunday
+func isoWeekday(days int) int {
+	if wd := Weekday(days); wd != time.Sunday {
+		return int(wd)
+	}
+	return 7
+}
+
+// isoYearStart returns the day number of the Monday starting an ISO year
+func isoYearStart(year int) int {
+	jan4 := Days(year, time.January, 4)
+	return jan4 - isoWeekday(jan4) + 1
+}
+
+// isoWeeksInYear returns 52 or 53, the number of weeks in an ISO year
+func isoWeeksInYear(year int) int {
+	return (isoYearStart(year+1) - isoYearStart(year)) / 7
+}
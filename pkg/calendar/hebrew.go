@@ -0,0 +1,168 @@
+package calendar
+
+import "fmt"
+
+// hebrewEpoch is 1 Tishrei AM 1 (7 October 3761 BCE Julian) in Rata Die days
+const hebrewEpoch = -1373427
+
+// Hebrew months in the order of the biblical year, which starts at Nisan.
+// Adar II is the thirteenth month and only exists in leap years.
+const (
+	nisan    = 1
+	tishrei  = 7
+	cheshvan = 8
+	kislev   = 9
+	adar     = 12
+	adarII   = 13
+)
+
+var hebrewMonths = [...]string{
+	"Nisan", "Iyar", "Sivan", "Tammuz", "Av", "Elul",
+	"Tishrei", "Cheshvan", "Kislev", "Tevet", "Shevat", "Adar", "Adar II",
+}
+
+// hebrew is the arithmetic Hebrew calendar. Date months are numbered from
+// Tishrei, where the year starts, so Adar is month 6, Adar II is month 7 in
+// leap years, and Elul is month 12 or 13.
+type hebrew struct{}
+
+func (hebrew) Name() string { return Hebrew }
+
+func (c hebrew) FromDays(days int) (Date, error) {
+	rd := days + rdUnixEpoch
+	if rd < hebrewEpoch {
+		return Date{}, outOfRange(c, "from 1 Tishrei 1 AM (3761 BCE)")
+	}
+	// The estimate is at most a year early
+	year := floorDiv((rd-hebrewEpoch)*98496, 35975351)
+	for hebrewNewYear(year+1) <= rd {
+		year++
+	}
+	month := tishrei
+	if rd >= hebrewToRD(year, nisan, 1) {
+		month = nisan
+	}
+	for rd > hebrewToRD(year, month, hebrewMonthLength(year, month)) {
+		month++
+	}
+	day := rd - hebrewToRD(year, month, 1) + 1
+	return Date{Year: year, Month: hebrewCivilMonth(year, month), Day: day}, nil
+}
+
+func (c hebrew) ToDays(d Date) (int, error) {
+	if d.Year < 1 || d.Year > 9999 {
+		return 0, outOfRange(c, "for years 1 to 9999 AM")
+	}
+	if n := hebrewMonthsInYear(d.Year); d.Month < 1 || d.Month > n {
+		return 0, invalid(c, "month %d, %d has %d months", d.Month, d.Year, n)
+	}
+	month := hebrewBiblicalMonth(d.Year, d.Month)
+	if n := hebrewMonthLength(d.Year, month); d.Day < 1 || d.Day > n {
+		return 0, invalid(c, "day %d, %s %d has %d days", d.Day, c.MonthName(d), d.Year, n)
+	}
+	return hebrewToRD(d.Year, month, d.Day) - rdUnixEpoch, nil
+}
+
+func (hebrew) MonthName(d Date) string {
+	month := hebrewBiblicalMonth(d.Year, d.Month)
+	if month == adar && hebrewLeapYear(d.Year) {
+		return "Adar I"
+	}
+	return hebrewMonths[month-1]
+}
+
+func (hebrew) YearName(Date) string { return "" }
+
+func (c hebrew) Format(d Date) string {
+	return fmt.Sprintf("%d %s %d", d.Day, c.MonthName(d), d.Year)
+}
+
+// hebrewLeapYear reports whether a Hebrew year has 13 months
+func hebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// hebrewMonthsInYear returns 12 or 13
+func hebrewMonthsInYear(year int) int {
+	if hebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad of Tishrei
+// of year, postponed when it falls on a Sunday, Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewNewYear returns the Rata Die day of 1 Tishrei of year, applying the
+// postponements that keep years to 353-355 or 383-385 days
+func hebrewNewYear(year int) int {
+	ny0, ny1, ny2 := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+	correction := 0
+	switch {
+	case ny2-ny1 == 356:
+		correction = 2
+	case ny1-ny0 == 382:
+		correction = 1
+	}
+	return hebrewEpoch + ny1 + correction
+}
+
+// hebrewMonthLength returns the days in a month numbered from Nisan
+func hebrewMonthLength(year, month int) int {
+	yearLength := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == adarII:
+		return 29
+	case month == adar && !hebrewLeapYear(year):
+		return 29
+	case month == cheshvan && yearLength%10 != 5:
+		return 29
+	case month == kislev && yearLength%10 == 3:
+		return 29
+	}
+	return 30
+}
+
+// hebrewToRD returns the Rata Die day of a date whose month is numbered from Nisan
+func hebrewToRD(year, month, day int) int {
+	rd := hebrewNewYear(year) + day - 1
+	if month < tishrei {
+		for m := tishrei; m <= hebrewMonthsInYear(year); m++ {
+			rd += hebrewMonthLength(year, m)
+		}
+		for m := nisan; m < month; m++ {
+			rd += hebrewMonthLength(year, m)
+		}
+		return rd
+	}
+	for m := tishrei; m < month; m++ {
+		rd += hebrewMonthLength(year, m)
+	}
+	return rd
+}
+
+// hebrewCivilMonth converts a month numbered from Nisan to one numbered from Tishrei
+func hebrewCivilMonth(year, month int) int {
+	if month >= tishrei {
+		return month - tishrei + 1
+	}
+	return month + hebrewMonthsInYear(year) - tishrei + 1
+}
+
+// hebrewBiblicalMonth converts a month numbered from Tishrei to one numbered from Nisan
+func hebrewBiblicalMonth(year, month int) int {
+	months := hebrewMonthsInYear(year)
+	if month <= months-tishrei+1 {
+		return month + tishrei - 1
+	}
+	return month - (months - tishrei + 1)
+}
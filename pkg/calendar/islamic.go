@@ -0,0 +1,77 @@
+package calendar
+
+import "fmt"
+
+// rdUnixEpoch is 1970-01-01 counted in Rata Die days, where day 1 is
+// 0001-01-01 in the proleptic Gregorian calendar
+const rdUnixEpoch = 719163
+
+// islamicEpoch is 1 Muharram AH 1 (16 July 622 Julian) in Rata Die days
+const islamicEpoch = 227015
+
+var islamicMonths = [...]string{
+	"Muharram", "Safar", "Rabi al-Awwal", "Rabi al-Thani", "Jumada al-Ula", "Jumada al-Akhirah",
+	"Rajab", "Shaban", "Ramadan", "Shawwal", "Dhu al-Qadah", "Dhu al-Hijjah",
+}
+
+// islamic is the tabular (arithmetic) Islamic calendar with the civil epoch
+// and leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each 30-year
+// cycle. Months alternate between 30 and 29 days, and leap years add a day to
+// Dhu al-Hijjah. Calendars based on sighting the moon, or on Umm al-Qura, can
+// differ from it by a day or two.
+type islamic struct{}
+
+func (islamic) Name() string { return Islamic }
+
+func (c islamic) FromDays(days int) (Date, error) {
+	rd := days + rdUnixEpoch
+	if rd < islamicEpoch {
+		return Date{}, outOfRange(c, "from 1 Muharram 1 AH (622 CE)")
+	}
+	year := floorDiv(30*(rd-islamicEpoch)+10646, 10631)
+	month := floorDiv(11*(rd-islamicToRD(year, 1, 1))+330, 325)
+	day := rd - islamicToRD(year, month, 1) + 1
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
+func (c islamic) ToDays(d Date) (int, error) {
+	if d.Year < 1 || d.Year > 9999 {
+		return 0, outOfRange(c, "for years 1 to 9999 AH")
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return 0, invalid(c, "month %d, must be 1 to 12", d.Month)
+	}
+	if n := islamicMonthLength(d.Year, d.Month); d.Day < 1 || d.Day > n {
+		return 0, invalid(c, "day %d, %s %d has %d days", d.Day, islamicMonths[d.Month-1], d.Year, n)
+	}
+	return islamicToRD(d.Year, d.Month, d.Day) - rdUnixEpoch, nil
+}
+
+func (islamic) MonthName(d Date) string {
+	return islamicMonths[d.Month-1]
+}
+
+func (islamic) YearName(Date) string { return "" }
+
+func (c islamic) Format(d Date) string {
+	return fmt.Sprintf("%d %s %d AH", d.Day, c.MonthName(d), d.Year)
+}
+
+// islamicToRD returns the Rata Die day of a tabular Islamic date
+func islamicToRD(year, month, day int) int {
+	return islamicEpoch - 1 + (year-1)*354 + floorDiv(3+11*year, 30) +
+		29*(month-1) + floorDiv(6*month-1, 11) + day
+}
+
+// islamicLeapYear reports whether a tabular Islamic year has 355 days
+func islamicLeapYear(year int) bool {
+	return mod(14+11*year, 30) < 11
+}
+
+// islamicMonthLength returns the number of days in a tabular Islamic month
+func islamicMonthLength(year, month int) int {
+	if month%2 == 1 || (month == 12 && islamicLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
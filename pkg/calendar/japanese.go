@@ -0,0 +1,103 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// japaneseEra is a Japanese imperial era and the Gregorian day it began
+type japaneseEra struct {
+	name  string
+	kanji string
+	start time.Time
+}
+
+// japaneseEras lists the eras since Japan adopted the Gregorian calendar,
+// oldest first
+var japaneseEras = []japaneseEra{
+	{"Meiji", "明治", time.Date(1868, time.October, 23, 0, 0, 0, 0, time.UTC)},
+	{"Taisho", "大正", time.Date(1912, time.July, 30, 0, 0, 0, 0, time.UTC)},
+	{"Showa", "昭和", time.Date(1926, time.December, 25, 0, 0, 0, 0, time.UTC)},
+	{"Heisei", "平成", time.Date(1989, time.January, 8, 0, 0, 0, 0, time.UTC)},
+	{"Reiwa", "令和", time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+}
+
+// japaneseStart is the day Japan adopted the Gregorian calendar, 1 January Meiji 6
+var japaneseStart = Days(1873, time.January, 1)
+
+// japanese is the Gregorian calendar with years counted in imperial eras.
+// The first year of an era runs from its first day to the end of that
+// Gregorian year.
+type japanese struct{}
+
+func (japanese) Name() string { return Japanese }
+
+func (c japanese) FromDays(days int) (Date, error) {
+	year, month, day := civil(days)
+	if days < japaneseStart || year > 9999 {
+		return Date{}, outOfRange(c, "from 1 January 1873 (Meiji 6)")
+	}
+	era := japaneseEras[0]
+	for _, e := range japaneseEras[1:] {
+		if days >= DaysOf(e.start) {
+			era = e
+		}
+	}
+	return Date{Year: year - era.start.Year() + 1, Month: int(month), Day: day, Era: era.name}, nil
+}
+
+func (c japanese) ToDays(d Date) (int, error) {
+	index := -1
+	for i, e := range japaneseEras {
+		if strings.EqualFold(d.Era, e.name) || d.Era == e.kanji {
+			index = i
+		}
+	}
+	if index < 0 {
+		names := make([]string, len(japaneseEras))
+		for i, e := range japaneseEras {
+			names[i] = e.name
+		}
+		return 0, invalid(c, "era %q, must be one of %s", d.Era, strings.Join(names, ", "))
+	}
+	era := japaneseEras[index]
+	if d.Year < 1 {
+		return 0, invalid(c, "year %d, era years start at 1", d.Year)
+	}
+
+	days, err := gregorian{}.ToDays(Date{Year: era.start.Year() + d.Year - 1, Month: d.Month, Day: d.Day})
+	if err != nil {
+		return 0, err
+	}
+	if days < DaysOf(era.start) || (index+1 < len(japaneseEras) && days >= DaysOf(japaneseEras[index+1].start)) {
+		return 0, invalid(c, "date %s %d-%02d-%02d, outside the %s era", era.name, d.Year, d.Month, d.Day, era.name)
+	}
+	if days < japaneseStart {
+		return 0, outOfRange(c, "from 1 January 1873 (Meiji 6)")
+	}
+	return days, nil
+}
+
+func (japanese) MonthName(d Date) string {
+	return time.Month(d.Month).String()
+}
+
+func (japanese) YearName(d Date) string {
+	return fmt.Sprintf("%s %d", d.Era, d.Year)
+}
+
+// Format writes the date in Japanese, calling the first year of an era 元年
+func (japanese) Format(d Date) string {
+	kanji := d.Era
+	for _, e := range japaneseEras {
+		if e.name == d.Era {
+			kanji = e.kanji
+		}
+	}
+	year := fmt.Sprint(d.Year)
+	if d.Year == 1 {
+		year = "元"
+	}
+	return fmt.Sprintf("%s%s年%d月%d日", kanji, year, d.Month, d.Day)
+}
@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+var persianMonths = [...]string{
+	"Farvardin", "Ordibehesht", "Khordad", "Tir", "Mordad", "Shahrivar",
+	"Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand",
+}
+
+// persianBreaks are the years in which the 33-year leap cycle of the
+// Persian calendar is interrupted, which keeps Nowruz on the day of the
+// March equinox in Tehran
+var persianBreaks = [...]int{
+	-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210,
+	1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178,
+}
+
+// persian is the Persian (Solar Hijri) calendar used in Iran and
+// Afghanistan. The first six months have 31 days, the next five 30 and
+// Esfand 29, or 30 in leap years. Leap years follow Borkowski's break table,
+// which matches the astronomical calendar for years 1 to 3177.
+type persian struct{}
+
+func (persian) Name() string { return Persian }
+
+func (c persian) FromDays(days int) (Date, error) {
+	gy, _, _ := civil(days)
+	year := gy - 621
+	if year < 1 || year > 3177 {
+		return Date{}, outOfRange(c, "for years 1 to 3177 SH")
+	}
+	leap, march := persianYear(year)
+	k := days - Days(gy, time.March, march)
+	if k >= 0 {
+		if k <= 185 {
+			return Date{Year: year, Month: 1 + k/31, Day: k%31 + 1}, nil
+		}
+		k -= 186
+	} else {
+		if year == 1 {
+			return Date{}, outOfRange(c, "for years 1 to 3177 SH")
+		}
+		year--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	}
+	return Date{Year: year, Month: 7 + k/30, Day: k%30 + 1}, nil
+}
+
+func (c persian) ToDays(d Date) (int, error) {
+	if d.Year < 1 || d.Year > 3177 {
+		return 0, outOfRange(c, "for years 1 to 3177 SH")
+	}
+	if d.Month < 1 || d.Month > 12 {
+		return 0, invalid(c, "month %d, must be 1 to 12", d.Month)
+	}
+	if n := persianMonthLength(d.Year, d.Month); d.Day < 1 || d.Day > n {
+		return 0, invalid(c, "day %d, %s %d has %d days", d.Day, persianMonths[d.Month-1], d.Year, n)
+	}
+	_, march := persianYear(d.Year)
+	return Days(d.Year+621, time.March, march) + (d.Month-1)*31 - d.Month/7*(d.Month-7) + d.Day - 1, nil
+}
+
+func (persian) MonthName(d Date) string {
+	return persianMonths[d.Month-1]
+}
+
+func (persian) YearName(Date) string { return "" }
+
+func (c persian) Format(d Date) string {
+	return fmt.Sprintf("%d %s %d SH", d.Day, c.MonthName(d), d.Year)
+}
+
+// persianYear returns where year falls in its 4-year leap cycle, 0 for a
+// leap year, and the day in March of the Gregorian year year+621 on which it
+// starts. It must only be called for years 1 to 3177.
+func persianYear(year int) (leap, march int) {
+	gy := year + 621
+	leapJ := -14
+	jp := persianBreaks[0]
+	jump := 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := year - jp
+
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march
+}
+
+// persianMonthLength returns the number of days in a Persian month
+func persianMonthLength(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	}
+	if leap, _ := persianYear(year); leap == 0 {
+		return 30
+	}
+	return 29
+}
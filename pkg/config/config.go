@@ -49,6 +49,12 @@ type Config struct {
 	DBMaxIdleConns int
 	DBCacheSize    int // In KB (will be converted to negative pages for SQLite)
 	DBWalMode      bool
+
+	// Holiday calendar data files
+	HolidayDataDir string
+
+	// Leap second table file, used instead of the bundled table when newer
+	LeapSecondsFile string
 }
 
 // Load loads configuration from environment variables with validation
@@ -97,6 +103,9 @@ func Load() (*Config, error) {
 		DBMaxIdleConns: parseInt(getEnv("DB_MAX_IDLE_CONNS", "5"), 5),
 		DBCacheSize:    parseInt(getEnv("DB_CACHE_SIZE_KB", "64000"), 64000),
 		DBWalMode:      parseBool(getEnv("DB_WAL_MODE", "true")),
+
+		HolidayDataDir:  HolidayDataDirFromEnv(),
+		LeapSecondsFile: LeapSecondsFileFromEnv(),
 	}
 
 	// Validate configuration
@@ -202,11 +211,13 @@ func (c *Config) String() string {
 	return fmt.Sprintf("Config{Port:%s, Host:%s, LogLevel:%s, AllowedOrigins:%v, "+
 		"ReadTimeout:%v, WriteTimeout:%v, IdleTimeout:%v, ReadHeaderTimeout:%v, "+
 		"ShutdownTimeout:%v, MaxHeaderBytes:%d, DBPath:%s, DBMaxOpenConns:%d, "+
-		"DBMaxIdleConns:%d, DBCacheSize:%dKB, DBWalMode:%v}",
+		"DBMaxIdleConns:%d, DBCacheSize:%dKB, DBWalMode:%v, HolidayDataDir:%s, "+
+		"LeapSecondsFile:%s}",
 		c.Port, c.Host, c.LogLevel, c.AllowedOrigins,
 		c.ReadTimeout, c.WriteTimeout, c.IdleTimeout, c.ReadHeaderTimeout,
 		c.ShutdownTimeout, c.MaxHeaderBytes, c.DBPath, c.DBMaxOpenConns,
-		c.DBMaxIdleConns, c.DBCacheSize, c.DBWalMode)
+		c.DBMaxIdleConns, c.DBCacheSize, c.DBWalMode, c.HolidayDataDir,
+		c.LeapSecondsFile)
 }
 
 // Helper functions
@@ -297,3 +308,15 @@ func parseCommaSeparatedList(value string) []string {
 func ParseLogLevelFromEnv() slog.Level {
 	return parseLogLevel(getEnv("LOG_LEVEL", "info"))
 }
+
+// HolidayDataDirFromEnv returns the directory of holiday calendar data files
+// from HOLIDAY_DATA_DIR, defaulting to data/holidays
+func HolidayDataDirFromEnv() string {
+	return getEnv("HOLIDAY_DATA_DIR", "data/holidays")
+}
+
+// LeapSecondsFileFromEnv returns the path of the leap-seconds.list file from
+// LEAP_SECONDS_FILE, defaulting to data/leap-seconds.list
+func LeapSecondsFileFromEnv() string {
+	return getEnv("LEAP_SECONDS_FILE", "data/leap-seconds.list")
+}
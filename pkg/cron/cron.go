@@ -0,0 +1,222 @@
+// Package cron parses cron expressions and previews their fire times on a
+// timezone's wall clock, including the runs that DST changes skip or double.
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// Parse errors
+var (
+	ErrInvalidExpression = errors.New("invalid cron expression")
+	ErrNeverFires        = errors.New("cron expression never fires")
+)
+
+// macros maps the supported @ macros to their five-field form
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// field describes one position of a cron expression
+type field struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	secondField = field{name: "second", min: 0, max: 59}
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12,
+		names: []string{"", "JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}}
+	// Day of week allows 7 for Sunday, folded onto 0 after parsing
+	dowField = field{name: "day of week", min: 0, max: 7,
+		names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}}
+)
+
+// Schedule is a parsed cron expression. Each field is a bit set of the values
+// it allows.
+type Schedule struct {
+	// Normalized is the expression with any macro expanded
+	Normalized string
+	// HasSeconds reports whether the expression has a leading seconds field
+	HasSeconds bool
+
+	second, minute, hour, dom, month, dow uint64
+	// domAny and dowAny record a day field given as * or ?. When both day
+	// fields are restricted, a day matches if either does, as in Vixie cron.
+	domAny, dowAny bool
+}
+
+// Parse parses a standard five-field cron expression (minute, hour, day of
+// month, month, day of week), a six-field expression with a leading seconds
+// field, or one of the macros @yearly, @annually, @monthly, @weekly, @daily,
+// @midnight and @hourly.
+//
+// Fields accept *, ?, values, ranges (1-5), steps (*/15, 1-30/2, 5/10) and
+// comma-separated lists. Months and weekdays may be given by three-letter
+// name, and 7 is Sunday as well as 0.
+func Parse(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		expanded, ok := macros[strings.ToLower(expr)]
+		if !ok {
+			return nil, fmt.Errorf("%w: unsupported macro %s", ErrInvalidExpression, expr)
+		}
+		expr = expanded
+	}
+
+	fields := strings.Fields(expr)
+	s := &Schedule{Normalized: strings.Join(fields, " ")}
+	switch len(fields) {
+	case 5:
+		s.second = 1
+	case 6:
+		s.HasSeconds = true
+		var err error
+		if s.second, err = parseField(fields[0], secondField); err != nil {
+			return nil, err
+		}
+		fields = fields[1:]
+	default:
+		return nil, fmt.Errorf("%w: expected 5 or 6 fields, got %d", ErrInvalidExpression, len(fields))
+	}
+
+	var err error
+	if s.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow = s.dow&^(1<<7) | 1
+	}
+	s.domAny = isAny(fields[2])
+	s.dowAny = isAny(fields[4])
+
+	if !s.possible() {
+		return nil, fmt.Errorf("%w: %s", ErrNeverFires, s.Normalized)
+	}
+	return s, nil
+}
+
+// possible reports whether any allowed month has an allowed day. A restricted
+// day of week always matches some day, so only the day of month can rule out
+// every month, as in "0 0 30 2 *".
+func (s *Schedule) possible() bool {
+	if !s.dowAny {
+		return true
+	}
+	maxDay := []int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for month := 1; month <= 12; month++ {
+		if s.month&(1<<month) != 0 && bits.TrailingZeros64(s.dom) <= maxDay[month] {
+			return true
+		}
+	}
+	return false
+}
+
+// parseField parses one field into a bit set
+func parseField(value string, f field) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(value, ",") {
+		if item == "" {
+			return 0, fmt.Errorf("%w: empty list item in %s field %q", ErrInvalidExpression, f.name, value)
+		}
+
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepPart)
+			if err != nil || n < 1 || n > f.max {
+				return 0, fmt.Errorf("%w: invalid step %q in %s field", ErrInvalidExpression, stepPart, f.name)
+			}
+			step = n
+		}
+
+		var lo, hi int
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			if rangePart == "?" && f.name != domField.name && f.name != dowField.name {
+				return 0, fmt.Errorf("%w: ? is only allowed in the day fields", ErrInvalidExpression)
+			}
+			lo, hi = f.min, f.max
+			if f.name == dowField.name {
+				hi = 6
+			}
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var err error
+			if lo, err = parseValue(a, f); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(b, f); err != nil {
+				return 0, err
+			}
+			if hi < lo {
+				return 0, fmt.Errorf("%w: range %s is backwards in %s field", ErrInvalidExpression, rangePart, f.name)
+			}
+		default:
+			var err error
+			if lo, err = parseValue(rangePart, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			// "5/10" means every 10 from 5 to the end of the range
+			if hasStep {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// parseValue parses a single number or name within the bounds of f
+func parseValue(s string, f field) (int, error) {
+	for i, name := range f.names {
+		if name != "" && strings.EqualFold(s, name) {
+			return i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid value %q in %s field", ErrInvalidExpression, s, f.name)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%w: %s must be between %d and %d, got %d", ErrInvalidExpression, f.name, f.min, f.max, n)
+	}
+	return n, nil
+}
+
+// isAny reports whether a day field starts with * or ?, which Vixie cron
+// treats as unrestricted when combining the two day fields
+func isAny(value string) bool {
+	return strings.HasPrefix(value, "*") || strings.HasPrefix(value, "?")
+}
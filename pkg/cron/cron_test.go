@@ -0,0 +1,167 @@
+package cron
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+// runTimes formats the runs of a preview in RFC 3339
+func runTimes(p *Preview) []string {
+	out := make([]string, len(p.Runs))
+	for i, r := range p.Runs {
+		out[i] = r.Time.Format(time.RFC3339)
+	}
+	return out
+}
+
+func TestParse(t *testing.T) {
+	valid := map[string]string{
+		"*/15 9-17 * * MON-FRI": "*/15 9-17 * * MON-FRI",
+		"@daily":                "0 0 * * *",
+		"@Weekly":               "0 0 * * 0",
+		"30 0 12 ? * 7":         "30 0 12 ? * 7",
+		"0  0 1,15 jan,jul *":   "0 0 1,15 jan,jul *",
+	}
+	for expr, normalized := range valid {
+		s, err := Parse(expr)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", expr, err)
+			continue
+		}
+		if s.Normalized != normalized {
+			t.Errorf("Parse(%q).Normalized = %q, want %q", expr, s.Normalized, normalized)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"? * * * *",
+		"* * L * *",
+		"1,,2 * * * *",
+		"@reboot",
+	}
+	for _, expr := range invalid {
+		if _, err := Parse(expr); !errors.Is(err, ErrInvalidExpression) {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidExpression", expr, err)
+		}
+	}
+
+	if _, err := Parse("0 0 30 2 *"); !errors.Is(err, ErrNeverFires) {
+		t.Errorf("expected ErrNeverFires for 30 February, got %v", err)
+	}
+	if _, err := Parse("0 0 29 2 *"); err != nil {
+		t.Errorf("expected 29 February to be possible, got %v", err)
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		n    int
+		want []string
+	}{
+		{
+			name: "weekday business hours",
+			expr: "0 9,17 * * MON-FRI",
+			from: time.Date(2025, 6, 6, 12, 0, 0, 0, time.UTC), // Friday
+			n:    3,
+			want: []string{"2025-06-06T17:00:00Z", "2025-06-09T09:00:00Z", "2025-06-09T17:00:00Z"},
+		},
+		{
+			name: "restricted day of month and day of week match either",
+			expr: "0 0 13 * FRI",
+			from: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+			n:    3,
+			want: []string{"2025-06-13T00:00:00Z", "2025-06-20T00:00:00Z", "2025-06-27T00:00:00Z"},
+		},
+		{
+			name: "seconds field",
+			expr: "*/20 0 12 * * *",
+			from: time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC),
+			n:    2,
+			want: []string{"2025-06-10T12:00:20Z", "2025-06-10T12:00:40Z"},
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			from: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			n:    2,
+			want: []string{"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			got := runTimes(s.Next(tt.from, time.UTC, tt.n))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPreviewAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	// 02:30 does not exist on 9 March 2025
+	s, _ := Parse("30 2 * * *")
+	p := s.Next(time.Date(2025, 3, 8, 12, 0, 0, 0, ny), ny, 2)
+	want := []string{"2025-03-10T02:30:00-04:00", "2025-03-11T02:30:00-04:00"}
+	if got := runTimes(p); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+	if len(p.Skipped) != 1 || p.Skipped[0] != time.Date(2025, 3, 9, 2, 30, 0, 0, time.UTC) {
+		t.Errorf("expected 9 March 02:30 to be skipped, got %v", p.Skipped)
+	}
+
+	// 01:30 happens twice on 2 November 2025, and the later 01:00 EST run comes
+	// after the earlier 01:45 EDT one
+	s, _ = Parse("0,45 1 * * *")
+	p = s.Next(time.Date(2025, 11, 2, 0, 0, 0, 0, ny), ny, 5)
+	want = []string{
+		"2025-11-02T01:00:00-04:00", "2025-11-02T01:45:00-04:00",
+		"2025-11-02T01:00:00-05:00", "2025-11-02T01:45:00-05:00",
+		"2025-11-03T01:00:00-05:00",
+	}
+	if got := runTimes(p); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+	for i, r := range p.Runs {
+		if r.Doubled != (i < 4) {
+			t.Errorf("run %d doubled = %v", i, r.Doubled)
+		}
+	}
+
+	// Walking backwards sees the same runs latest first
+	p = s.Prev(time.Date(2025, 11, 3, 0, 0, 0, 0, ny), ny, 3)
+	want = []string{"2025-11-02T01:45:00-05:00", "2025-11-02T01:00:00-05:00", "2025-11-02T01:45:00-04:00"}
+	if got := runTimes(p); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Prev() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,159 @@
+package cron
+
+import (
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// MaxSearchYears bounds how far a preview looks for fire times
+const MaxSearchYears = 50
+
+// foldMargin covers the longest stretch of wall-clock time a DST change repeats.
+// Instants whose wall-clock times are further apart than this are in order.
+const foldMargin = 3 * time.Hour
+
+// Run is a fire time. Doubled marks a wall-clock time that occurs twice as
+// clocks go back, so the schedule fires at both instants.
+type Run struct {
+	Time    time.Time
+	Doubled bool
+}
+
+// Preview is a list of fire times in order away from the starting instant.
+// Skipped holds the wall-clock times, as times in UTC, that the schedule
+// matches but that do not exist because clocks go forward.
+type Preview struct {
+	Runs    []Run
+	Skipped []time.Time
+}
+
+// Next returns up to n fire times after t, evaluated on the wall clock of loc
+func (s *Schedule) Next(t time.Time, loc *time.Location, n int) *Preview {
+	return s.preview(t, loc, n, true)
+}
+
+// Prev returns up to n fire times before t, latest first, evaluated on the wall clock of loc
+func (s *Schedule) Prev(t time.Time, loc *time.Location, n int) *Preview {
+	return s.preview(t, loc, n, false)
+}
+
+// preview walks wall-clock matches away from t, collecting the instants they
+// map to. A DST fold can put an instant of a later wall-clock time before one
+// of an earlier wall-clock time, so matches are walked until they are more than
+// foldMargin past the n-th closest instant.
+func (s *Schedule) preview(t time.Time, loc *time.Location, n int, forward bool) *Preview {
+	origin := timecalc.Floating(t.In(loc))
+	sign := time.Duration(1)
+	if !forward {
+		sign = -1
+	}
+	beyond := func(a, b time.Time) bool {
+		if forward {
+			return a.After(b)
+		}
+		return a.Before(b)
+	}
+
+	var runs []Run
+	var skipped []time.Time
+	closest := func() {
+		sort.SliceStable(runs, func(i, j int) bool { return beyond(runs[j].Time, runs[i].Time) })
+	}
+
+	start := origin.Add(-sign * foldMargin)
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; i < MaxSearchYears*366; i++ {
+		if s.matchDay(day) {
+			for _, wall := range s.times(day, forward) {
+				if !beyond(wall, start) {
+					continue
+				}
+				if len(runs) >= n {
+					closest()
+					runs = runs[:n]
+					if beyond(wall, timecalc.Floating(runs[n-1].Time.In(loc)).Add(sign*foldMargin)) {
+						return s.finish(runs, skipped, forward)
+					}
+				}
+
+				instants := timecalc.InstantsAt(wall, loc)
+				if len(instants) == 0 && beyond(wall, origin) {
+					skipped = append(skipped, wall)
+				}
+				for _, at := range instants {
+					if beyond(at, t) {
+						runs = append(runs, Run{Time: at, Doubled: len(instants) == 2})
+					}
+				}
+			}
+		}
+		day = day.AddDate(0, 0, int(sign))
+	}
+
+	closest()
+	if len(runs) > n {
+		runs = runs[:n]
+	}
+	return s.finish(runs, skipped, forward)
+}
+
+// finish drops skipped times past the last run and builds the preview
+func (s *Schedule) finish(runs []Run, skipped []time.Time, forward bool) *Preview {
+	p := &Preview{Runs: runs, Skipped: []time.Time{}}
+	for _, wall := range skipped {
+		if len(runs) > 0 {
+			last := timecalc.Floating(runs[len(runs)-1].Time)
+			if (forward && wall.After(last)) || (!forward && wall.Before(last)) {
+				continue
+			}
+		}
+		p.Skipped = append(p.Skipped, wall)
+	}
+	return p
+}
+
+// matchDay reports whether the schedule fires on the date of d
+func (s *Schedule) matchDay(d time.Time) bool {
+	if s.month&(1<<uint(d.Month())) == 0 {
+		return false
+	}
+	dom := s.dom&(1<<uint(d.Day())) != 0
+	dow := s.dow&(1<<uint(d.Weekday())) != 0
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// times returns the wall-clock times the schedule fires at on day, in the
+// direction of the walk
+func (s *Schedule) times(day time.Time, forward bool) []time.Time {
+	out := make([]time.Time, 0, bits.OnesCount64(s.hour)*bits.OnesCount64(s.minute)*bits.OnesCount64(s.second))
+	for _, h := range members(s.hour) {
+		for _, m := range members(s.minute) {
+			for _, sec := range members(s.second) {
+				out = append(out, day.Add(time.Duration(h)*time.Hour+time.Duration(m)*time.Minute+time.Duration(sec)*time.Second))
+			}
+		}
+	}
+	if !forward {
+		for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+			out[i], out[j] = out[j], out[i]
+		}
+	}
+	return out
+}
+
+// members returns the values in a bit set, in ascending order
+func members(set uint64) []int {
+	var out []int
+	for set != 0 {
+		v := bits.TrailingZeros64(set)
+		out = append(out, v)
+		set &^= 1 << v
+	}
+	return out
+}
@@ -0,0 +1,2 @@
+-- Rollback: Remove business hours from locations
+ALTER TABLE locations DROP COLUMN business_hours;
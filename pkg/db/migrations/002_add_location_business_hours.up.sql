@@ -0,0 +1,3 @@
+-- Add business hours to locations, stored as a JSON document:
+-- {"weekly": {"monday": [{"open": "09:00", "close": "17:00"}]}, "overrides": [...]}
+ALTER TABLE locations ADD COLUMN business_hours TEXT;
@@ -0,0 +1,6 @@
+-- Rollback: Drop holiday tables and related objects
+DROP TABLE IF EXISTS location_calendars;
+DROP INDEX IF EXISTS idx_holidays_location_date_name;
+DROP INDEX IF EXISTS idx_holidays_calendar_date;
+DROP TABLE IF EXISTS holidays;
+DROP TABLE IF EXISTS holiday_calendars;
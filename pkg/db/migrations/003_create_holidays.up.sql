@@ -0,0 +1,34 @@
+-- Holiday calendars loaded from data files
+CREATE TABLE IF NOT EXISTS holiday_calendars (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    description TEXT,
+    source TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Holidays belong either to a calendar or, for custom holidays, to a single location
+CREATE TABLE IF NOT EXISTS holidays (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    calendar_id INTEGER REFERENCES holiday_calendars(id) ON DELETE CASCADE,
+    location_id INTEGER REFERENCES locations(id) ON DELETE CASCADE,
+    date TEXT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((calendar_id IS NULL) <> (location_id IS NULL))
+);
+
+-- Index for date range lookups per calendar
+CREATE INDEX IF NOT EXISTS idx_holidays_calendar_date ON holidays(calendar_id, date);
+
+-- Custom holidays are unique per location, date and name
+CREATE UNIQUE INDEX IF NOT EXISTS idx_holidays_location_date_name ON holidays(location_id, date, name)
+    WHERE location_id IS NOT NULL;
+
+-- Calendars attached to each location
+CREATE TABLE IF NOT EXISTS location_calendars (
+    location_id INTEGER NOT NULL REFERENCES locations(id) ON DELETE CASCADE,
+    calendar_id INTEGER NOT NULL REFERENCES holiday_calendars(id) ON DELETE CASCADE,
+    PRIMARY KEY (location_id, calendar_id)
+);
@@ -0,0 +1,2 @@
+-- Rollback: Remove weekend days from locations
+ALTER TABLE locations DROP COLUMN weekend;
@@ -0,0 +1,3 @@
+-- Add weekend days to locations, stored as a JSON array of weekday names:
+-- ["friday", "saturday"]. NULL means the default Saturday and Sunday weekend.
+ALTER TABLE locations ADD COLUMN weekend TEXT;
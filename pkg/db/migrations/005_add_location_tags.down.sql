@@ -0,0 +1,2 @@
+-- Rollback: Remove tags from locations
+ALTER TABLE locations DROP COLUMN tags;
@@ -0,0 +1,3 @@
+-- Add tags to locations, stored as a JSON array of lower-case names:
+-- ["emea", "engineering"]. NULL means no tags.
+ALTER TABLE locations ADD COLUMN tags TEXT;
@@ -0,0 +1,3 @@
+-- Rollback: Remove coordinates from locations
+ALTER TABLE locations DROP COLUMN longitude;
+ALTER TABLE locations DROP COLUMN latitude;
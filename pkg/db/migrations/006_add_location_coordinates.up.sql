@@ -0,0 +1,4 @@
+-- Add coordinates to locations in decimal degrees, north and east positive.
+-- Both are NULL when the location has no position.
+ALTER TABLE locations ADD COLUMN latitude REAL;
+ALTER TABLE locations ADD COLUMN longitude REAL;
@@ -0,0 +1,2 @@
+-- Rollback: Drop fiscal calendars table
+DROP TABLE IF EXISTS fiscal_calendars;
@@ -0,0 +1,14 @@
+-- Fiscal calendars. pattern is 'monthly' or a retail week pattern such as
+-- '4-4-5'; year_end ('last' or 'nearest') applies to retail calendars only.
+CREATE TABLE IF NOT EXISTS fiscal_calendars (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    description TEXT,
+    start_month INTEGER NOT NULL CHECK (start_month BETWEEN 1 AND 12),
+    pattern TEXT NOT NULL,
+    week_start TEXT NOT NULL,
+    year_end TEXT,
+    year_label TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
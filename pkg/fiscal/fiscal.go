@@ -0,0 +1,206 @@
+// Package fiscal maps dates to fiscal years, quarters, periods and weeks.
+//
+// Monthly fiscal years are twelve calendar months starting in any month.
+// Retail fiscal years are whole weeks, 52 or 53 of them, ending on the same
+// weekday every year, and split each quarter into periods of 4-4-5, 4-5-4 or
+// 5-4-4 weeks.
+//
+// Dates are handled as times at midnight UTC; only their year, month and day
+// matter.
+package fiscal
+
+import (
+	"math"
+	"time"
+)
+
+// Calendar describes how fiscal years are laid out
+type Calendar struct {
+	// StartMonth is the month the fiscal year starts in. A retail year starts
+	// the day after the end of the previous year, which falls near the end
+	// of the month before.
+	StartMonth time.Month
+	// Weeks holds the weeks in each of the three periods of a quarter for a
+	// retail calendar, such as {4, 4, 5}. It is nil for a monthly calendar.
+	Weeks []int
+	// WeekStart is the first day of fiscal weeks, and of retail years
+	WeekStart time.Weekday
+	// Nearest ends a retail year on the last day of its week nearest the end
+	// of the month before StartMonth, rather than the last one in that month
+	Nearest bool
+	// LabelStart names a fiscal year by the calendar year it starts in,
+	// rather than the one it ends in
+	LabelStart bool
+}
+
+// Span is a range of dates, End exclusive
+type Span struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Days returns the number of days in the span
+func (s Span) Days() int {
+	return int(math.Round(s.End.Sub(s.Start).Hours() / 24))
+}
+
+// Period is one of the twelve periods of a fiscal year. Weeks is the number
+// of whole weeks in a retail period and zero in a monthly one.
+type Period struct {
+	Span
+	Year    int
+	Quarter int
+	Number  int
+	Weeks   int
+}
+
+// Position locates a date in a fiscal calendar. Weeks are numbered from 1
+// for the week containing the first day of the year; in a monthly calendar
+// the first and last weeks may be short.
+type Position struct {
+	Year      int
+	Quarter   int
+	Period    int
+	Week      int
+	DayOfYear int
+	// WeeksInYear is 52 or 53 for a retail year, and the number of the last
+	// week for a monthly one
+	WeeksInYear int
+
+	YearSpan    Span
+	QuarterSpan Span
+	PeriodSpan  Span
+	WeekSpan    Span
+}
+
+// Retail reports whether the calendar has 52/53-week years
+func (c Calendar) Retail() bool {
+	return len(c.Weeks) > 0
+}
+
+// Year returns the dates of fiscal year
+func (c Calendar) Year(year int) Span {
+	return Span{Start: c.yearStart(year), End: c.yearStart(year + 1)}
+}
+
+// Periods returns the twelve periods of fiscal year. In a 53-week retail
+// year the extra week goes to the last period.
+func (c Calendar) Periods(year int) []Period {
+	periods := make([]Period, 12)
+	start := c.yearStart(year)
+	weeks := c.Year(year).Days() / 7
+	for i := range periods {
+		var end time.Time
+		var n int
+		if c.Retail() {
+			n = c.Weeks[i%3]
+			if i == 11 && weeks == 53 {
+				n++
+			}
+			end = start.AddDate(0, 0, 7*n)
+		} else {
+			end = start.AddDate(0, 1, 0)
+		}
+		periods[i] = Period{Span: Span{Start: start, End: end}, Year: year, Quarter: i/3 + 1, Number: i + 1, Weeks: n}
+		start = end
+	}
+	return periods
+}
+
+// Quarters returns the dates of the four quarters of fiscal year
+func (c Calendar) Quarters(year int) []Span {
+	periods := c.Periods(year)
+	quarters := make([]Span, 4)
+	for i := range quarters {
+		quarters[i] = Span{Start: periods[3*i].Start, End: periods[3*i+2].End}
+	}
+	return quarters
+}
+
+// YearOf returns the fiscal year containing date
+func (c Calendar) YearOf(date time.Time) int {
+	day := dateOf(date)
+	year := day.Year()
+	if !c.LabelStart && c.StartMonth > time.January && day.Month() >= c.StartMonth {
+		year++
+	}
+	// Retail years can start a few days either side of the month
+	for day.Before(c.yearStart(year)) {
+		year--
+	}
+	for !day.Before(c.yearStart(year + 1)) {
+		year++
+	}
+	return year
+}
+
+// Locate returns the fiscal position of date
+func (c Calendar) Locate(date time.Time) Position {
+	day := dateOf(date)
+	year := c.YearOf(day)
+	span := c.Year(year)
+
+	pos := Position{Year: year, YearSpan: span, DayOfYear: daysBetween(span.Start, day) + 1}
+	for _, p := range c.Periods(year) {
+		if day.Before(p.End) {
+			pos.Quarter, pos.Period, pos.PeriodSpan = p.Quarter, p.Number, p.Span
+			break
+		}
+	}
+	pos.QuarterSpan = c.Quarters(year)[pos.Quarter-1]
+
+	// Weeks start on WeekStart, counting from the one containing the first
+	// day of the year, and are cut short at the ends of the year
+	first := span.Start.AddDate(0, 0, -int((span.Start.Weekday()-c.WeekStart+7)%7))
+	pos.Week = daysBetween(first, day)/7 + 1
+	weekStart := first.AddDate(0, 0, 7*(pos.Week-1))
+	pos.WeekSpan = Span{Start: later(weekStart, span.Start), End: earlier(weekStart.AddDate(0, 0, 7), span.End)}
+	pos.WeeksInYear = daysBetween(first, span.End.AddDate(0, 0, -1))/7 + 1
+	return pos
+}
+
+// yearStart returns the first day of fiscal year
+func (c Calendar) yearStart(year int) time.Time {
+	startYear := year
+	if !c.LabelStart && c.StartMonth > time.January {
+		startYear--
+	}
+	if !c.Retail() {
+		return time.Date(startYear, c.StartMonth, 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	// The previous year ends on the last day of a week, the one on or before
+	// the end of the previous month, or the nearest to it
+	monthEnd := time.Date(startYear, c.StartMonth, 0, 0, 0, 0, 0, time.UTC)
+	weekEnd := (c.WeekStart + 6) % 7
+	back := int((monthEnd.Weekday() - weekEnd + 7) % 7)
+	if c.Nearest && back > 3 {
+		back -= 7
+	}
+	return monthEnd.AddDate(0, 0, 1-back)
+}
+
+// dateOf returns the calendar date of t as midnight UTC
+func dateOf(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// daysBetween returns the number of days from one date to another
+func daysBetween(from, to time.Time) int {
+	return Span{Start: from, End: to}.Days()
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,154 @@
+package fiscal
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// nrf is the National Retail Federation 4-5-4 calendar, whose years end on
+// the Saturday nearest the end of January and are named by their start
+var nrf = Calendar{StartMonth: time.February, Weeks: []int{4, 5, 4}, WeekStart: time.Sunday, Nearest: true, LabelStart: true}
+
+func TestYear(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		year     int
+		start    time.Time
+		end      time.Time
+	}{
+		{"calendar year", Calendar{StartMonth: time.January}, 2025, date(2025, 1, 1), date(2026, 1, 1)},
+		{"july start named by end", Calendar{StartMonth: time.July}, 2025, date(2024, 7, 1), date(2025, 7, 1)},
+		{"april start named by start", Calendar{StartMonth: time.April, LabelStart: true}, 2025, date(2025, 4, 1), date(2026, 4, 1)},
+		{"nrf 2023 has 53 weeks", nrf, 2023, date(2023, 1, 29), date(2024, 2, 4)},
+		{"nrf 2024", nrf, 2024, date(2024, 2, 4), date(2025, 2, 2)},
+		// Ends on the last Saturday of September
+		{"last saturday", Calendar{StartMonth: time.October, Weeks: []int{5, 4, 4}, WeekStart: time.Sunday}, 2025, date(2024, 9, 29), date(2025, 9, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			span := tt.calendar.Year(tt.year)
+			if !span.Start.Equal(tt.start) || !span.End.Equal(tt.end) {
+				t.Errorf("Year(%d) = %s to %s, want %s to %s", tt.year,
+					span.Start.Format(time.DateOnly), span.End.Format(time.DateOnly),
+					tt.start.Format(time.DateOnly), tt.end.Format(time.DateOnly))
+			}
+		})
+	}
+}
+
+func TestPeriods(t *testing.T) {
+	periods := nrf.Periods(2023)
+	weeks := []int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 5}
+	start := date(2023, 1, 29)
+	for i, p := range periods {
+		if p.Weeks != weeks[i] || !p.Start.Equal(start) || p.Quarter != i/3+1 || p.Number != i+1 {
+			t.Errorf("period %d = %+v, want %d weeks from %s", i+1, p, weeks[i], start.Format(time.DateOnly))
+		}
+		start = p.End
+	}
+	if !start.Equal(date(2024, 2, 4)) {
+		t.Errorf("periods end on %s, want 2024-02-04", start.Format(time.DateOnly))
+	}
+
+	monthly := Calendar{StartMonth: time.July}.Periods(2025)
+	if !monthly[0].Start.Equal(date(2024, 7, 1)) || !monthly[11].End.Equal(date(2025, 7, 1)) || monthly[7].Days() != 28 {
+		t.Errorf("unexpected monthly periods: %+v", monthly)
+	}
+
+	quarters := nrf.Quarters(2024)
+	if !quarters[1].Start.Equal(date(2024, 5, 5)) || quarters[1].Days() != 91 {
+		t.Errorf("unexpected second quarter: %+v", quarters[1])
+	}
+}
+
+func TestLocate(t *testing.T) {
+	tests := []struct {
+		name     string
+		calendar Calendar
+		date     time.Time
+		want     Position
+		week     Span
+	}{
+		{
+			name:     "monthly mid-year",
+			calendar: Calendar{StartMonth: time.April, WeekStart: time.Monday, LabelStart: true},
+			date:     date(2025, 10, 16),
+			want:     Position{Year: 2025, Quarter: 3, Period: 7, Week: 29, DayOfYear: 199, WeeksInYear: 53},
+			week:     Span{date(2025, 10, 13), date(2025, 10, 20)},
+		},
+		{
+			name:     "monthly first short week",
+			calendar: Calendar{StartMonth: time.April, WeekStart: time.Monday, LabelStart: true},
+			date:     date(2025, 4, 5),
+			want:     Position{Year: 2025, Quarter: 1, Period: 1, Week: 1, DayOfYear: 5, WeeksInYear: 53},
+			week:     Span{date(2025, 4, 1), date(2025, 4, 7)},
+		},
+		{
+			name:     "retail year before the calendar year",
+			calendar: nrf,
+			date:     date(2025, 2, 1),
+			want:     Position{Year: 2024, Quarter: 4, Period: 12, Week: 52, DayOfYear: 364, WeeksInYear: 52},
+			week:     Span{date(2025, 1, 26), date(2025, 2, 2)},
+		},
+		{
+			name:     "retail 53rd week",
+			calendar: nrf,
+			date:     date(2024, 2, 1),
+			want:     Position{Year: 2023, Quarter: 4, Period: 12, Week: 53, DayOfYear: 369, WeeksInYear: 53},
+			week:     Span{date(2024, 1, 28), date(2024, 2, 4)},
+		},
+		{
+			name:     "retail first day",
+			calendar: nrf,
+			date:     time.Date(2025, 2, 2, 23, 30, 0, 0, time.FixedZone("", -5*3600)),
+			want:     Position{Year: 2025, Quarter: 1, Period: 1, Week: 1, DayOfYear: 1, WeeksInYear: 52},
+			week:     Span{date(2025, 2, 2), date(2025, 2, 9)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.calendar.Locate(tt.date)
+			if got.Year != tt.want.Year || got.Quarter != tt.want.Quarter || got.Period != tt.want.Period ||
+				got.Week != tt.want.Week || got.DayOfYear != tt.want.DayOfYear || got.WeeksInYear != tt.want.WeeksInYear {
+				t.Errorf("Locate() = FY%d Q%d P%d W%d day %d of %d weeks, want FY%d Q%d P%d W%d day %d of %d weeks",
+					got.Year, got.Quarter, got.Period, got.Week, got.DayOfYear, got.WeeksInYear,
+					tt.want.Year, tt.want.Quarter, tt.want.Period, tt.want.Week, tt.want.DayOfYear, tt.want.WeeksInYear)
+			}
+			if got.WeekSpan != tt.week {
+				t.Errorf("week = %s to %s, want %s to %s",
+					got.WeekSpan.Start.Format(time.DateOnly), got.WeekSpan.End.Format(time.DateOnly),
+					tt.week.Start.Format(time.DateOnly), tt.week.End.Format(time.DateOnly))
+			}
+			if got.PeriodSpan.Start.After(dateOf(tt.date)) || !got.PeriodSpan.End.After(dateOf(tt.date)) {
+				t.Errorf("period %+v does not contain the date", got.PeriodSpan)
+			}
+		})
+	}
+}
+
+func TestLocateEveryDay(t *testing.T) {
+	// Consecutive days never go backwards or skip a week
+	calendars := []Calendar{nrf, {StartMonth: time.July, WeekStart: time.Monday}}
+	for _, c := range calendars {
+		prev := c.Locate(date(2019, 12, 31))
+		for day := date(2020, 1, 1); day.Year() < 2031; day = day.AddDate(0, 0, 1) {
+			pos := c.Locate(day)
+			switch {
+			case pos.Year == prev.Year+1:
+				if pos.DayOfYear != 1 || pos.Week != 1 || pos.Period != 1 {
+					t.Fatalf("%s starts a year at %+v", day.Format(time.DateOnly), pos)
+				}
+			case pos.Year != prev.Year || pos.DayOfYear != prev.DayOfYear+1 || pos.Week < prev.Week || pos.Week > prev.Week+1:
+				t.Fatalf("%s: %+v follows %+v", day.Format(time.DateOnly), pos, prev)
+			}
+			prev = pos
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Package holiday loads holiday calendars from local data files.
+//
+// Three formats are supported, chosen by file extension:
+//
+//   - .ics: iCalendar files with one all-day VEVENT per holiday. Multi-day
+//     events expand to one holiday per date; recurrence rules are rejected.
+//   - .json, .yaml, .yml: a document with optional name and description and a
+//     list of holidays, each with a date (YYYY-MM-DD) and a name.
+//
+// A calendar's name defaults to the file name without its extension.
+package holiday
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/yourorg/timeservice/pkg/model"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrUnsupportedFormat is returned for files with an unknown extension
+var ErrUnsupportedFormat = errors.New("unsupported holiday file format")
+
+// document is the JSON and YAML holiday file format
+type document struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	Holidays    []struct {
+		Date string `json:"date" yaml:"date"`
+		Name string `json:"name" yaml:"name"`
+	} `json:"holidays" yaml:"holidays"`
+}
+
+// Supported reports whether path has a supported holiday file extension
+func Supported(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ics", ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// LoadFile loads and validates a holiday calendar from a data file
+func LoadFile(path string) (*model.HolidayCalendar, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	ext := strings.ToLower(filepath.Ext(path))
+	var cal *model.HolidayCalendar
+	switch ext {
+	case ".ics":
+		cal, err = ParseICS(f)
+	case ".json":
+		cal, err = parseDocument(f, json.Unmarshal)
+	case ".yaml", ".yml":
+		cal, err = parseDocument(f, yaml.Unmarshal)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if cal.Name == "" {
+		cal.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	cal.Source = filepath.Base(path)
+	cal.Normalize()
+	sort.SliceStable(cal.Holidays, func(i, j int) bool { return cal.Holidays[i].Date < cal.Holidays[j].Date })
+	if err := cal.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cal, nil
+}
+
+// LoadDir loads every supported holiday file in dir, in file name order.
+// A missing directory yields no calendars.
+func LoadDir(dir string) ([]*model.HolidayCalendar, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var calendars []*model.HolidayCalendar
+	for _, entry := range entries {
+		if entry.IsDir() || !Supported(entry.Name()) {
+			continue
+		}
+		cal, err := LoadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		calendars = append(calendars, cal)
+	}
+
+	return calendars, nil
+}
+
+// parseDocument parses a JSON or YAML holiday document with unmarshal
+func parseDocument(r io.Reader, unmarshal func([]byte, any) error) (*model.HolidayCalendar, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc document
+	if err := unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	cal := &model.HolidayCalendar{Name: doc.Name, Description: doc.Description}
+	for _, h := range doc.Holidays {
+		cal.Holidays = append(cal.Holidays, model.Holiday{Date: h.Date, Name: h.Name})
+	}
+	return cal, nil
+}
@@ -0,0 +1,139 @@
+package holiday
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+func TestLoadFile(t *testing.T) {
+	tests := []struct {
+		file        string
+		name        string
+		description string
+		holidays    []model.Holiday
+	}{
+		{
+			file:        "uk-bank.ics",
+			name:        "uk-bank",
+			description: "UK Bank Holidays",
+			holidays: []model.Holiday{
+				{Date: "2025-08-25", Name: "Summer bank holiday (England and Wales)"},
+				{Date: "2025-12-25", Name: "Christmas, Boxing Day"},
+				{Date: "2025-12-26", Name: "Christmas, Boxing Day"},
+			},
+		},
+		{
+			file:        "company.json",
+			name:        "company",
+			description: "Company shutdown days",
+			holidays: []model.Holiday{
+				{Date: "2025-07-18", Name: "Summer party"},
+				{Date: "2025-12-31", Name: "New Year's Eve shutdown"},
+			},
+		},
+		{
+			file:        "us-federal.yaml",
+			name:        "us-federal",
+			description: "US federal holidays",
+			holidays: []model.Holiday{
+				{Date: "2025-07-04", Name: "Independence Day"},
+				{Date: "2025-11-27", Name: "Thanksgiving Day"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			cal, err := LoadFile(filepath.Join("testdata", tt.file))
+			if err != nil {
+				t.Fatalf("LoadFile() error = %v", err)
+			}
+			if cal.Name != tt.name || cal.Description != tt.description || cal.Source != tt.file {
+				t.Errorf("got calendar %q (%q) from %q", cal.Name, cal.Description, cal.Source)
+			}
+			if len(cal.Holidays) != len(tt.holidays) {
+				t.Fatalf("got %d holidays, want %d: %+v", len(cal.Holidays), len(tt.holidays), cal.Holidays)
+			}
+			for i, want := range tt.holidays {
+				if cal.Holidays[i] != want {
+					t.Errorf("holiday %d = %+v, want %+v", i, cal.Holidays[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	calendars, err := LoadDir("testdata")
+	if err != nil {
+		t.Fatalf("LoadDir() error = %v", err)
+	}
+	var names []string
+	for _, cal := range calendars {
+		names = append(names, cal.Name)
+	}
+	if strings.Join(names, ",") != "company,uk-bank,us-federal" {
+		t.Errorf("LoadDir() names = %v", names)
+	}
+
+	calendars, err = LoadDir(filepath.Join("testdata", "missing"))
+	if err != nil || calendars != nil {
+		t.Errorf("LoadDir() on a missing directory = %v, %v", calendars, err)
+	}
+}
+
+func TestLoadFileErrors(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr error
+	}{
+		{
+			name:    "recurring event",
+			path:    write("rrule.ics", "BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250101\nRRULE:FREQ=YEARLY\nSUMMARY:New Year\nEND:VEVENT\n"),
+			wantErr: ErrUnsupportedRecurrence,
+		},
+		{
+			name:    "bad ICS date",
+			path:    write("bad.ics", "BEGIN:VEVENT\nDTSTART:2025-01-01\nSUMMARY:New Year\nEND:VEVENT\n"),
+			wantErr: ErrInvalidICSDate,
+		},
+		{
+			name:    "bad JSON date",
+			path:    write("bad.json", `{"holidays": [{"date": "01/01/2025", "name": "New Year"}]}`),
+			wantErr: model.ErrInvalidHolidayDate,
+		},
+		{
+			name:    "invalid calendar name",
+			path:    write("bad name.yaml", "holidays: []\n"),
+			wantErr: model.ErrInvalidCalendarName,
+		},
+		{
+			name:    "unsupported format",
+			path:    filepath.Join("testdata", "notes.txt"),
+			wantErr: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := LoadFile(tt.path); !errors.Is(err, tt.wantErr) {
+				t.Errorf("LoadFile() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package holiday
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/model"
+)
+
+// ICS parsing errors
+var (
+	ErrUnsupportedRecurrence = errors.New("recurring events are not supported; list each occurrence")
+	ErrInvalidICSDate        = errors.New("invalid DTSTART or DTEND date")
+	ErrMissingICSDate        = errors.New("event has no DTSTART")
+)
+
+// maxEventDays bounds the expansion of a single multi-day event
+const maxEventDays = 31
+
+// icsDateLayout is the layout of iCalendar DATE values
+const icsDateLayout = "20060102"
+
+// ParseICS parses an iCalendar stream into a holiday calendar.
+// X-WR-CALNAME becomes the description; the name is left for the caller.
+func ParseICS(r io.Reader) (*model.HolidayCalendar, error) {
+	cal := &model.HolidayCalendar{}
+
+	var event map[string]string
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+	for n, line := range lines {
+		name, value, ok := splitProperty(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			event = map[string]string{}
+		case name == "END" && value == "VEVENT":
+			if event == nil {
+				continue
+			}
+			holidays, err := eventHolidays(event)
+			if err != nil {
+				return nil, fmt.Errorf("event ending on line %d: %w", n+1, err)
+			}
+			cal.Holidays = append(cal.Holidays, holidays...)
+			event = nil
+		case event != nil:
+			event[name] = value
+		case name == "X-WR-CALNAME":
+			cal.Description = unescape(value)
+		}
+	}
+
+	return cal, nil
+}
+
+// eventHolidays converts a VEVENT's properties to one holiday per date it covers
+func eventHolidays(event map[string]string) ([]model.Holiday, error) {
+	if _, ok := event["RRULE"]; ok {
+		return nil, ErrUnsupportedRecurrence
+	}
+	if event["DTSTART"] == "" {
+		return nil, ErrMissingICSDate
+	}
+
+	start, err := parseICSDate(event["DTSTART"])
+	if err != nil {
+		return nil, err
+	}
+	end := start.AddDate(0, 0, 1)
+	if v := event["DTEND"]; v != "" {
+		if end, err = parseICSDate(v); err != nil {
+			return nil, err
+		}
+		// DTEND is exclusive, but a timed event ending later on its start day still covers it
+		if !end.After(start) {
+			end = start.AddDate(0, 0, 1)
+		}
+	}
+	if end.After(start.AddDate(0, 0, maxEventDays)) {
+		return nil, fmt.Errorf("%w: event spans more than %d days", ErrInvalidICSDate, maxEventDays)
+	}
+
+	name := unescape(event["SUMMARY"])
+	var holidays []model.Holiday
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		holidays = append(holidays, model.Holiday{Date: day.Format(model.HolidayDateLayout), Name: name})
+	}
+	return holidays, nil
+}
+
+// parseICSDate parses the date part of a DATE or DATE-TIME value
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < len(icsDateLayout) {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidICSDate, value)
+	}
+	t, err := time.Parse(icsDateLayout, value[:len(icsDateLayout)])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidICSDate, value)
+	}
+	return t, nil
+}
+
+// unfold reads content lines, joining continuation lines that start with a space or tab
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && line != "" && (line[0] == ' ' || line[0] == '\t') {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+// splitProperty splits a content line into its upper-case property name,
+// without parameters, and its value
+func splitProperty(line string) (string, string, bool) {
+	head, value, ok := strings.Cut(line, ":")
+	if !ok {
+		return "", "", false
+	}
+	name, _, _ := strings.Cut(head, ";")
+	return strings.ToUpper(strings.TrimSpace(name)), strings.TrimSpace(value), true
+}
+
+// unescape decodes iCalendar TEXT escapes
+func unescape(s string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
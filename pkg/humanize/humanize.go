@@ -0,0 +1,245 @@
+// Package humanize describes instants and offsets in words, such as "in 3
+// hours", "2 days ago", "tomorrow at 09:00 Tokyo time" or "9 hours ahead".
+package humanize
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// ErrInvalidUnit is returned for an unknown granularity unit
+var ErrInvalidUnit = errors.New("invalid unit")
+
+// Unit is a unit of a relative time, from Second up to Year
+type Unit int
+
+// Units, finest first
+const (
+	Second Unit = iota
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Year
+)
+
+// unitNames are the singular names of the units, indexed by Unit
+var unitNames = [...]string{"second", "minute", "hour", "day", "week", "month", "year"}
+
+// lessThan describes a period shorter than one unit
+var lessThan = [...]string{"a second", "a minute", "an hour", "a day", "a week", "a month", "a year"}
+
+// String returns the singular name of the unit
+func (u Unit) String() string {
+	if u < Second || u > Year {
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+	return unitNames[u]
+}
+
+// Units returns the names of the units, finest first
+func Units() []string {
+	return append([]string(nil), unitNames[:]...)
+}
+
+// ParseUnit parses a unit name, singular or plural, case-insensitively
+func ParseUnit(s string) (Unit, error) {
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "s")
+	for u, n := range unitNames {
+		if n == name {
+			return Unit(u), nil
+		}
+	}
+	return 0, fmt.Errorf("%w %q: must be one of %s", ErrInvalidUnit, s, strings.Join(unitNames[:], ", "))
+}
+
+// Options control how Relative describes an instant
+type Options struct {
+	// Granularity is the finest unit shown; the zero value is Second
+	Granularity Unit
+	// Precision is the most units shown, e.g. 2 for "in 1 day and 3 hours";
+	// values below 1 mean 1
+	Precision int
+	// Calendar describes instants on the day before, of or after the
+	// reference as "yesterday", "today" or "tomorrow" at a wall-clock time,
+	// unless they are less than an hour away
+	Calendar bool
+	// Label follows calendar descriptions, e.g. "Tokyo time"
+	Label string
+}
+
+// Relative describes t relative to ref. Units follow the calendar in t's
+// location, so a month is a calendar month there, and the last unit shown is
+// rounded half up.
+func Relative(t, ref time.Time, opts Options) string {
+	if opts.Calendar {
+		if s, ok := calendar(t, ref, opts.Label); ok {
+			return s
+		}
+	}
+
+	d := t.Sub(ref)
+	parts := amounts(t, ref, opts)
+	switch {
+	case len(parts) > 0 && d > 0:
+		return "in " + join(parts)
+	case len(parts) > 0:
+		return join(parts) + " ago"
+	case d == 0 || opts.Granularity == Second:
+		return "now"
+	case d > 0:
+		return "in less than " + lessThan[opts.Granularity]
+	}
+	return "less than " + lessThan[opts.Granularity] + " ago"
+}
+
+// Offset describes a difference between UTC offsets in seconds, e.g. "5 hours
+// and 30 minutes ahead"
+func Offset(seconds int) string {
+	if seconds == 0 {
+		return "same time"
+	}
+	direction := "ahead"
+	if seconds < 0 {
+		direction = "behind"
+		seconds = -seconds
+	}
+	var parts []string
+	if h := seconds / 3600; h > 0 {
+		parts = append(parts, plural(h, Hour))
+	}
+	if m := seconds % 3600 / 60; m > 0 {
+		parts = append(parts, plural(m, Minute))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, plural(seconds, Second))
+	}
+	return join(parts) + " " + direction
+}
+
+// ZoneLabel names a timezone for calendar descriptions, e.g. "Tokyo time" for
+// Asia/Tokyo or "UTC"
+func ZoneLabel(loc *time.Location) string {
+	name := loc.String()
+	if name == "UTC" || name == "Etc/UTC" {
+		return "UTC"
+	}
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ReplaceAll(name, "_", " ") + " time"
+}
+
+// amounts returns the units describing the distance from ref to t, largest
+// first, or nil if it is less than one unit of the granularity
+func amounts(t, ref time.Time, opts Options) []string {
+	precision := max(opts.Precision, 1)
+
+	// Round half up in the last unit shown, then describe the rounded instant
+	sign := 1
+	if t.Before(ref) {
+		sign = -1
+	}
+	_, last := span(t, ref, opts.Granularity, precision)
+	values, last := span(half(t, last, sign), ref, opts.Granularity, precision)
+
+	var parts []string
+	for u := Year; u >= last; u-- {
+		if values[u] > 0 {
+			parts = append(parts, plural(values[u], u))
+		}
+	}
+	return parts
+}
+
+// span returns the whole units between ref and t and the finest unit shown
+// when the description starts at the largest non-zero unit
+func span(t, ref time.Time, granularity Unit, precision int) ([Year + 1]int, Unit) {
+	p := timecalc.Between(ref, t, t.Location())
+	values := [Year + 1]int{
+		Second: p.Seconds,
+		Minute: p.Minutes,
+		Hour:   p.Hours,
+		Day:    p.Days % 7,
+		Week:   p.Days / 7,
+		Month:  p.Months,
+		Year:   p.Years,
+	}
+
+	lead := granularity
+	for u := Year; u > granularity; u-- {
+		if values[u] > 0 {
+			lead = u
+			break
+		}
+	}
+	return values, max(lead-Unit(precision-1), granularity)
+}
+
+// half moves t half of unit u in direction sign
+func half(t time.Time, u Unit, sign int) time.Time {
+	switch u {
+	case Year:
+		return t.AddDate(0, 6*sign, 0)
+	case Month:
+		return t.AddDate(0, 0, 15*sign)
+	case Week:
+		return t.Add(time.Duration(sign) * 84 * time.Hour)
+	case Day:
+		return t.Add(time.Duration(sign) * 12 * time.Hour)
+	case Hour:
+		return t.Add(time.Duration(sign) * 30 * time.Minute)
+	case Minute:
+		return t.Add(time.Duration(sign) * 30 * time.Second)
+	}
+	return t.Add(time.Duration(sign) * 500 * time.Millisecond)
+}
+
+// calendar describes t as yesterday, today or tomorrow at a wall-clock time
+// in t's location
+func calendar(t, ref time.Time, label string) (string, bool) {
+	if d := t.Sub(ref); d > -time.Hour && d < time.Hour {
+		return "", false
+	}
+	r := ref.In(t.Location())
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	refDay := time.Date(r.Year(), r.Month(), r.Day(), 0, 0, 0, 0, time.UTC)
+
+	var word string
+	switch day.Sub(refDay) / (24 * time.Hour) {
+	case -1:
+		word = "yesterday"
+	case 0:
+		word = "today"
+	case 1:
+		word = "tomorrow"
+	default:
+		return "", false
+	}
+	s := word + " at " + t.Format("15:04")
+	if label != "" {
+		s += " " + label
+	}
+	return s, true
+}
+
+// plural formats n units, e.g. "1 day" or "3 days"
+func plural(n int, u Unit) string {
+	if n == 1 {
+		return "1 " + u.String()
+	}
+	return fmt.Sprintf("%d %ss", n, u)
+}
+
+// join joins parts as "a", "a and b" or "a, b and c"
+func join(parts []string) string {
+	if len(parts) < 2 {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
@@ -0,0 +1,95 @@
+package humanize
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRelative(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	ref := time.Date(2025, 6, 10, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		opts Options
+		want string
+	}{
+		{"now", ref, Options{}, "now"},
+		{"seconds ahead", ref.Add(45 * time.Second), Options{}, "in 45 seconds"},
+		{"hours ahead", ref.Add(3 * time.Hour), Options{}, "in 3 hours"},
+		{"days ago", ref.AddDate(0, 0, -2), Options{}, "2 days ago"},
+		{"rounds half up", ref.Add(2*time.Hour + 40*time.Minute), Options{}, "in 3 hours"},
+		{"rounding carries into the next unit", ref.Add(-59*time.Minute - 45*time.Second), Options{}, "1 hour ago"},
+		{"weeks", ref.AddDate(0, 0, 15), Options{}, "in 2 weeks"},
+		{"calendar months", ref.AddDate(0, -3, 0), Options{}, "3 months ago"},
+		{"years", ref.AddDate(1, 8, 0), Options{}, "in 2 years"},
+		{"precision", ref.Add(27*time.Hour + 20*time.Minute), Options{Precision: 2}, "in 1 day and 3 hours"},
+		{"precision three", ref.Add(-(26*time.Hour + 5*time.Minute + 7*time.Second)), Options{Precision: 3}, "1 day, 2 hours and 5 minutes ago"},
+		{"precision skips zero units", ref.Add(24*time.Hour + 10*time.Minute), Options{Precision: 3}, "in 1 day and 10 minutes"},
+		{"granularity", ref.Add(3*time.Hour + 20*time.Minute + 10*time.Second), Options{Granularity: Minute, Precision: 3}, "in 3 hours and 20 minutes"},
+		{"below granularity", ref.Add(5 * time.Hour), Options{Granularity: Day}, "in less than a day"},
+		{"below granularity in the past", ref.Add(-20 * time.Second), Options{Granularity: Minute}, "less than a minute ago"},
+		{"calendar tomorrow", time.Date(2025, 6, 11, 9, 0, 0, 0, tokyo), Options{Calendar: true, Label: "Tokyo time"}, "tomorrow at 09:00 Tokyo time"},
+		{"calendar today", time.Date(2025, 6, 10, 20, 0, 0, 0, time.UTC), Options{Calendar: true}, "today at 20:00"},
+		{"calendar yesterday", time.Date(2025, 6, 9, 23, 0, 0, 0, time.UTC), Options{Calendar: true}, "yesterday at 23:00"},
+		{"calendar within the hour", ref.Add(10 * time.Minute), Options{Calendar: true}, "in 10 minutes"},
+		{"calendar beyond tomorrow", ref.AddDate(0, 0, 3), Options{Calendar: true}, "in 3 days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Relative(tt.t, ref, tt.opts); got != tt.want {
+				t.Errorf("Relative() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOffset(t *testing.T) {
+	tests := map[int]string{
+		0:      "same time",
+		32400:  "9 hours ahead",
+		-19800: "5 hours and 30 minutes behind",
+		2700:   "45 minutes ahead",
+		-3600:  "1 hour behind",
+	}
+	for seconds, want := range tests {
+		if got := Offset(seconds); got != want {
+			t.Errorf("Offset(%d) = %q, want %q", seconds, got, want)
+		}
+	}
+}
+
+func TestZoneLabel(t *testing.T) {
+	tests := map[string]string{
+		"America/New_York":               "New York time",
+		"America/Argentina/Buenos_Aires": "Buenos Aires time",
+		"UTC":                            "UTC",
+	}
+	for name, want := range tests {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatalf("failed to load timezone: %v", err)
+		}
+		if got := ZoneLabel(loc); got != want {
+			t.Errorf("ZoneLabel(%s) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for s, want := range map[string]Unit{"second": Second, "Hours": Hour, " week ": Week, "years": Year} {
+		got, err := ParseUnit(s)
+		if err != nil || got != want {
+			t.Errorf("ParseUnit(%q) = %v, %v, want %v", s, got, err, want)
+		}
+	}
+	if _, err := ParseUnit("fortnight"); !errors.Is(err, ErrInvalidUnit) {
+		t.Errorf("expected ErrInvalidUnit, got %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package model
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// Time arithmetic validation errors
+var (
+	ErrDurationWithUnits = errors.New("duration cannot be combined with individual units")
+	ErrOffsetOutOfRange  = errors.New("offset is out of range")
+)
+
+// Offset bounds: elapsed-time units must fit in a time.Duration and
+// calendar units are capped at roughly ten thousand years
+const (
+	maxOffsetHours = 100000 * 24
+	maxOffsetDays  = 10000 * 366
+)
+
+// AddTimeRequest represents a request to add calendar and clock units to an instant.
+// Years, months, weeks and days are calendar units applied on the wall clock of
+// the timezone; hours, minutes and seconds are elapsed time.
+type AddTimeRequest struct {
+	Base     string  `json:"base,omitempty"`
+	Timezone string  `json:"timezone,omitempty"`
+	Years    int     `json:"years,omitempty"`
+	Months   int     `json:"months,omitempty"`
+	Weeks    int     `json:"weeks,omitempty"`
+	Days     int     `json:"days,omitempty"`
+	Hours    float64 `json:"hours,omitempty"`
+	Minutes  float64 `json:"minutes,omitempty"`
+	Seconds  float64 `json:"seconds,omitempty"`
+	Duration string  `json:"duration,omitempty"`
+	Overflow string  `json:"overflow,omitempty"`
+}
+
+// AddTimeResponse represents the result of time arithmetic
+type AddTimeResponse struct {
+	Base           *ZonedTime `json:"base"`
+	Result         *ZonedTime `json:"result"`
+	Overflow       string     `json:"overflow"`
+	ElapsedSeconds float64    `json:"elapsed_seconds"`
+	Elapsed        string     `json:"elapsed"`
+	ElapsedISO8601 string     `json:"elapsed_iso8601"`
+}
+
+// Normalize normalizes the fields of an AddTimeRequest
+func (r *AddTimeRequest) Normalize() {
+	r.Base = strings.TrimSpace(r.Base)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.Duration = strings.TrimSpace(r.Duration)
+	r.Overflow = strings.ToLower(strings.TrimSpace(r.Overflow))
+
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.Overflow == "" {
+		r.Overflow = string(timecalc.OverflowClamp)
+	}
+}
+
+// Validate validates an AddTimeRequest
+func (r *AddTimeRequest) Validate() error {
+	if _, err := timecalc.ParseOverflow(r.Overflow); err != nil {
+		return err
+	}
+
+	if r.Duration != "" {
+		if r.hasUnits() {
+			return ErrDurationWithUnits
+		}
+		p, err := timecalc.ParseISO8601(r.Duration)
+		if err != nil {
+			return err
+		}
+		hours := math.Abs(float64(p.Hours)) + math.Abs(float64(p.Minutes))/60 +
+			(math.Abs(float64(p.Seconds))+math.Abs(float64(p.Nanoseconds))/1e9)/3600
+		return checkOffset(p.Years, p.Months, p.Weeks, p.Days, hours)
+	}
+
+	hours := math.Abs(r.Hours) + math.Abs(r.Minutes)/60 + math.Abs(r.Seconds)/3600
+	return checkOffset(r.Years, r.Months, r.Weeks, r.Days, hours)
+}
+
+// checkOffset checks calendar units and elapsed hours against the offset bounds
+func checkOffset(years, months, weeks, days int, hours float64) error {
+	if hours > maxOffsetHours || math.IsNaN(hours) {
+		return ErrOffsetOutOfRange
+	}
+	total := math.Abs(float64(years))*366 + math.Abs(float64(months))*31 +
+		math.Abs(float64(weeks))*7 + math.Abs(float64(days))
+	if total > maxOffsetDays {
+		return ErrOffsetOutOfRange
+	}
+	return nil
+}
+
+// Offset returns the calendar period and the elapsed-time duration to apply.
+// It must only be called after Validate succeeds.
+func (r *AddTimeRequest) Offset() (timecalc.Period, time.Duration) {
+	if r.Duration != "" {
+		p, _ := timecalc.ParseISO8601(r.Duration)
+		return p, 0
+	}
+
+	period := timecalc.Period{
+		Years:  r.Years,
+		Months: r.Months,
+		Weeks:  r.Weeks,
+		Days:   r.Days,
+	}
+	// Multiply in float64 space before converting to Duration to handle fractional values
+	exact := time.Duration(r.Hours*float64(time.Hour)) +
+		time.Duration(r.Minutes*float64(time.Minute)) +
+		time.Duration(r.Seconds*float64(time.Second))
+
+	return period, exact
+}
+
+// Apply adds the requested offset to base on the wall clock of loc
+func (r *AddTimeRequest) Apply(base time.Time, loc *time.Location) time.Time {
+	overflow, _ := timecalc.ParseOverflow(r.Overflow)
+	period, exact := r.Offset()
+	return timecalc.Add(base, period, loc, overflow).Add(exact)
+}
+
+// NewAddTimeResponse creates an AddTimeResponse for base and result.
+// location is the saved location name the timezone came from, if any.
+func NewAddTimeResponse(base, result time.Time, location, overflow string) *AddTimeResponse {
+	elapsed := result.Sub(base)
+	return &AddTimeResponse{
+		Base:           NewZonedTime(base, location),
+		Result:         NewZonedTime(result, location),
+		Overflow:       overflow,
+		ElapsedSeconds: elapsed.Seconds(),
+		Elapsed:        elapsed.String(),
+		ElapsedISO8601: timecalc.FormatDuration(elapsed),
+	}
+}
+
+// hasUnits reports whether any individual unit is set
+func (r *AddTimeRequest) hasUnits() bool {
+	return r.Years != 0 || r.Months != 0 || r.Weeks != 0 || r.Days != 0 ||
+		r.Hours != 0 || r.Minutes != 0 || r.Seconds != 0
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+func TestAddTimeRequestNormalize(t *testing.T) {
+	req := AddTimeRequest{Base: " now ", Duration: " P1D ", Overflow: " Rollover "}
+	req.Normalize()
+
+	want := AddTimeRequest{Base: "now", Timezone: "UTC", Duration: "P1D", Overflow: "rollover"}
+	if req != want {
+		t.Errorf("Normalize() = %+v, want %+v", req, want)
+	}
+
+	empty := AddTimeRequest{}
+	empty.Normalize()
+	if empty.Overflow != "clamp" {
+		t.Errorf("expected default overflow clamp, got %s", empty.Overflow)
+	}
+}
+
+func TestAddTimeRequestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       AddTimeRequest
+		wantError error
+	}{
+		{"valid units", AddTimeRequest{Months: 1, Hours: 1.5, Overflow: "clamp"}, nil},
+		{"valid duration", AddTimeRequest{Duration: "-P1Y2M", Overflow: "clamp"}, nil},
+		{"duration with units", AddTimeRequest{Duration: "P1D", Days: 1, Overflow: "clamp"}, ErrDurationWithUnits},
+		{"invalid duration", AddTimeRequest{Duration: "1 day", Overflow: "clamp"}, timecalc.ErrInvalidISO8601},
+		{"invalid overflow", AddTimeRequest{Overflow: "wrap"}, timecalc.ErrInvalidOverflow},
+		{"hours out of range", AddTimeRequest{Hours: 1e12, Overflow: "clamp"}, ErrOffsetOutOfRange},
+		{"years out of range", AddTimeRequest{Years: 1000000, Overflow: "clamp"}, ErrOffsetOutOfRange},
+		{"duration hours out of range", AddTimeRequest{Duration: "PT2147483647H", Overflow: "clamp"}, ErrOffsetOutOfRange},
+		{"duration minutes out of range", AddTimeRequest{Duration: "-PT999999999M", Overflow: "clamp"}, ErrOffsetOutOfRange},
+		{"duration years out of range", AddTimeRequest{Duration: "P20000Y", Overflow: "clamp"}, ErrOffsetOutOfRange},
+		{"duration at the hour bound", AddTimeRequest{Duration: "PT2400000H", Overflow: "clamp"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(); !errors.Is(err, tt.wantError) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestAddTimeRequestApply(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	base := time.Date(2025, 3, 8, 12, 0, 0, 0, ny)
+
+	req := AddTimeRequest{Days: 1, Minutes: 30, Overflow: "clamp"}
+	got := req.Apply(base, ny)
+	want := time.Date(2025, 3, 9, 12, 30, 0, 0, ny)
+	if !got.Equal(want) {
+		t.Errorf("Apply() = %v, want %v", got, want)
+	}
+
+	resp := NewAddTimeResponse(base, got, "hq", req.Overflow)
+	if resp.ElapsedISO8601 != "PT23H30M" {
+		t.Errorf("expected PT23H30M elapsed, got %s", resp.ElapsedISO8601)
+	}
+	if resp.Base.Location != "hq" || resp.Result.Offset != "-04:00" {
+		t.Errorf("unexpected zoned times: base=%+v result=%+v", resp.Base, resp.Result)
+	}
+}
@@ -0,0 +1,191 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// Bucketing modes
+const (
+	BucketFloor = "floor"
+	BucketCeil  = "ceil"
+	BucketRound = "round"
+)
+
+// MaxBuckets is the most bucket boundaries listed for a range
+const MaxBuckets = 1000
+
+// Bucketing validation errors
+var (
+	ErrEmptyBucketUnit   = errors.New("unit is required")
+	ErrInvalidBucketMode = errors.New("mode must be one of floor, ceil or round")
+	ErrBucketRange       = errors.New("start and end must be given together")
+	ErrBucketOrder       = errors.New("end must be after start")
+)
+
+// BucketRequest represents a request to align an instant to a bucket of local
+// time, list the buckets in a range, or both. Time defaults to now unless a
+// range is given. Times without a UTC offset are read on the wall clock of
+// Timezone, which may be an IANA timezone or a saved location.
+type BucketRequest struct {
+	Time     string `json:"time,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+	Unit     string `json:"unit"`
+	Size     int    `json:"size,omitempty"`
+	Mode     string `json:"mode,omitempty"`
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+}
+
+// BucketSpan represents one bucket. Its duration is elapsed time, so a day
+// bucket may last 23 or 25 hours.
+type BucketSpan struct {
+	Start           string  `json:"start"`
+	End             string  `json:"end"`
+	LocalStart      string  `json:"local_start"`
+	DurationSeconds float64 `json:"duration_seconds"`
+	Duration        string  `json:"duration"`
+}
+
+// BucketResponse represents an instant aligned to a bucket and the buckets in
+// a range. Result is Time moved to the start of its bucket for floor, to the
+// next boundary for ceil, or to the nearer of the two for round.
+type BucketResponse struct {
+	Location  string        `json:"location,omitempty"`
+	Timezone  string        `json:"timezone"`
+	Bucket    string        `json:"bucket"`
+	Mode      string        `json:"mode,omitempty"`
+	Time      *ZonedTime    `json:"time,omitempty"`
+	Result    *ZonedTime    `json:"result,omitempty"`
+	Current   *BucketSpan   `json:"current,omitempty"`
+	Start     string        `json:"start,omitempty"`
+	End       string        `json:"end,omitempty"`
+	Count     int           `json:"count,omitempty"`
+	Truncated bool          `json:"truncated,omitempty"`
+	Buckets   []*BucketSpan `json:"buckets,omitempty"`
+}
+
+// Normalize normalizes the fields of a BucketRequest
+func (r *BucketRequest) Normalize() {
+	r.Time = strings.TrimSpace(r.Time)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.Unit = strings.TrimSpace(r.Unit)
+	r.Mode = strings.ToLower(strings.TrimSpace(r.Mode))
+	r.Start = strings.TrimSpace(r.Start)
+	r.End = strings.TrimSpace(r.End)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.Mode == "" {
+		r.Mode = BucketFloor
+	}
+}
+
+// Validate validates a BucketRequest
+func (r *BucketRequest) Validate() error {
+	if r.Unit == "" {
+		return ErrEmptyBucketUnit
+	}
+	if _, err := timecalc.ParseBucket(r.Unit, r.Size); err != nil {
+		return err
+	}
+	switch r.Mode {
+	case BucketFloor, BucketCeil, BucketRound:
+	default:
+		return ErrInvalidBucketMode
+	}
+	if (r.Start == "") != (r.End == "") {
+		return ErrBucketRange
+	}
+	return nil
+}
+
+// Bucket returns the bucket described by the request.
+// It must only be called after Validate succeeds.
+func (r *BucketRequest) Bucket() timecalc.Bucket {
+	bucket, _ := timecalc.ParseBucket(r.Unit, r.Size)
+	return bucket
+}
+
+// HasTime reports whether the response should align an instant, which it
+// does when a time is given or no range is
+func (r *BucketRequest) HasTime() bool {
+	return r.Time != "" || r.Start == ""
+}
+
+// Range parses the start and end of the request on the wall clock of loc.
+// It must only be called after Validate succeeds, and when Start is set.
+func (r *BucketRequest) Range(loc *time.Location, now time.Time) (time.Time, time.Time, error) {
+	start, err := timeparse.Parse(r.Start, loc, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start: %w", err)
+	}
+	end, err := timeparse.Parse(r.End, loc, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid end: %w", err)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, ErrBucketOrder
+	}
+	return start, end, nil
+}
+
+// NewBucketResponse creates a BucketResponse in loc, aligning t when it is
+// not nil and listing the buckets in [start, end) when end is after start.
+// location is the saved location name the timezone came from, if any.
+func NewBucketResponse(req *BucketRequest, loc *time.Location, location string, t *time.Time, start, end time.Time) *BucketResponse {
+	bucket := req.Bucket()
+	response := &BucketResponse{
+		Location: location,
+		Timezone: loc.String(),
+		Bucket:   bucket.String(),
+	}
+
+	if t != nil {
+		floor := bucket.Floor(*t, loc)
+		var result time.Time
+		switch req.Mode {
+		case BucketCeil:
+			result = bucket.Ceil(*t, loc)
+		case BucketRound:
+			result = bucket.Round(*t, loc)
+		default:
+			result = floor
+		}
+		response.Mode = req.Mode
+		response.Time = NewZonedTime(t.In(loc), location)
+		response.Result = NewZonedTime(result.In(loc), location)
+		response.Current = newBucketSpan(floor, bucket.Next(*t, loc), loc)
+	}
+
+	if end.After(start) {
+		boundaries, truncated := bucket.Boundaries(loc, start, end, MaxBuckets)
+		response.Start = start.In(loc).Format(time.RFC3339)
+		response.End = end.In(loc).Format(time.RFC3339)
+		response.Count = len(boundaries)
+		response.Truncated = truncated
+		response.Buckets = make([]*BucketSpan, len(boundaries))
+		for i, b := range boundaries {
+			response.Buckets[i] = newBucketSpan(b, bucket.Next(b, loc), loc)
+		}
+	}
+
+	return response
+}
+
+// newBucketSpan creates a BucketSpan for [start, end) in loc
+func newBucketSpan(start, end time.Time, loc *time.Location) *BucketSpan {
+	length := end.Sub(start)
+	return &BucketSpan{
+		Start:           start.In(loc).Format(time.RFC3339),
+		End:             end.In(loc).Format(time.RFC3339),
+		LocalStart:      start.In(loc).Format("2006-01-02 15:04"),
+		DurationSeconds: length.Seconds(),
+		Duration:        length.String(),
+	}
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+func TestBucketRequestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  BucketRequest
+		want error
+	}{
+		{"valid", BucketRequest{Unit: "minute", Size: 15, Mode: "Round"}, nil},
+		{"missing unit", BucketRequest{}, ErrEmptyBucketUnit},
+		{"unknown unit", BucketRequest{Unit: "fortnight"}, timecalc.ErrInvalidBucketUnit},
+		{"invalid size", BucketRequest{Unit: "hour", Size: 5}, timecalc.ErrInvalidBucketSize},
+		{"unknown mode", BucketRequest{Unit: "day", Mode: "truncate"}, ErrInvalidBucketMode},
+		{"start without end", BucketRequest{Unit: "day", Start: "2025-01-01"}, ErrBucketRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewBucketResponse(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	req := BucketRequest{Unit: "day", Mode: "ceil", Start: "2025-11-01", End: "2025-11-04"}
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		t.Fatalf("Validate() unexpected error: %v", err)
+	}
+	start, end, err := req.Range(ny, time.Now())
+	if err != nil {
+		t.Fatalf("Range() unexpected error: %v", err)
+	}
+	at := time.Date(2025, 11, 2, 15, 0, 0, 0, ny)
+	resp := NewBucketResponse(&req, ny, "hq", &at, start, end)
+
+	if resp.Bucket != "day" || resp.Location != "hq" || resp.Mode != "ceil" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Result.Time != "2025-11-03T00:00:00-05:00" {
+		t.Errorf("ceil = %s, want next midnight", resp.Result.Time)
+	}
+	if resp.Current.Start != "2025-11-02T00:00:00-04:00" || resp.Current.DurationSeconds != 25*3600 {
+		t.Errorf("unexpected current bucket: %+v", resp.Current)
+	}
+
+	if resp.Count != 3 || resp.Truncated {
+		t.Fatalf("expected 3 buckets, got %d", resp.Count)
+	}
+	wantHours := []float64{24, 25, 24}
+	for i, b := range resp.Buckets {
+		if b.DurationSeconds != wantHours[i]*3600 {
+			t.Errorf("bucket %d (%s) lasts %s, want %vh", i, b.LocalStart, b.Duration, wantHours[i])
+		}
+	}
+
+	// Without a time, only the range is listed
+	req = BucketRequest{Unit: "hour", Start: "2025-03-09", End: "2025-03-10", Timezone: "hq"}
+	req.Normalize()
+	start, end, _ = req.Range(ny, time.Now())
+	if req.HasTime() {
+		t.Fatal("HasTime() = true for a range without a time")
+	}
+	resp = NewBucketResponse(&req, ny, "", nil, start, end)
+	if resp.Result != nil || resp.Count != 23 || resp.Buckets[2].LocalStart != "2025-03-09 03:00" {
+		t.Errorf("expected 23 hourly buckets skipping 02:00, got %d: %+v", resp.Count, resp.Buckets[2])
+	}
+}
+
+func TestBucketRequestRange(t *testing.T) {
+	req := BucketRequest{Unit: "day", Start: "2025-01-02", End: "2025-01-01"}
+	if _, _, err := req.Range(time.UTC, time.Now()); err != ErrBucketOrder {
+		t.Errorf("Range() = %v, want %v", err, ErrBucketOrder)
+	}
+	req.End = "tomorrow"
+	if _, _, err := req.Range(time.UTC, time.Now()); err == nil {
+		t.Error("expected an invalid end error")
+	}
+}
@@ -0,0 +1,217 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/schedule"
+)
+
+// Business day limits
+const (
+	MaxBusinessDays          = 2500
+	MaxBusinessDayRangeYears = 10
+)
+
+// DefaultWeekend is the weekend of locations that do not define one
+var DefaultWeekend = []string{"saturday", "sunday"}
+
+// Business day validation errors
+var (
+	ErrInvalidWeekendDay     = errors.New("weekend days must be weekday names such as saturday")
+	ErrDuplicateWeekendDay   = errors.New("weekend days must be unique")
+	ErrNoBusinessDays        = errors.New("weekend must leave at least one business day")
+	ErrBusinessDaysRange     = fmt.Errorf("days must be between -%d and %d", MaxBusinessDays, MaxBusinessDays)
+	ErrEmptyBusinessDayEnd   = errors.New("to is required")
+	ErrBusinessDayRangeLimit = fmt.Errorf("from and to must be at most %d years apart", MaxBusinessDayRangeYears)
+)
+
+// BusinessDaysAddRequest represents a request to move a date by a number of business days
+type BusinessDaysAddRequest struct {
+	Location string `json:"location"`
+	Start    string `json:"start,omitempty"`
+	Days     int    `json:"days"`
+}
+
+// BusinessDaysCountRequest represents a request to count the business days between two dates
+type BusinessDaysCountRequest struct {
+	Location string `json:"location"`
+	From     string `json:"from,omitempty"`
+	To       string `json:"to"`
+}
+
+// BusinessDaysAddResponse represents the result of business-day arithmetic
+type BusinessDaysAddResponse struct {
+	Location        string     `json:"location"`
+	Timezone        string     `json:"timezone"`
+	Weekend         []string   `json:"weekend"`
+	Days            int        `json:"days"`
+	Start           *ZonedTime `json:"start"`
+	Result          *ZonedTime `json:"result"`
+	ResultDate      string     `json:"result_date"`
+	Weekday         string     `json:"weekday"`
+	CalendarDays    int        `json:"calendar_days"`
+	SkippedHolidays []*Holiday `json:"skipped_holidays"`
+}
+
+// BusinessDaysCountResponse represents the business days between two dates
+type BusinessDaysCountResponse struct {
+	Location     string     `json:"location"`
+	Timezone     string     `json:"timezone"`
+	Weekend      []string   `json:"weekend"`
+	From         *ZonedTime `json:"from"`
+	To           *ZonedTime `json:"to"`
+	BusinessDays int        `json:"business_days"`
+	CalendarDays int        `json:"calendar_days"`
+	WeekendDays  int        `json:"weekend_days"`
+	Holidays     []*Holiday `json:"holidays"`
+}
+
+// NormalizeWeekend lower-cases and trims weekend day names, keeping nil as nil
+func NormalizeWeekend(days []string) []string {
+	if days == nil {
+		return nil
+	}
+	normalized := make([]string, len(days))
+	for i, day := range days {
+		normalized[i] = strings.ToLower(strings.TrimSpace(day))
+	}
+	return normalized
+}
+
+// ValidateWeekend validates an optional list of weekend day names
+func ValidateWeekend(days []string) error {
+	seen := make(map[string]bool)
+	for _, day := range days {
+		if _, ok := weekdays[day]; !ok {
+			return fmt.Errorf("%w: %s", ErrInvalidWeekendDay, day)
+		}
+		if seen[day] {
+			return fmt.Errorf("%w: %s", ErrDuplicateWeekendDay, day)
+		}
+		seen[day] = true
+	}
+	if len(seen) == len(weekdays) {
+		return ErrNoBusinessDays
+	}
+	return nil
+}
+
+// EffectiveWeekend returns the location's weekend, or DefaultWeekend when it has none
+func (l *Location) EffectiveWeekend() []string {
+	if l.Weekend == nil {
+		return append([]string(nil), DefaultWeekend...)
+	}
+	return l.Weekend
+}
+
+// BusinessDays returns the location's business-day calendar with the given holidays
+func (l *Location) BusinessDays(holidays []*Holiday) schedule.BusinessDays {
+	b := schedule.BusinessDays{Holidays: make(map[string]bool, len(holidays))}
+	for _, day := range l.EffectiveWeekend() {
+		b.Weekend[weekdays[day]] = true
+	}
+	for _, h := range holidays {
+		b.Holidays[h.Date] = true
+	}
+	return b
+}
+
+// Normalize normalizes the fields of a BusinessDaysAddRequest
+func (r *BusinessDaysAddRequest) Normalize() {
+	r.Location = strings.TrimSpace(r.Location)
+	r.Start = strings.TrimSpace(r.Start)
+}
+
+// Validate validates a BusinessDaysAddRequest
+func (r *BusinessDaysAddRequest) Validate() error {
+	if err := ValidateName(r.Location); err != nil {
+		return err
+	}
+	if r.Days < -MaxBusinessDays || r.Days > MaxBusinessDays {
+		return ErrBusinessDaysRange
+	}
+	return nil
+}
+
+// Normalize normalizes the fields of a BusinessDaysCountRequest
+func (r *BusinessDaysCountRequest) Normalize() {
+	r.Location = strings.TrimSpace(r.Location)
+	r.From = strings.TrimSpace(r.From)
+	r.To = strings.TrimSpace(r.To)
+}
+
+// Validate validates a BusinessDaysCountRequest
+func (r *BusinessDaysCountRequest) Validate() error {
+	if err := ValidateName(r.Location); err != nil {
+		return err
+	}
+	if r.To == "" {
+		return ErrEmptyBusinessDayEnd
+	}
+	return nil
+}
+
+// NewBusinessDaysAddResponse creates a BusinessDaysAddResponse. holidays may
+// include dates outside the span between start and result.
+func NewBusinessDaysAddResponse(loc *Location, days int, start, result time.Time, holidays []*Holiday) *BusinessDaysAddResponse {
+	return &BusinessDaysAddResponse{
+		Location:        loc.Name,
+		Timezone:        loc.Timezone,
+		Weekend:         loc.EffectiveWeekend(),
+		Days:            days,
+		Start:           NewZonedTime(start, loc.Name),
+		Result:          NewZonedTime(result, loc.Name),
+		ResultDate:      result.Format(HolidayDateLayout),
+		Weekday:         result.Weekday().String(),
+		CalendarDays:    calendarDays(start, result),
+		SkippedHolidays: holidaysBetween(loc.BusinessDays(nil), start, result, holidays),
+	}
+}
+
+// NewBusinessDaysCountResponse creates a BusinessDaysCountResponse. holidays may
+// include dates outside the span between from and to.
+func NewBusinessDaysCountResponse(loc *Location, from, to time.Time, holidays []*Holiday) *BusinessDaysCountResponse {
+	weekendOnly := loc.BusinessDays(nil)
+	return &BusinessDaysCountResponse{
+		Location:     loc.Name,
+		Timezone:     loc.Timezone,
+		Weekend:      loc.EffectiveWeekend(),
+		From:         NewZonedTime(from, loc.Name),
+		To:           NewZonedTime(to, loc.Name),
+		BusinessDays: loc.BusinessDays(holidays).Count(from, to),
+		CalendarDays: calendarDays(from, to),
+		WeekendDays:  calendarDays(from, to) - weekendOnly.Count(from, to),
+		Holidays:     holidaysBetween(weekendOnly, from, to, holidays),
+	}
+}
+
+// calendarDays returns the signed number of dates from from's date to to's date
+func calendarDays(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+// holidaysBetween returns the holidays on working weekdays among the dates
+// counted by schedule.BusinessDays.Count(from, to), in the given order
+func holidaysBetween(weekendOnly schedule.BusinessDays, from, to time.Time, holidays []*Holiday) []*Holiday {
+	first, last := from.Format(HolidayDateLayout), to.Format(HolidayDateLayout)
+	counted := func(date string) bool { return date > first && date <= last }
+	if last < first {
+		counted = func(date string) bool { return date >= last && date < first }
+	}
+
+	between := []*Holiday{}
+	for _, h := range holidays {
+		if !counted(h.Date) {
+			continue
+		}
+		if date, err := time.Parse(HolidayDateLayout, h.Date); err == nil && weekendOnly.IsBusinessDay(date) {
+			between = append(between, h)
+		}
+	}
+	return between
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateWeekend(t *testing.T) {
+	tests := []struct {
+		name    string
+		days    []string
+		wantErr error
+	}{
+		{name: "default", days: nil},
+		{name: "no weekend", days: []string{}},
+		{name: "friday and saturday", days: []string{" Friday", "SATURDAY "}},
+		{name: "unknown day", days: []string{"fri"}, wantErr: ErrInvalidWeekendDay},
+		{name: "duplicate", days: []string{"sunday", "Sunday"}, wantErr: ErrDuplicateWeekendDay},
+		{
+			name:    "every day",
+			days:    []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"},
+			wantErr: ErrNoBusinessDays,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWeekend(NormalizeWeekend(tt.days))
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewBusinessDaysCountResponse(t *testing.T) {
+	loc := &Location{Name: "dubai", Timezone: "Asia/Dubai", Weekend: []string{"saturday", "sunday"}}
+	dubai, _ := time.LoadLocation(loc.Timezone)
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, dubai) // Sunday
+	to := time.Date(2025, 6, 8, 0, 0, 0, 0, dubai)   // Sunday
+
+	holidays := []*Holiday{
+		{Date: "2025-06-01", Name: "Excluded start"},
+		{Date: "2025-06-05", Name: "Arafat Day"},
+		{Date: "2025-06-07", Name: "Weekend holiday"},
+	}
+	resp := NewBusinessDaysCountResponse(loc, from, to, holidays)
+	if resp.BusinessDays != 4 || resp.CalendarDays != 7 || resp.WeekendDays != 2 {
+		t.Errorf("unexpected counts: business %d, calendar %d, weekend %d", resp.BusinessDays, resp.CalendarDays, resp.WeekendDays)
+	}
+	if len(resp.Holidays) != 1 || resp.Holidays[0].Name != "Arafat Day" {
+		t.Errorf("expected only the weekday holiday in range, got %+v", resp.Holidays)
+	}
+
+	if got := (&Location{}).EffectiveWeekend(); len(got) != 2 || got[0] != "saturday" {
+		t.Errorf("EffectiveWeekend() = %v, want the default weekend", got)
+	}
+}
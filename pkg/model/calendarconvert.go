@@ -0,0 +1,227 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/calendar"
+)
+
+// Calendar conversion validation errors
+var (
+	ErrCalendarDateConflict   = errors.New("give either date or year, month and day, not both")
+	ErrIncompleteCalendarDate = errors.New("year, month (or week) and day must be given together")
+	ErrInvalidCalendarDate    = errors.New("date must be written year-month-day, or year-Wweek-weekday for iso-week")
+	ErrCalendarTimeConflict   = errors.New("time and timezone cannot be combined with a date")
+	ErrCalendarWeekMonth      = errors.New("week is only used with the iso-week calendar, month with the others")
+)
+
+// CalendarConvertRequest represents a request to convert a date between
+// calendar systems. The date is given in the From calendar, either as a
+// year-month-day Date or as separate fields; for iso-week the month is the
+// week and the day the weekday. Without a date, the calendar date of Time
+// (default now) in Timezone, an IANA timezone or a saved location, is
+// converted. To lists the calendars to convert to, default all of them.
+type CalendarConvertRequest struct {
+	From      string   `json:"from,omitempty"`
+	Date      string   `json:"date,omitempty"`
+	Year      int      `json:"year,omitempty"`
+	Month     int      `json:"month,omitempty"`
+	Week      int      `json:"week,omitempty"`
+	Day       int      `json:"day,omitempty"`
+	LeapMonth bool     `json:"leap_month,omitempty"`
+	Era       string   `json:"era,omitempty"`
+	Time      string   `json:"time,omitempty"`
+	Timezone  string   `json:"timezone,omitempty"`
+	To        []string `json:"to,omitempty"`
+}
+
+// CalendarDate represents a date in one calendar system. ISO week dates have
+// a week instead of a month, and their day is the weekday, 1 for Monday.
+// Error is set instead of the date when it falls outside the range the
+// calendar supports.
+type CalendarDate struct {
+	Calendar  string `json:"calendar"`
+	Era       string `json:"era,omitempty"`
+	Year      int    `json:"year,omitempty"`
+	Month     int    `json:"month,omitempty"`
+	Week      int    `json:"week,omitempty"`
+	Day       int    `json:"day,omitempty"`
+	LeapMonth bool   `json:"leap_month,omitempty"`
+	MonthName string `json:"month_name,omitempty"`
+	YearName  string `json:"year_name,omitempty"`
+	Formatted string `json:"formatted,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// CalendarConvertResponse represents a date in several calendar systems.
+// Time is set when the date came from an instant rather than a given date.
+type CalendarConvertResponse struct {
+	Location  string          `json:"location,omitempty"`
+	Timezone  string          `json:"timezone,omitempty"`
+	Time      *ZonedTime      `json:"time,omitempty"`
+	Gregorian string          `json:"gregorian"`
+	Weekday   string          `json:"weekday"`
+	Source    *CalendarDate   `json:"source,omitempty"`
+	Dates     []*CalendarDate `json:"dates"`
+}
+
+// Normalize normalizes the fields of a CalendarConvertRequest, splitting
+// comma-separated target calendars
+func (r *CalendarConvertRequest) Normalize() {
+	r.From = strings.ToLower(strings.TrimSpace(r.From))
+	r.Date = strings.TrimSpace(r.Date)
+	r.Era = strings.TrimSpace(r.Era)
+	r.Time = strings.TrimSpace(r.Time)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.To = splitList(r.To)
+	for i, to := range r.To {
+		r.To[i] = strings.ToLower(to)
+	}
+	if r.From == "" {
+		r.From = calendar.Gregorian
+	}
+}
+
+// Validate validates a CalendarConvertRequest
+func (r *CalendarConvertRequest) Validate() error {
+	if _, err := calendar.Lookup(r.From); err != nil {
+		return err
+	}
+	for _, to := range r.To {
+		if _, err := calendar.Lookup(to); err != nil {
+			return err
+		}
+	}
+
+	fields := r.Year != 0 || r.Month != 0 || r.Week != 0 || r.Day != 0
+	if r.Date != "" && fields {
+		return ErrCalendarDateConflict
+	}
+	if r.Date != "" {
+		if _, err := parseCalendarDate(r.Date); err != nil {
+			return err
+		}
+	}
+	if fields {
+		isoWeek := r.From == calendar.ISOWeek
+		if (r.Week != 0 && !isoWeek) || (r.Month != 0 && isoWeek) {
+			return ErrCalendarWeekMonth
+		}
+		if r.Year == 0 || r.Month+r.Week == 0 || r.Day == 0 {
+			return ErrIncompleteCalendarDate
+		}
+	}
+	if r.HasDate() && (r.Time != "" || r.Timezone != "") {
+		return ErrCalendarTimeConflict
+	}
+	return nil
+}
+
+// HasDate reports whether the request gives a date to convert rather than an instant
+func (r *CalendarConvertRequest) HasDate() bool {
+	return r.Date != "" || r.Year != 0 || r.Month != 0 || r.Week != 0 || r.Day != 0
+}
+
+// SourceDays returns the day number, counted from 1970-01-01, of the date
+// given in the From calendar, reporting dates the calendar rejects.
+// It must only be called after Validate succeeds, and when HasDate is true.
+func (r *CalendarConvertRequest) SourceDays() (int, error) {
+	c, _ := calendar.Lookup(r.From)
+	d := calendar.Date{Year: r.Year, Month: r.Month + r.Week, Day: r.Day}
+	if r.Date != "" {
+		d, _ = parseCalendarDate(r.Date)
+	}
+	d.LeapMonth = r.LeapMonth
+	d.Era = r.Era
+	return c.ToDays(d)
+}
+
+// Targets returns the calendars to convert to.
+// It must only be called after Validate succeeds.
+func (r *CalendarConvertRequest) Targets() []calendar.Calendar {
+	names := r.To
+	if len(names) == 0 {
+		names = calendar.Names()
+	}
+	var targets []calendar.Calendar
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if c, _ := calendar.Lookup(name); !seen[c.Name()] {
+			seen[c.Name()] = true
+			targets = append(targets, c)
+		}
+	}
+	return targets
+}
+
+// NewCalendarConvertResponse creates a CalendarConvertResponse for a day
+// number. t is the instant the day was taken from, nil when the request gave
+// a date, and location the saved location name its timezone came from.
+func NewCalendarConvertResponse(req *CalendarConvertRequest, days int, t *time.Time, location string) *CalendarConvertResponse {
+	gregorian := time.Unix(int64(days)*86400, 0).UTC()
+	response := &CalendarConvertResponse{
+		Gregorian: gregorian.Format("2006-01-02"),
+		Weekday:   gregorian.Weekday().String(),
+	}
+	if t != nil {
+		response.Location = location
+		response.Timezone = t.Location().String()
+		response.Time = NewZonedTime(*t, location)
+	} else {
+		from, _ := calendar.Lookup(req.From)
+		response.Source = newCalendarDate(from, days)
+	}
+
+	targets := req.Targets()
+	response.Dates = make([]*CalendarDate, len(targets))
+	for i, c := range targets {
+		response.Dates[i] = newCalendarDate(c, days)
+	}
+	return response
+}
+
+// newCalendarDate creates a CalendarDate for a day number in calendar c
+func newCalendarDate(c calendar.Calendar, days int) *CalendarDate {
+	d, err := c.FromDays(days)
+	if err != nil {
+		return &CalendarDate{Calendar: c.Name(), Error: err.Error()}
+	}
+	date := &CalendarDate{
+		Calendar:  c.Name(),
+		Era:       d.Era,
+		Year:      d.Year,
+		Month:     d.Month,
+		Day:       d.Day,
+		LeapMonth: d.LeapMonth,
+		MonthName: c.MonthName(d),
+		YearName:  c.YearName(d),
+		Formatted: c.Format(d),
+	}
+	if c.Name() == calendar.ISOWeek {
+		date.Month, date.Week = 0, d.Month
+	}
+	return date
+}
+
+// parseCalendarDate parses a numeric year-month-day date, accepting
+// year-Wweek-weekday for ISO week dates
+func parseCalendarDate(s string) (calendar.Date, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 3 {
+		return calendar.Date{}, ErrInvalidCalendarDate
+	}
+	parts[1] = strings.TrimPrefix(strings.ToUpper(parts[1]), "W")
+	var n [3]int
+	for i, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil || v < 1 {
+			return calendar.Date{}, fmt.Errorf("%w: %q", ErrInvalidCalendarDate, s)
+		}
+		n[i] = v
+	}
+	return calendar.Date{Year: n[0], Month: n[1], Day: n[2]}, nil
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/calendar"
+)
+
+func TestCalendarConvertRequestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  CalendarConvertRequest
+		want error
+	}{
+		{"now", CalendarConvertRequest{}, nil},
+		{"date", CalendarConvertRequest{From: "Hebrew", Date: "5786-1-24", To: []string{"gregorian, persian"}}, nil},
+		{"fields", CalendarConvertRequest{From: "chinese", Year: 2025, Month: 6, Day: 1, LeapMonth: true}, nil},
+		{"iso week", CalendarConvertRequest{From: "iso-week", Year: 2025, Week: 42, Day: 4}, nil},
+		{"unknown from", CalendarConvertRequest{From: "mayan"}, calendar.ErrUnknownCalendar},
+		{"unknown target", CalendarConvertRequest{To: []string{"islamic,julian"}}, calendar.ErrUnknownCalendar},
+		{"date and fields", CalendarConvertRequest{Date: "2025-10-16", Year: 2025}, ErrCalendarDateConflict},
+		{"malformed date", CalendarConvertRequest{Date: "16/10/2025"}, ErrInvalidCalendarDate},
+		{"missing day", CalendarConvertRequest{Year: 2025, Month: 10}, ErrIncompleteCalendarDate},
+		{"week outside iso-week", CalendarConvertRequest{Year: 2025, Week: 42, Day: 4}, ErrCalendarWeekMonth},
+		{"month in iso-week", CalendarConvertRequest{From: "iso-week", Year: 2025, Month: 10, Day: 4}, ErrCalendarWeekMonth},
+		{"date and time", CalendarConvertRequest{Date: "2025-10-16", Time: "now"}, ErrCalendarTimeConflict},
+		{"date and timezone", CalendarConvertRequest{Date: "2025-10-16", Timezone: "Asia/Tokyo"}, ErrCalendarTimeConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarConvertSourceDays(t *testing.T) {
+	tests := []struct {
+		name string
+		req  CalendarConvertRequest
+		want string
+	}{
+		{"gregorian date", CalendarConvertRequest{Date: "2025-10-16"}, "2025-10-16"},
+		{"iso week date", CalendarConvertRequest{From: "iso-week", Date: "2025-W42-4"}, "2025-10-16"},
+		{"hebrew fields", CalendarConvertRequest{From: "hebrew", Year: 5786, Month: 1, Day: 24}, "2025-10-16"},
+		{"japanese era", CalendarConvertRequest{From: "japanese", Date: "7-10-16", Era: "reiwa"}, "2025-10-16"},
+		{"chinese leap month", CalendarConvertRequest{From: "chinese", Date: "2025-6-1", LeapMonth: true}, "2025-07-25"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); err != nil {
+				t.Fatalf("Validate() unexpected error: %v", err)
+			}
+			days, err := tt.req.SourceDays()
+			if err != nil {
+				t.Fatalf("SourceDays() unexpected error: %v", err)
+			}
+			if got := time.Unix(int64(days)*86400, 0).UTC().Format("2006-01-02"); got != tt.want {
+				t.Errorf("SourceDays() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	req := CalendarConvertRequest{From: "persian", Date: "1404-12-30"}
+	req.Normalize()
+	if _, err := req.SourceDays(); !errors.Is(err, calendar.ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate for Esfand 30 in a common year, got %v", err)
+	}
+}
+
+func TestNewCalendarConvertResponse(t *testing.T) {
+	req := CalendarConvertRequest{From: "hebrew", Date: "5786-1-24", To: []string{"iso-week,chinese", "Chinese"}}
+	req.Normalize()
+	days, err := req.SourceDays()
+	if err != nil {
+		t.Fatalf("SourceDays() unexpected error: %v", err)
+	}
+	resp := NewCalendarConvertResponse(&req, days, nil, "")
+
+	if resp.Gregorian != "2025-10-16" || resp.Weekday != "Thursday" || resp.Time != nil {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Source == nil || resp.Source.Formatted != "24 Tishrei 5786" {
+		t.Errorf("unexpected source: %+v", resp.Source)
+	}
+	if len(resp.Dates) != 2 {
+		t.Fatalf("expected duplicate targets to be dropped, got %d dates", len(resp.Dates))
+	}
+	if week := resp.Dates[0]; week.Week != 42 || week.Month != 0 || week.Day != 4 {
+		t.Errorf("unexpected iso week date: %+v", week)
+	}
+	if cn := resp.Dates[1]; cn.Formatted != "乙巳年八月廿五" || cn.YearName != "Yi-Si (Snake)" {
+		t.Errorf("unexpected chinese date: %+v", cn)
+	}
+
+	// An instant is converted on its local date, and all calendars are listed
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	at := time.Date(2025, 10, 16, 1, 0, 0, 0, tokyo)
+	req = CalendarConvertRequest{}
+	req.Normalize()
+	resp = NewCalendarConvertResponse(&req, calendar.DaysOf(at), &at, "tokyo-office")
+	if resp.Gregorian != "2025-10-16" || resp.Location != "tokyo-office" || resp.Timezone != "Asia/Tokyo" || resp.Source != nil {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if len(resp.Dates) != len(calendar.Names()) {
+		t.Errorf("expected every calendar, got %d dates", len(resp.Dates))
+	}
+
+	// Dates outside a calendar's range carry an error instead
+	resp = NewCalendarConvertResponse(&req, calendar.Days(1850, time.January, 1), nil, "")
+	for _, d := range resp.Dates {
+		if (d.Calendar == calendar.Chinese || d.Calendar == calendar.Japanese) != (d.Error != "") {
+			t.Errorf("unexpected %s date for 1850: %+v", d.Calendar, d)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxConvertTargets limits the number of target zones in a single conversion
+const MaxConvertTargets = 50
+
+// Conversion validation errors
+var (
+	ErrNoConvertTargets      = errors.New("at least one target timezone or location is required")
+	ErrTooManyConvertTargets = fmt.Errorf("at most %d target timezones or locations are allowed", MaxConvertTargets)
+)
+
+// ZonedTime represents an instant as wall-clock time in a specific timezone
+type ZonedTime struct {
+	Location      string `json:"location,omitempty"`
+	Timezone      string `json:"timezone"`
+	Time          string `json:"time"`
+	LocalTime     string `json:"local_time"`
+	Offset        string `json:"offset"`
+	OffsetSeconds int    `json:"offset_seconds"`
+	Abbreviation  string `json:"abbreviation"`
+	IsDST         bool   `json:"is_dst"`
+	Humanized     string `json:"humanized,omitempty"`
+}
+
+// ConvertRequest represents a request to convert an instant between timezones.
+// With Humanize, each zoned time also describes the instant relative to now.
+type ConvertRequest struct {
+	Time     string   `json:"time,omitempty"`
+	From     string   `json:"from,omitempty"`
+	To       []string `json:"to"`
+	Humanize bool     `json:"humanize,omitempty"`
+	HumanizeOptions
+}
+
+// ConvertResponse represents an instant converted into one or more timezones
+type ConvertResponse struct {
+	Instant  string       `json:"instant"`
+	UnixTime int64        `json:"unix_time"`
+	Source   *ZonedTime   `json:"source"`
+	Targets  []*ZonedTime `json:"targets"`
+}
+
+// NewZonedTime creates a ZonedTime from t in its own timezone.
+// location is the saved location name the timezone came from, if any.
+func NewZonedTime(t time.Time, location string) *ZonedTime {
+	abbr, offset := t.Zone()
+	return &ZonedTime{
+		Location:      location,
+		Timezone:      t.Location().String(),
+		Time:          t.Format(time.RFC3339),
+		LocalTime:     t.Format("2006-01-02 15:04:05"),
+		Offset:        FormatOffset(offset),
+		OffsetSeconds: offset,
+		Abbreviation:  abbr,
+		IsDST:         t.IsDST(),
+	}
+}
+
+// FormatOffset formats a UTC offset in seconds as ±HH:MM
+func FormatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, seconds/3600, (seconds%3600)/60)
+}
+
+// Normalize normalizes the fields of a ConvertRequest, splitting comma-separated targets
+func (r *ConvertRequest) Normalize() {
+	r.Time = strings.TrimSpace(r.Time)
+	r.From = strings.TrimSpace(r.From)
+	if r.From == "" {
+		r.From = "UTC"
+	}
+
+	var targets []string
+	for _, to := range r.To {
+		for _, part := range strings.Split(to, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				targets = append(targets, part)
+			}
+		}
+	}
+	r.To = targets
+	r.HumanizeOptions.Normalize()
+}
+
+// Validate validates a ConvertRequest
+func (r *ConvertRequest) Validate() error {
+	if len(r.To) == 0 {
+		return ErrNoConvertTargets
+	}
+	if len(r.To) > MaxConvertTargets {
+		return ErrTooManyConvertTargets
+	}
+	if r.Humanize {
+		return r.HumanizeOptions.Validate()
+	}
+	return nil
+}
+
+// ZonedTime creates a ZonedTime for t like NewZonedTime, describing it
+// relative to now when the request asks for it. It must only be called after
+// Validate succeeds.
+func (r *ConvertRequest) ZonedTime(t time.Time, location string, now time.Time) *ZonedTime {
+	z := NewZonedTime(t, location)
+	if r.Humanize {
+		z.Humanized = r.Describe(t, now, location, true)
+	}
+	return z
+}
@@ -0,0 +1,122 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewZonedTime(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		instant  time.Time
+		location string
+		want     ZonedTime
+	}{
+		{
+			name:     "summer time",
+			instant:  time.Date(2025, 7, 1, 16, 0, 0, 0, time.UTC).In(ny),
+			location: "hq",
+			want: ZonedTime{
+				Location:      "hq",
+				Timezone:      "America/New_York",
+				Time:          "2025-07-01T12:00:00-04:00",
+				LocalTime:     "2025-07-01 12:00:00",
+				Offset:        "-04:00",
+				OffsetSeconds: -4 * 3600,
+				Abbreviation:  "EDT",
+				IsDST:         true,
+			},
+		},
+		{
+			name:    "winter time",
+			instant: time.Date(2025, 1, 1, 17, 0, 0, 0, time.UTC).In(ny),
+			want: ZonedTime{
+				Timezone:      "America/New_York",
+				Time:          "2025-01-01T12:00:00-05:00",
+				LocalTime:     "2025-01-01 12:00:00",
+				Offset:        "-05:00",
+				OffsetSeconds: -5 * 3600,
+				Abbreviation:  "EST",
+				IsDST:         false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewZonedTime(tt.instant, tt.location)
+			if *got != tt.want {
+				t.Errorf("NewZonedTime() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "+00:00"},
+		{3600, "+01:00"},
+		{19800, "+05:30"},
+		{-18000, "-05:00"},
+		{-34200, "-09:30"},
+		{49500, "+13:45"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatOffset(tt.seconds); got != tt.want {
+				t.Errorf("FormatOffset(%d) = %s, want %s", tt.seconds, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertRequestNormalize(t *testing.T) {
+	req := &ConvertRequest{
+		Time: "  14:30 ",
+		To:   []string{"Asia/Tokyo, hq", " ", "Europe/London"},
+	}
+	req.Normalize()
+
+	if req.Time != "14:30" {
+		t.Errorf("expected trimmed time, got %q", req.Time)
+	}
+	if req.From != "UTC" {
+		t.Errorf("expected default source UTC, got %q", req.From)
+	}
+	want := []string{"Asia/Tokyo", "hq", "Europe/London"}
+	if strings.Join(req.To, "|") != strings.Join(want, "|") {
+		t.Errorf("expected targets %v, got %v", want, req.To)
+	}
+}
+
+func TestConvertRequestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		to        []string
+		wantError error
+	}{
+		{"single target", []string{"Asia/Tokyo"}, nil},
+		{"no targets", nil, ErrNoConvertTargets},
+		{"maximum targets", make([]string, MaxConvertTargets), nil},
+		{"too many targets", make([]string, MaxConvertTargets+1), ErrTooManyConvertTargets},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &ConvertRequest{To: tt.to}
+			if err := req.Validate(); err != tt.wantError {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/cron"
+)
+
+// Cron preview limits and defaults
+const (
+	MaxCronRuns     = 100
+	DefaultCronRuns = 10
+)
+
+// Cron preview directions
+const (
+	CronDirectionNext     = "next"
+	CronDirectionPrevious = "previous"
+)
+
+// Cron validation errors
+var (
+	ErrEmptyCronExpression = errors.New("expression is required")
+	ErrInvalidCronCount    = fmt.Errorf("count must be between 1 and %d", MaxCronRuns)
+	ErrInvalidCronDir      = errors.New("direction must be 'next' or 'previous'")
+)
+
+// CronPreviewRequest represents a request for the fire times of a cron expression
+type CronPreviewRequest struct {
+	Expression string `json:"expression"`
+	Timezone   string `json:"timezone,omitempty"`
+	From       string `json:"from,omitempty"`
+	Count      int    `json:"count,omitempty"`
+	Direction  string `json:"direction,omitempty"`
+}
+
+// CronRun represents one fire time. Doubled marks a local time that happens
+// twice as clocks go back, so a wall-clock scheduler fires at both instants.
+type CronRun struct {
+	Time         string `json:"time"`
+	UTC          string `json:"utc"`
+	LocalTime    string `json:"local_time"`
+	Weekday      string `json:"weekday"`
+	Offset       string `json:"offset"`
+	Abbreviation string `json:"abbreviation"`
+	Doubled      bool   `json:"doubled,omitempty"`
+}
+
+// CronPreviewResponse represents the fire times of a cron expression.
+// Skipped lists local times the expression matches that do not exist because
+// clocks go forward, so a wall-clock scheduler never fires them.
+type CronPreviewResponse struct {
+	Expression string     `json:"expression"`
+	Normalized string     `json:"normalized"`
+	Timezone   string     `json:"timezone"`
+	Location   string     `json:"location,omitempty"`
+	From       string     `json:"from"`
+	Direction  string     `json:"direction"`
+	Count      int        `json:"count"`
+	Runs       []*CronRun `json:"runs"`
+	Skipped    []string   `json:"skipped"`
+}
+
+// Normalize normalizes the fields of a CronPreviewRequest
+func (r *CronPreviewRequest) Normalize() {
+	r.Expression = strings.TrimSpace(r.Expression)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.From = strings.TrimSpace(r.From)
+	r.Direction = strings.ToLower(strings.TrimSpace(r.Direction))
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.Count == 0 {
+		r.Count = DefaultCronRuns
+	}
+	if r.Direction == "" {
+		r.Direction = CronDirectionNext
+	}
+}
+
+// Validate validates a CronPreviewRequest
+func (r *CronPreviewRequest) Validate() error {
+	if r.Expression == "" {
+		return ErrEmptyCronExpression
+	}
+	if _, err := cron.Parse(r.Expression); err != nil {
+		return err
+	}
+	if r.Count < 1 || r.Count > MaxCronRuns {
+		return ErrInvalidCronCount
+	}
+	if r.Direction != CronDirectionNext && r.Direction != CronDirectionPrevious {
+		return ErrInvalidCronDir
+	}
+	return nil
+}
+
+// Preview returns the fire times of the expression around from in loc.
+// It must only be called after Validate succeeds.
+func (r *CronPreviewRequest) Preview(from time.Time, loc *time.Location) (*cron.Schedule, *cron.Preview) {
+	schedule, _ := cron.Parse(r.Expression)
+	if r.Direction == CronDirectionPrevious {
+		return schedule, schedule.Prev(from, loc, r.Count)
+	}
+	return schedule, schedule.Next(from, loc, r.Count)
+}
+
+// NewCronPreviewResponse creates a CronPreviewResponse.
+// location is the saved location name the timezone came from, if any.
+func NewCronPreviewResponse(req *CronPreviewRequest, schedule *cron.Schedule, preview *cron.Preview, from time.Time, location string) *CronPreviewResponse {
+	response := &CronPreviewResponse{
+		Expression: req.Expression,
+		Normalized: schedule.Normalized,
+		Timezone:   from.Location().String(),
+		Location:   location,
+		From:       from.Format(time.RFC3339),
+		Direction:  req.Direction,
+		Count:      len(preview.Runs),
+		Runs:       make([]*CronRun, len(preview.Runs)),
+		Skipped:    make([]string, len(preview.Skipped)),
+	}
+
+	for i, run := range preview.Runs {
+		abbr, offset := run.Time.Zone()
+		response.Runs[i] = &CronRun{
+			Time:         run.Time.Format(time.RFC3339),
+			UTC:          run.Time.UTC().Format(time.RFC3339),
+			LocalTime:    run.Time.Format("2006-01-02 15:04:05"),
+			Weekday:      run.Time.Weekday().String(),
+			Offset:       FormatOffset(offset),
+			Abbreviation: abbr,
+			Doubled:      run.Doubled,
+		}
+	}
+	for i, wall := range preview.Skipped {
+		response.Skipped[i] = wall.Format("2006-01-02 15:04:05")
+	}
+
+	return response
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/cron"
+)
+
+func TestCronPreviewRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     CronPreviewRequest
+		wantErr error
+	}{
+		{name: "defaults", req: CronPreviewRequest{Expression: "0 9 * * MON-FRI"}},
+		{name: "macro backwards", req: CronPreviewRequest{Expression: "@hourly", Direction: "Previous", Count: 5}},
+		{name: "missing expression", req: CronPreviewRequest{Expression: "  "}, wantErr: ErrEmptyCronExpression},
+		{name: "invalid expression", req: CronPreviewRequest{Expression: "0 25 * * *"}, wantErr: cron.ErrInvalidExpression},
+		{name: "never fires", req: CronPreviewRequest{Expression: "0 0 31 4 *"}, wantErr: cron.ErrNeverFires},
+		{name: "count too large", req: CronPreviewRequest{Expression: "@daily", Count: MaxCronRuns + 1}, wantErr: ErrInvalidCronCount},
+		{name: "unknown direction", req: CronPreviewRequest{Expression: "@daily", Direction: "sideways"}, wantErr: ErrInvalidCronDir},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			err := tt.req.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewCronPreviewResponse(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	// Clocks go forward at 01:00 on 30 March 2025 and back at 02:00 on 26 October
+	req := CronPreviewRequest{Expression: "30 1 * * SUN", Count: 3}
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	from := time.Date(2025, 3, 25, 0, 0, 0, 0, london)
+	schedule, preview := req.Preview(from, london)
+	resp := NewCronPreviewResponse(&req, schedule, preview, from, "london")
+
+	if resp.Count != 3 || resp.Direction != CronDirectionNext || resp.Location != "london" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if len(resp.Skipped) != 1 || resp.Skipped[0] != "2025-03-30 01:30:00" {
+		t.Errorf("expected 30 March 01:30 to be skipped, got %v", resp.Skipped)
+	}
+	if resp.Runs[0].Time != "2025-04-06T01:30:00+01:00" || resp.Runs[0].Abbreviation != "BST" || resp.Runs[0].Weekday != "Sunday" {
+		t.Errorf("unexpected first run: %+v", resp.Runs[0])
+	}
+
+	from = time.Date(2025, 10, 25, 0, 0, 0, 0, london)
+	schedule, preview = req.Preview(from, london)
+	resp = NewCronPreviewResponse(&req, schedule, preview, from, "")
+	if !resp.Runs[0].Doubled || !resp.Runs[1].Doubled || resp.Runs[1].UTC != "2025-10-26T01:30:00Z" || resp.Runs[2].Doubled {
+		t.Errorf("expected the two 01:30 runs on 26 October to be doubled, got %+v %+v %+v", resp.Runs[0], resp.Runs[1], resp.Runs[2])
+	}
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// Difference validation errors
+var (
+	ErrEmptyDiffStart = errors.New("start time is required")
+	ErrEmptyDiffEnd   = errors.New("end time is required")
+)
+
+// DiffRequest represents a request for the difference between two instants
+type DiffRequest struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	StartZone string `json:"start_zone,omitempty"`
+	EndZone   string `json:"end_zone,omitempty"`
+	Zone      string `json:"zone,omitempty"`
+}
+
+// CalendarBreakdown represents a difference split into calendar components
+type CalendarBreakdown struct {
+	Years       int `json:"years"`
+	Months      int `json:"months"`
+	Days        int `json:"days"`
+	Hours       int `json:"hours"`
+	Minutes     int `json:"minutes"`
+	Seconds     int `json:"seconds"`
+	Nanoseconds int `json:"nanoseconds,omitempty"`
+}
+
+// DiffResponse represents the difference between two instants
+type DiffResponse struct {
+	Start           *ZonedTime         `json:"start"`
+	End             *ZonedTime         `json:"end"`
+	Zone            string             `json:"zone"`
+	Negative        bool               `json:"negative"`
+	DurationSeconds float64            `json:"duration_seconds"`
+	Duration        string             `json:"duration"`
+	DurationISO8601 string             `json:"duration_iso8601"`
+	Calendar        *CalendarBreakdown `json:"calendar"`
+	ISO8601         string             `json:"iso8601"`
+}
+
+// NewDiffResponse computes the difference between start and end, with the
+// calendar breakdown measured on the wall clock of loc.
+// startLocation and endLocation are the saved location names the instants came from, if any.
+func NewDiffResponse(start, end time.Time, startLocation, endLocation string, loc *time.Location) *DiffResponse {
+	exact := end.Sub(start)
+	period := timecalc.Between(start, end, loc)
+
+	return &DiffResponse{
+		Start:           NewZonedTime(start, startLocation),
+		End:             NewZonedTime(end, endLocation),
+		Zone:            loc.String(),
+		Negative:        period.Negative,
+		DurationSeconds: exact.Seconds(),
+		Duration:        exact.String(),
+		DurationISO8601: timecalc.FormatDuration(exact),
+		Calendar: &CalendarBreakdown{
+			Years:       period.Years,
+			Months:      period.Months,
+			Days:        period.Days,
+			Hours:       period.Hours,
+			Minutes:     period.Minutes,
+			Seconds:     period.Seconds,
+			Nanoseconds: period.Nanoseconds,
+		},
+		ISO8601: period.ISO8601(),
+	}
+}
+
+// Normalize normalizes the fields of a DiffRequest.
+// Zones default to UTC for the start, the start zone for the end, and the
+// start zone for the calendar breakdown.
+func (r *DiffRequest) Normalize() {
+	r.Start = strings.TrimSpace(r.Start)
+	r.End = strings.TrimSpace(r.End)
+	r.StartZone = strings.TrimSpace(r.StartZone)
+	r.EndZone = strings.TrimSpace(r.EndZone)
+	r.Zone = strings.TrimSpace(r.Zone)
+
+	if r.StartZone == "" {
+		r.StartZone = "UTC"
+	}
+	if r.EndZone == "" {
+		r.EndZone = r.StartZone
+	}
+	if r.Zone == "" {
+		r.Zone = r.StartZone
+	}
+}
+
+// Validate validates a DiffRequest
+func (r *DiffRequest) Validate() error {
+	if r.Start == "" {
+		return ErrEmptyDiffStart
+	}
+	if r.End == "" {
+		return ErrEmptyDiffEnd
+	}
+	return nil
+}
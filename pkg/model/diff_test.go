@@ -0,0 +1,96 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewDiffResponse(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	start := time.Date(2025, 3, 8, 12, 0, 0, 0, ny)
+	end := time.Date(2025, 3, 9, 12, 0, 0, 0, ny)
+
+	resp := NewDiffResponse(start, end, "hq", "", ny)
+
+	if resp.Start.Location != "hq" || resp.End.Location != "" {
+		t.Errorf("unexpected locations: start=%q end=%q", resp.Start.Location, resp.End.Location)
+	}
+	if resp.Zone != "America/New_York" {
+		t.Errorf("expected zone America/New_York, got %s", resp.Zone)
+	}
+	if resp.DurationSeconds != 23*3600 {
+		t.Errorf("expected 23 hours elapsed, got %v seconds", resp.DurationSeconds)
+	}
+	if resp.Duration != "23h0m0s" {
+		t.Errorf("expected duration 23h0m0s, got %s", resp.Duration)
+	}
+	if resp.DurationISO8601 != "PT23H" {
+		t.Errorf("expected PT23H, got %s", resp.DurationISO8601)
+	}
+	if *resp.Calendar != (CalendarBreakdown{Days: 1}) {
+		t.Errorf("expected 1 calendar day, got %+v", *resp.Calendar)
+	}
+	if resp.ISO8601 != "P1D" {
+		t.Errorf("expected P1D, got %s", resp.ISO8601)
+	}
+	if resp.Negative {
+		t.Error("expected positive difference")
+	}
+}
+
+func TestDiffRequestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		req  DiffRequest
+		want DiffRequest
+	}{
+		{
+			name: "defaults",
+			req:  DiffRequest{Start: " a ", End: " b "},
+			want: DiffRequest{Start: "a", End: "b", StartZone: "UTC", EndZone: "UTC", Zone: "UTC"},
+		},
+		{
+			name: "end and calendar zone follow start zone",
+			req:  DiffRequest{StartZone: "hq"},
+			want: DiffRequest{StartZone: "hq", EndZone: "hq", Zone: "hq"},
+		},
+		{
+			name: "explicit zones kept",
+			req:  DiffRequest{StartZone: "hq", EndZone: "Asia/Tokyo", Zone: "UTC"},
+			want: DiffRequest{StartZone: "hq", EndZone: "Asia/Tokyo", Zone: "UTC"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if tt.req != tt.want {
+				t.Errorf("Normalize() = %+v, want %+v", tt.req, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffRequestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       DiffRequest
+		wantError error
+	}{
+		{"valid", DiffRequest{Start: "2025-01-01", End: "2025-02-01"}, nil},
+		{"missing start", DiffRequest{End: "2025-02-01"}, ErrEmptyDiffStart},
+		{"missing end", DiffRequest{Start: "2025-01-01"}, ErrEmptyDiffEnd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(); err != tt.wantError {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/fiscal"
+)
+
+// Fiscal calendar patterns
+const (
+	FiscalMonthly = "monthly"
+	Fiscal445     = "4-4-5"
+	Fiscal454     = "4-5-4"
+	Fiscal544     = "5-4-4"
+)
+
+// Fiscal year limits
+const (
+	MinFiscalYear = 1900
+	MaxFiscalYear = 2200
+)
+
+// fiscalPatterns maps retail patterns to the weeks in each period of a quarter
+var fiscalPatterns = map[string][]int{
+	Fiscal445: {4, 4, 5},
+	Fiscal454: {4, 5, 4},
+	Fiscal544: {5, 4, 4},
+}
+
+// Fiscal calendar validation errors
+var (
+	ErrInvalidFiscalStartMonth = errors.New("start_month must be between 1 and 12")
+	ErrInvalidFiscalPattern    = errors.New("pattern must be monthly, 4-4-5, 4-5-4 or 5-4-4")
+	ErrInvalidFiscalWeekStart  = errors.New("week_start must be a weekday name such as monday")
+	ErrInvalidFiscalYearEnd    = errors.New("year_end must be last or nearest")
+	ErrFiscalYearEndMonthly    = errors.New("year_end only applies to 4-4-5, 4-5-4 and 5-4-4 calendars")
+	ErrInvalidFiscalYearLabel  = errors.New("year_label must be start or end")
+	ErrInvalidFiscalYear       = fmt.Errorf("year must be between %d and %d", MinFiscalYear, MaxFiscalYear)
+)
+
+// FiscalCalendar represents a named fiscal calendar.
+//
+// A monthly calendar's year is the twelve months from StartMonth. A retail
+// calendar (4-4-5, 4-5-4 or 5-4-4) has years of 52 or 53 whole weeks
+// starting on WeekStart; each year ends on the last day of a week that is
+// either the last one in the month before StartMonth or, with year_end
+// "nearest", the one nearest the end of that month. YearLabel names a fiscal
+// year by the calendar year it starts or ends in.
+type FiscalCalendar struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	StartMonth  int       `json:"start_month"`
+	Pattern     string    `json:"pattern"`
+	WeekStart   string    `json:"week_start"`
+	YearEnd     string    `json:"year_end,omitempty"`
+	YearLabel   string    `json:"year_label"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// FiscalDateRequest represents a request for the fiscal position of Time
+// (default now) in Timezone, an IANA timezone or a saved location
+type FiscalDateRequest struct {
+	Calendar string `json:"calendar"`
+	Time     string `json:"time,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// FiscalPeriodsRequest represents a request for the quarters and periods of
+// a fiscal year. Year defaults to the fiscal year of today in Timezone.
+type FiscalPeriodsRequest struct {
+	Calendar string `json:"calendar"`
+	Year     int    `json:"year,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// FiscalSpan represents a range of dates in a fiscal year, End inclusive.
+// Weeks is set for retail calendars.
+type FiscalSpan struct {
+	Quarter int    `json:"quarter,omitempty"`
+	Period  int    `json:"period,omitempty"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	Days    int    `json:"days"`
+	Weeks   int    `json:"weeks,omitempty"`
+}
+
+// FiscalCalendarListResponse represents the saved fiscal calendars
+type FiscalCalendarListResponse struct {
+	Count     int               `json:"count"`
+	Calendars []*FiscalCalendar `json:"calendars"`
+}
+
+// FiscalDateResponse represents where an instant's local date falls in a
+// fiscal calendar. Label reads like "FY2025 Q3 P7 W29".
+type FiscalDateResponse struct {
+	Calendar    string     `json:"calendar"`
+	Time        *ZonedTime `json:"time"`
+	Date        string     `json:"date"`
+	FiscalYear  int        `json:"fiscal_year"`
+	Quarter     int        `json:"quarter"`
+	Period      int        `json:"period"`
+	Week        int        `json:"week"`
+	DayOfYear   int        `json:"day_of_year"`
+	WeeksInYear int        `json:"weeks_in_year"`
+	Label       string     `json:"label"`
+	Year        FiscalSpan `json:"year"`
+	QuarterSpan FiscalSpan `json:"quarter_span"`
+	PeriodSpan  FiscalSpan `json:"period_span"`
+	WeekSpan    FiscalSpan `json:"week_span"`
+}
+
+// FiscalPeriodsResponse represents the boundaries of a fiscal year
+type FiscalPeriodsResponse struct {
+	Calendar   string       `json:"calendar"`
+	Pattern    string       `json:"pattern"`
+	FiscalYear int          `json:"fiscal_year"`
+	Year       FiscalSpan   `json:"year"`
+	Quarters   []FiscalSpan `json:"quarters"`
+	Periods    []FiscalSpan `json:"periods"`
+}
+
+// Normalize normalizes the fields of a FiscalCalendar and fills in defaults:
+// a monthly pattern, weeks starting on Monday, retail years ending in the
+// last week of the month and years named by the calendar year they end in
+func (c *FiscalCalendar) Normalize() {
+	c.Name = strings.ToLower(strings.TrimSpace(c.Name))
+	c.Description = strings.TrimSpace(c.Description)
+	c.Pattern = strings.ToLower(strings.TrimSpace(c.Pattern))
+	c.WeekStart = strings.ToLower(strings.TrimSpace(c.WeekStart))
+	c.YearEnd = strings.ToLower(strings.TrimSpace(c.YearEnd))
+	c.YearLabel = strings.ToLower(strings.TrimSpace(c.YearLabel))
+
+	if c.Pattern == "" {
+		c.Pattern = FiscalMonthly
+	}
+	if c.WeekStart == "" {
+		c.WeekStart = "monday"
+	}
+	if c.YearEnd == "" && c.Retail() {
+		c.YearEnd = "last"
+	}
+	if c.YearLabel == "" {
+		c.YearLabel = "end"
+	}
+}
+
+// Validate validates a FiscalCalendar
+func (c *FiscalCalendar) Validate() error {
+	if err := ValidateCalendarName(c.Name); err != nil {
+		return err
+	}
+	if err := ValidateDescription(c.Description); err != nil {
+		return err
+	}
+	if c.StartMonth < 1 || c.StartMonth > 12 {
+		return ErrInvalidFiscalStartMonth
+	}
+	if c.Pattern != FiscalMonthly && !c.Retail() {
+		return fmt.Errorf("%w: %s", ErrInvalidFiscalPattern, c.Pattern)
+	}
+	if _, ok := weekdays[c.WeekStart]; !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidFiscalWeekStart, c.WeekStart)
+	}
+	switch {
+	case !c.Retail() && c.YearEnd != "":
+		return ErrFiscalYearEndMonthly
+	case c.Retail() && c.YearEnd != "last" && c.YearEnd != "nearest":
+		return fmt.Errorf("%w: %s", ErrInvalidFiscalYearEnd, c.YearEnd)
+	}
+	if c.YearLabel != "start" && c.YearLabel != "end" {
+		return fmt.Errorf("%w: %s", ErrInvalidFiscalYearLabel, c.YearLabel)
+	}
+	return nil
+}
+
+// Retail reports whether the calendar has 52/53-week years
+func (c *FiscalCalendar) Retail() bool {
+	_, ok := fiscalPatterns[c.Pattern]
+	return ok
+}
+
+// Calendar returns the layout of the calendar's fiscal years.
+// It must only be called after Validate succeeds.
+func (c *FiscalCalendar) Calendar() fiscal.Calendar {
+	return fiscal.Calendar{
+		StartMonth: time.Month(c.StartMonth),
+		Weeks:      fiscalPatterns[c.Pattern],
+		WeekStart:  weekdays[c.WeekStart],
+		Nearest:    c.YearEnd == "nearest",
+		LabelStart: c.YearLabel == "start",
+	}
+}
+
+// Normalize normalizes the fields of a FiscalDateRequest, defaulting the
+// timezone to UTC
+func (r *FiscalDateRequest) Normalize() {
+	r.Calendar = strings.ToLower(strings.TrimSpace(r.Calendar))
+	r.Time = strings.TrimSpace(r.Time)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+}
+
+// Validate validates a FiscalDateRequest
+func (r *FiscalDateRequest) Validate() error {
+	return ValidateCalendarName(r.Calendar)
+}
+
+// Normalize normalizes the fields of a FiscalPeriodsRequest, defaulting the
+// timezone to UTC
+func (r *FiscalPeriodsRequest) Normalize() {
+	r.Calendar = strings.ToLower(strings.TrimSpace(r.Calendar))
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+}
+
+// Validate validates a FiscalPeriodsRequest
+func (r *FiscalPeriodsRequest) Validate() error {
+	if err := ValidateCalendarName(r.Calendar); err != nil {
+		return err
+	}
+	if r.Year != 0 && (r.Year < MinFiscalYear || r.Year > MaxFiscalYear) {
+		return ErrInvalidFiscalYear
+	}
+	return nil
+}
+
+// NewFiscalCalendarListResponse creates a FiscalCalendarListResponse
+func NewFiscalCalendarListResponse(calendars []*FiscalCalendar) *FiscalCalendarListResponse {
+	return &FiscalCalendarListResponse{Count: len(calendars), Calendars: calendars}
+}
+
+// NewFiscalDateResponse creates a FiscalDateResponse for the local date of t.
+// The calendar must be valid.
+func NewFiscalDateResponse(cal *FiscalCalendar, t time.Time, location string) *FiscalDateResponse {
+	pos := cal.Calendar().Locate(t)
+	return &FiscalDateResponse{
+		Calendar:    cal.Name,
+		Time:        NewZonedTime(t, location),
+		Date:        t.Format(HolidayDateLayout),
+		FiscalYear:  pos.Year,
+		Quarter:     pos.Quarter,
+		Period:      pos.Period,
+		Week:        pos.Week,
+		DayOfYear:   pos.DayOfYear,
+		WeeksInYear: pos.WeeksInYear,
+		Label:       fmt.Sprintf("FY%d Q%d P%d W%d", pos.Year, pos.Quarter, pos.Period, pos.Week),
+		Year:        newFiscalSpan(pos.YearSpan, cal.Retail()),
+		QuarterSpan: newFiscalSpan(pos.QuarterSpan, cal.Retail()),
+		PeriodSpan:  newFiscalSpan(pos.PeriodSpan, cal.Retail()),
+		WeekSpan:    newFiscalSpan(pos.WeekSpan, false),
+	}
+}
+
+// NewFiscalPeriodsResponse creates a FiscalPeriodsResponse for a fiscal year.
+// The calendar must be valid.
+func NewFiscalPeriodsResponse(cal *FiscalCalendar, year int) *FiscalPeriodsResponse {
+	c := cal.Calendar()
+	response := &FiscalPeriodsResponse{
+		Calendar:   cal.Name,
+		Pattern:    cal.Pattern,
+		FiscalYear: year,
+		Year:       newFiscalSpan(c.Year(year), cal.Retail()),
+		Quarters:   make([]FiscalSpan, 0, 4),
+		Periods:    make([]FiscalSpan, 0, 12),
+	}
+	for i, q := range c.Quarters(year) {
+		span := newFiscalSpan(q, cal.Retail())
+		span.Quarter = i + 1
+		response.Quarters = append(response.Quarters, span)
+	}
+	for _, p := range c.Periods(year) {
+		span := newFiscalSpan(p.Span, cal.Retail())
+		span.Quarter = p.Quarter
+		span.Period = p.Number
+		response.Periods = append(response.Periods, span)
+	}
+	return response
+}
+
+// newFiscalSpan converts a span to inclusive dates, counting its weeks when
+// it is made of whole ones
+func newFiscalSpan(s fiscal.Span, weeks bool) FiscalSpan {
+	span := FiscalSpan{
+		Start: s.Start.Format(HolidayDateLayout),
+		End:   s.End.AddDate(0, 0, -1).Format(HolidayDateLayout),
+		Days:  s.Days(),
+	}
+	if weeks {
+		span.Weeks = span.Days / 7
+	}
+	return span
+}
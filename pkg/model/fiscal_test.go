@@ -0,0 +1,102 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFiscalCalendarValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cal  FiscalCalendar
+		want error
+	}{
+		{"monthly defaults", FiscalCalendar{Name: "uk", StartMonth: 4}, nil},
+		{"retail", FiscalCalendar{Name: "Retail", StartMonth: 2, Pattern: "4-5-4", WeekStart: "Sunday", YearEnd: "Nearest", YearLabel: "start"}, nil},
+		{"missing name", FiscalCalendar{StartMonth: 1}, ErrEmptyCalendarName},
+		{"missing start month", FiscalCalendar{Name: "uk"}, ErrInvalidFiscalStartMonth},
+		{"start month too large", FiscalCalendar{Name: "uk", StartMonth: 13}, ErrInvalidFiscalStartMonth},
+		{"unknown pattern", FiscalCalendar{Name: "uk", StartMonth: 1, Pattern: "13-period"}, ErrInvalidFiscalPattern},
+		{"unknown week start", FiscalCalendar{Name: "uk", StartMonth: 1, WeekStart: "mon"}, ErrInvalidFiscalWeekStart},
+		{"unknown year end", FiscalCalendar{Name: "uk", StartMonth: 1, Pattern: "4-4-5", YearEnd: "first"}, ErrInvalidFiscalYearEnd},
+		{"year end on monthly", FiscalCalendar{Name: "uk", StartMonth: 1, YearEnd: "last"}, ErrFiscalYearEndMonthly},
+		{"unknown year label", FiscalCalendar{Name: "uk", StartMonth: 1, YearLabel: "middle"}, ErrInvalidFiscalYearLabel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cal.Normalize()
+			if err := tt.cal.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	cal := FiscalCalendar{Name: "retail", StartMonth: 8, Pattern: "4-4-5"}
+	cal.Normalize()
+	if cal.WeekStart != "monday" || cal.YearEnd != "last" || cal.YearLabel != "end" {
+		t.Errorf("unexpected defaults: %+v", cal)
+	}
+}
+
+func TestFiscalPeriodsRequestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  FiscalPeriodsRequest
+		want error
+	}{
+		{"current year", FiscalPeriodsRequest{Calendar: "uk"}, nil},
+		{"explicit year", FiscalPeriodsRequest{Calendar: "uk", Year: 2026}, nil},
+		{"year too early", FiscalPeriodsRequest{Calendar: "uk", Year: 1800}, ErrInvalidFiscalYear},
+		{"bad calendar name", FiscalPeriodsRequest{Calendar: "uk tax"}, ErrInvalidCalendarName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFiscalDateResponse(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	cal := &FiscalCalendar{Name: "uk", StartMonth: 4}
+	cal.Normalize()
+	resp := NewFiscalDateResponse(cal, time.Date(2026, 3, 31, 23, 30, 0, 0, london), "london")
+	if resp.Label != "FY2026 Q4 P12 W53" || resp.DayOfYear != 365 || resp.Time.Location != "london" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Year.Start != "2025-04-01" || resp.Year.End != "2026-03-31" || resp.Year.Weeks != 0 {
+		t.Errorf("unexpected year: %+v", resp.Year)
+	}
+	// The last week is cut short at the end of the year
+	if resp.WeekSpan.Start != "2026-03-30" || resp.WeekSpan.End != "2026-03-31" || resp.WeekSpan.Days != 2 {
+		t.Errorf("unexpected week: %+v", resp.WeekSpan)
+	}
+}
+
+func TestNewFiscalPeriodsResponse(t *testing.T) {
+	cal := &FiscalCalendar{Name: "retail", StartMonth: 2, Pattern: "4-4-5", WeekStart: "sunday", YearEnd: "nearest", YearLabel: "start"}
+	resp := NewFiscalPeriodsResponse(cal, 2024)
+
+	if resp.Year.Start != "2024-02-04" || resp.Year.End != "2025-02-01" || resp.Year.Weeks != 52 {
+		t.Errorf("unexpected year: %+v", resp.Year)
+	}
+	weeks := []int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5}
+	for i, p := range resp.Periods {
+		if p.Period != i+1 || p.Weeks != weeks[i] || p.Days != 7*weeks[i] {
+			t.Errorf("unexpected period %d: %+v", i+1, p)
+		}
+	}
+	if q := resp.Quarters[1]; q.Quarter != 2 || q.Start != "2024-05-05" || q.End != "2024-08-03" || q.Weeks != 13 {
+		t.Errorf("unexpected second quarter: %+v", q)
+	}
+}
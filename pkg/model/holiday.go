@@ -0,0 +1,278 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Holiday limits
+const (
+	MaxLocationCalendars = 20
+	MaxHolidayRangeYears = 10
+)
+
+// HolidayDateLayout is the layout of holiday dates
+const HolidayDateLayout = "2006-01-02"
+
+// Holiday validation errors
+var (
+	ErrEmptyCalendarName   = errors.New("calendar name cannot be empty")
+	ErrInvalidCalendarName = errors.New("calendar name must be 100 characters or less and contain only alphanumeric characters, hyphens, and underscores")
+	ErrTooManyCalendars    = fmt.Errorf("at most %d calendars can be attached to a location", MaxLocationCalendars)
+	ErrEmptyHolidayDate    = errors.New("holiday date cannot be empty")
+	ErrInvalidHolidayDate  = errors.New("holiday date must be in YYYY-MM-DD format")
+	ErrEmptyHolidayName    = errors.New("holiday name cannot be empty")
+	ErrHolidayNameTooLong  = errors.New("holiday name must be 200 characters or less")
+	ErrInvalidHolidayRange = errors.New("from and to must be dates in YYYY-MM-DD format")
+	ErrHolidayRangeOrder   = errors.New("to must not be before from")
+	ErrHolidayRangeTooLong = fmt.Errorf("holiday range must be at most %d years", MaxHolidayRangeYears)
+)
+
+// HolidayCalendar represents a named set of holidays loaded from a data file
+type HolidayCalendar struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description,omitempty"`
+	Source       string    `json:"source,omitempty"`
+	HolidayCount int       `json:"holiday_count"`
+	Holidays     []Holiday `json:"-"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Holiday represents a single holiday on a local date.
+// Calendar holds the calendar it came from; custom holidays belong to a
+// single location and have no calendar.
+type Holiday struct {
+	ID       int64  `json:"id,omitempty"`
+	Date     string `json:"date"`
+	Name     string `json:"name"`
+	Calendar string `json:"calendar,omitempty"`
+	Custom   bool   `json:"custom"`
+}
+
+// HolidayRequest represents the request body for creating or updating a custom holiday
+type HolidayRequest struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+// LocationCalendarsRequest represents the request body for attaching calendars to a location
+type LocationCalendarsRequest struct {
+	Calendars []string `json:"calendars"`
+}
+
+// HolidayRangeRequest represents an inclusive date range of holidays to list
+type HolidayRangeRequest struct {
+	From string
+	To   string
+}
+
+// CalendarListResponse represents the available holiday calendars
+type CalendarListResponse struct {
+	Count     int                `json:"count"`
+	Calendars []*HolidayCalendar `json:"calendars"`
+}
+
+// LocationCalendarsResponse represents the calendars attached to a location
+type LocationCalendarsResponse struct {
+	Location  string   `json:"location"`
+	Calendars []string `json:"calendars"`
+}
+
+// HolidayListResponse represents the holidays of a location within a date range
+type HolidayListResponse struct {
+	Location string     `json:"location"`
+	Timezone string     `json:"timezone"`
+	From     string     `json:"from"`
+	To       string     `json:"to"`
+	Count    int        `json:"count"`
+	Holidays []*Holiday `json:"holidays"`
+}
+
+// HolidayCheckResponse represents whether a date is a holiday at a location
+type HolidayCheckResponse struct {
+	Location  string     `json:"location"`
+	Timezone  string     `json:"timezone"`
+	Date      string     `json:"date"`
+	Weekday   string     `json:"weekday"`
+	IsHoliday bool       `json:"is_holiday"`
+	Holidays  []*Holiday `json:"holidays"`
+}
+
+// ValidateCalendarName validates a holiday calendar name
+func ValidateCalendarName(name string) error {
+	if name == "" {
+		return ErrEmptyCalendarName
+	}
+	if len(name) > 100 || !nameRegex.MatchString(name) {
+		return fmt.Errorf("%w: %s", ErrInvalidCalendarName, name)
+	}
+	return nil
+}
+
+// ValidateHolidayDate validates a YYYY-MM-DD holiday date
+func ValidateHolidayDate(date string) error {
+	if date == "" {
+		return ErrEmptyHolidayDate
+	}
+	if _, err := time.Parse(HolidayDateLayout, date); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidHolidayDate, date)
+	}
+	return nil
+}
+
+// Validate validates a Holiday
+func (h *Holiday) Validate() error {
+	if err := ValidateHolidayDate(h.Date); err != nil {
+		return err
+	}
+	if h.Name == "" {
+		return ErrEmptyHolidayName
+	}
+	if len(h.Name) > 200 {
+		return ErrHolidayNameTooLong
+	}
+	return nil
+}
+
+// Normalize normalizes the fields of a HolidayCalendar and its holidays
+func (c *HolidayCalendar) Normalize() {
+	c.Name = strings.ToLower(strings.TrimSpace(c.Name))
+	c.Description = strings.TrimSpace(c.Description)
+	for i := range c.Holidays {
+		c.Holidays[i].Date = strings.TrimSpace(c.Holidays[i].Date)
+		c.Holidays[i].Name = strings.TrimSpace(c.Holidays[i].Name)
+	}
+}
+
+// Validate validates a HolidayCalendar and its holidays
+func (c *HolidayCalendar) Validate() error {
+	if err := ValidateCalendarName(c.Name); err != nil {
+		return err
+	}
+	if err := ValidateDescription(c.Description); err != nil {
+		return err
+	}
+	for i := range c.Holidays {
+		if err := c.Holidays[i].Validate(); err != nil {
+			return fmt.Errorf("holiday %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+// Normalize normalizes the fields of a HolidayRequest
+func (r *HolidayRequest) Normalize() {
+	r.Date = strings.TrimSpace(r.Date)
+	r.Name = strings.TrimSpace(r.Name)
+}
+
+// Validate validates a HolidayRequest
+func (r *HolidayRequest) Validate() error {
+	return r.ToHoliday().Validate()
+}
+
+// ToHoliday converts a HolidayRequest to a custom Holiday
+func (r *HolidayRequest) ToHoliday() *Holiday {
+	return &Holiday{Date: r.Date, Name: r.Name, Custom: true}
+}
+
+// Normalize lower-cases calendar names and drops blanks and duplicates
+func (r *LocationCalendarsRequest) Normalize() {
+	seen := make(map[string]bool)
+	calendars := []string{}
+	for _, name := range r.Calendars {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && !seen[name] {
+			seen[name] = true
+			calendars = append(calendars, name)
+		}
+	}
+	r.Calendars = calendars
+}
+
+// Validate validates a LocationCalendarsRequest
+func (r *LocationCalendarsRequest) Validate() error {
+	if len(r.Calendars) > MaxLocationCalendars {
+		return ErrTooManyCalendars
+	}
+	for _, name := range r.Calendars {
+		if err := ValidateCalendarName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Normalize normalizes the fields of a HolidayRangeRequest
+func (r *HolidayRangeRequest) Normalize() {
+	r.From = strings.TrimSpace(r.From)
+	r.To = strings.TrimSpace(r.To)
+}
+
+// Range returns the inclusive dates to list. From defaults to today in now's
+// location and To to one year after From.
+func (r *HolidayRangeRequest) Range(now time.Time) (string, string, error) {
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if r.From != "" {
+		t, err := time.Parse(HolidayDateLayout, r.From)
+		if err != nil {
+			return "", "", ErrInvalidHolidayRange
+		}
+		from = t
+	}
+
+	to := from.AddDate(1, 0, 0)
+	if r.To != "" {
+		t, err := time.Parse(HolidayDateLayout, r.To)
+		if err != nil {
+			return "", "", ErrInvalidHolidayRange
+		}
+		to = t
+	}
+
+	if to.Before(from) {
+		return "", "", ErrHolidayRangeOrder
+	}
+	if to.After(from.AddDate(MaxHolidayRangeYears, 0, 0)) {
+		return "", "", ErrHolidayRangeTooLong
+	}
+
+	return from.Format(HolidayDateLayout), to.Format(HolidayDateLayout), nil
+}
+
+// NewCalendarListResponse creates a CalendarListResponse
+func NewCalendarListResponse(calendars []*HolidayCalendar) *CalendarListResponse {
+	return &CalendarListResponse{Count: len(calendars), Calendars: calendars}
+}
+
+// NewHolidayListResponse creates a HolidayListResponse
+func NewHolidayListResponse(loc *Location, from, to string, holidays []*Holiday) *HolidayListResponse {
+	return &HolidayListResponse{
+		Location: loc.Name,
+		Timezone: loc.Timezone,
+		From:     from,
+		To:       to,
+		Count:    len(holidays),
+		Holidays: holidays,
+	}
+}
+
+// NewHolidayCheckResponse creates a HolidayCheckResponse for a date
+// and the holidays found on it
+func NewHolidayCheckResponse(loc *Location, date time.Time, holidays []*Holiday) *HolidayCheckResponse {
+	if holidays == nil {
+		holidays = []*Holiday{}
+	}
+	return &HolidayCheckResponse{
+		Location:  loc.Name,
+		Timezone:  loc.Timezone,
+		Date:      date.Format(HolidayDateLayout),
+		Weekday:   date.Weekday().String(),
+		IsHoliday: len(holidays) > 0,
+		Holidays:  holidays,
+	}
+}
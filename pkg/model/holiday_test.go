@@ -0,0 +1,131 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHolidayRangeRequest(t *testing.T) {
+	now := time.Date(2025, 6, 15, 23, 30, 0, 0, time.FixedZone("UTC+10", 10*60*60))
+
+	tests := []struct {
+		name     string
+		req      HolidayRangeRequest
+		wantFrom string
+		wantTo   string
+		wantErr  error
+	}{
+		{
+			name:     "defaults to a year from today",
+			wantFrom: "2025-06-15",
+			wantTo:   "2026-06-15",
+		},
+		{
+			name:     "from only",
+			req:      HolidayRangeRequest{From: " 2025-01-01 "},
+			wantFrom: "2025-01-01",
+			wantTo:   "2026-01-01",
+		},
+		{
+			name:     "single day",
+			req:      HolidayRangeRequest{From: "2025-12-25", To: "2025-12-25"},
+			wantFrom: "2025-12-25",
+			wantTo:   "2025-12-25",
+		},
+		{
+			name:    "invalid date",
+			req:     HolidayRangeRequest{From: "25/12/2025"},
+			wantErr: ErrInvalidHolidayRange,
+		},
+		{
+			name:    "reversed",
+			req:     HolidayRangeRequest{From: "2025-12-25", To: "2025-12-24"},
+			wantErr: ErrHolidayRangeOrder,
+		},
+		{
+			name:    "too long",
+			req:     HolidayRangeRequest{From: "2025-01-01", To: "2035-01-02"},
+			wantErr: ErrHolidayRangeTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			from, to, err := tt.req.Range(now)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if from != tt.wantFrom || to != tt.wantTo {
+				t.Errorf("Range() = %s..%s, want %s..%s", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestLocationCalendarsRequest(t *testing.T) {
+	req := LocationCalendarsRequest{Calendars: []string{" UK-Bank ", "company", "uk-bank", ""}}
+	req.Normalize()
+	if len(req.Calendars) != 2 || req.Calendars[0] != "uk-bank" || req.Calendars[1] != "company" {
+		t.Errorf("Normalize() = %v, want [uk-bank company]", req.Calendars)
+	}
+	if err := req.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	req = LocationCalendarsRequest{Calendars: []string{"uk bank"}}
+	if err := req.Validate(); !errors.Is(err, ErrInvalidCalendarName) {
+		t.Errorf("expected ErrInvalidCalendarName, got %v", err)
+	}
+}
+
+func TestHolidayRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     HolidayRequest
+		wantErr error
+	}{
+		{name: "valid", req: HolidayRequest{Date: "2025-08-01", Name: "Summer party"}},
+		{name: "missing date", req: HolidayRequest{Name: "Summer party"}, wantErr: ErrEmptyHolidayDate},
+		{name: "invalid date", req: HolidayRequest{Date: "2025-02-30", Name: "x"}, wantErr: ErrInvalidHolidayDate},
+		{name: "missing name", req: HolidayRequest{Date: "2025-08-01", Name: "  "}, wantErr: ErrEmptyHolidayName},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			err := tt.req.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewHolidayCheckResponse(t *testing.T) {
+	loc := &Location{Name: "london", Timezone: "Europe/London"}
+	date := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	resp := NewHolidayCheckResponse(loc, date, []*Holiday{{Date: "2025-12-25", Name: "Christmas Day", Calendar: "uk-bank"}})
+	if !resp.IsHoliday || resp.Date != "2025-12-25" || resp.Weekday != "Thursday" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	resp = NewHolidayCheckResponse(loc, date.AddDate(0, 0, 2), nil)
+	if resp.IsHoliday || resp.Holidays == nil {
+		t.Errorf("expected non-holiday with empty list, got %+v", resp)
+	}
+}
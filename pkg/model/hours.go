@@ -0,0 +1,262 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/schedule"
+)
+
+// Business hours limits
+const (
+	MaxIntervalsPerDay = 8
+	MaxHoursOverrides  = 366
+)
+
+// Business hours validation errors
+var (
+	ErrInvalidWeekday      = errors.New("weekly keys must be weekday names such as monday")
+	ErrTooManyIntervals    = fmt.Errorf("at most %d intervals are allowed per day", MaxIntervalsPerDay)
+	ErrEmptyInterval       = errors.New("open must be before 24:00 and differ from close")
+	ErrTooManyOverrides    = fmt.Errorf("at most %d overrides are allowed", MaxHoursOverrides)
+	ErrInvalidOverrideDate = errors.New("override dates must be in YYYY-MM-DD format")
+	ErrDuplicateOverride   = errors.New("override dates must be unique")
+	ErrOverrideNoteTooLong = errors.New("override note must be 200 characters or less")
+)
+
+// weekdays maps lower-case weekday names to time.Weekday
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// BusinessHours is a weekly opening schedule with date-specific overrides.
+// Weekly is keyed by lower-case weekday name; days without intervals are closed.
+type BusinessHours struct {
+	Weekly    map[string][]OpeningInterval `json:"weekly,omitempty"`
+	Overrides []HoursOverride              `json:"overrides,omitempty"`
+}
+
+// OpeningInterval is a span of local opening time as HH:MM.
+// A close at or before the open runs past midnight.
+type OpeningInterval struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// HoursOverride replaces the weekly hours on one local date.
+// An override without intervals means closed all day.
+type HoursOverride struct {
+	Date      string            `json:"date"`
+	Intervals []OpeningInterval `json:"intervals,omitempty"`
+	Note      string            `json:"note,omitempty"`
+}
+
+// OpenStatus represents whether a business hours schedule is open at an instant
+type OpenStatus struct {
+	Status          string          `json:"status"`
+	CurrentInterval *StatusInterval `json:"current_interval,omitempty"`
+	NextOpen        string          `json:"next_open,omitempty"`
+	NextClose       string          `json:"next_close,omitempty"`
+	Override        *HoursOverride  `json:"override,omitempty"`
+}
+
+// LocationStatusResponse represents whether a location is open at an instant
+type LocationStatusResponse struct {
+	Location  string `json:"location"`
+	Timezone  string `json:"timezone"`
+	LocalTime string `json:"local_time"`
+	OpenStatus
+}
+
+// StatusInterval represents an opening period in a location's local time
+type StatusInterval struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// Location status values
+const (
+	StatusOpen    = "open"
+	StatusClosed  = "closed"
+	StatusUnknown = "unknown"
+)
+
+// IsEmpty reports whether the schedule has no weekly hours and no overrides
+func (b *BusinessHours) IsEmpty() bool {
+	if b == nil {
+		return true
+	}
+	if len(b.Overrides) > 0 {
+		return false
+	}
+	for _, intervals := range b.Weekly {
+		if len(intervals) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Normalize normalizes weekday names, times and dates
+func (b *BusinessHours) Normalize() {
+	weekly := make(map[string][]OpeningInterval, len(b.Weekly))
+	for day, intervals := range b.Weekly {
+		day = strings.ToLower(strings.TrimSpace(day))
+		weekly[day] = append(weekly[day], normalizeIntervals(intervals)...)
+	}
+	b.Weekly = weekly
+
+	for i := range b.Overrides {
+		o := &b.Overrides[i]
+		o.Date = strings.TrimSpace(o.Date)
+		o.Note = strings.TrimSpace(o.Note)
+		o.Intervals = normalizeIntervals(o.Intervals)
+	}
+}
+
+// Validate validates a BusinessHours schedule
+func (b *BusinessHours) Validate() error {
+	for day, intervals := range b.Weekly {
+		if _, ok := weekdays[day]; !ok {
+			return fmt.Errorf("%w: %s", ErrInvalidWeekday, day)
+		}
+		if err := validateIntervals(intervals); err != nil {
+			return fmt.Errorf("%s: %w", day, err)
+		}
+	}
+
+	if len(b.Overrides) > MaxHoursOverrides {
+		return ErrTooManyOverrides
+	}
+	seen := make(map[string]bool, len(b.Overrides))
+	for _, o := range b.Overrides {
+		if _, err := time.Parse(schedule.DateLayout, o.Date); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidOverrideDate, o.Date)
+		}
+		if seen[o.Date] {
+			return fmt.Errorf("%w: %s", ErrDuplicateOverride, o.Date)
+		}
+		seen[o.Date] = true
+		if len(o.Note) > 200 {
+			return ErrOverrideNoteTooLong
+		}
+		if err := validateIntervals(o.Intervals); err != nil {
+			return fmt.Errorf("%s: %w", o.Date, err)
+		}
+	}
+
+	return nil
+}
+
+// Schedule converts the business hours to a schedule.Weekly.
+// It must only be called after Validate succeeds.
+func (b *BusinessHours) Schedule() schedule.Weekly {
+	var w schedule.Weekly
+	for day, intervals := range b.Weekly {
+		w.Days[weekdays[day]] = toWorkingHours(intervals)
+	}
+	if len(b.Overrides) > 0 {
+		w.Overrides = make(map[string][]schedule.WorkingHours, len(b.Overrides))
+		for _, o := range b.Overrides {
+			w.Overrides[o.Date] = toWorkingHours(o.Intervals)
+		}
+	}
+	return w
+}
+
+// override returns the override for the local date of t, if any
+func (b *BusinessHours) override(t time.Time) *HoursOverride {
+	date := t.Format(schedule.DateLayout)
+	for i := range b.Overrides {
+		if b.Overrides[i].Date == date {
+			return &b.Overrides[i]
+		}
+	}
+	return nil
+}
+
+// NewOpenStatus reports whether hours are open at now in tz.
+// A missing or empty schedule has status unknown.
+func NewOpenStatus(hours *BusinessHours, tz *time.Location, now time.Time) OpenStatus {
+	if hours.IsEmpty() {
+		return OpenStatus{Status: StatusUnknown}
+	}
+
+	now = now.In(tz)
+	status := hours.Schedule().Status(tz, now)
+	result := OpenStatus{Status: StatusClosed, Override: hours.override(now)}
+	if status.Open {
+		result.Status = StatusOpen
+		result.CurrentInterval = &StatusInterval{
+			Open:  status.Current.Start.Format(time.RFC3339),
+			Close: status.Current.End.Format(time.RFC3339),
+		}
+	}
+	if !status.NextOpen.IsZero() {
+		result.NextOpen = status.NextOpen.Format(time.RFC3339)
+	}
+	if !status.NextClose.IsZero() {
+		result.NextClose = status.NextClose.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// NewLocationStatusResponse reports whether loc is open at now in tz
+func NewLocationStatusResponse(loc *Location, tz *time.Location, now time.Time) *LocationStatusResponse {
+	return &LocationStatusResponse{
+		Location:   loc.Name,
+		Timezone:   loc.Timezone,
+		LocalTime:  now.In(tz).Format(time.RFC3339),
+		OpenStatus: NewOpenStatus(loc.BusinessHours, tz, now),
+	}
+}
+
+// normalizeIntervals trims the times of intervals
+func normalizeIntervals(intervals []OpeningInterval) []OpeningInterval {
+	for i := range intervals {
+		intervals[i].Open = strings.TrimSpace(intervals[i].Open)
+		intervals[i].Close = strings.TrimSpace(intervals[i].Close)
+	}
+	return intervals
+}
+
+// validateIntervals validates the intervals of a single day
+func validateIntervals(intervals []OpeningInterval) error {
+	if len(intervals) > MaxIntervalsPerDay {
+		return ErrTooManyIntervals
+	}
+	for _, iv := range intervals {
+		start, err := schedule.ParseClock(iv.Open)
+		if err != nil {
+			return err
+		}
+		end, err := schedule.ParseClock(iv.Close)
+		if err != nil {
+			return err
+		}
+		if start == end || start == 24*time.Hour {
+			return ErrEmptyInterval
+		}
+	}
+	return nil
+}
+
+// toWorkingHours converts validated intervals to schedule windows
+func toWorkingHours(intervals []OpeningInterval) []schedule.WorkingHours {
+	hours := make([]schedule.WorkingHours, len(intervals))
+	for i, iv := range intervals {
+		start, _ := schedule.ParseClock(iv.Open)
+		end, _ := schedule.ParseClock(iv.Close)
+		hours[i] = schedule.WorkingHours{Start: start, End: end}
+	}
+	return hours
+}
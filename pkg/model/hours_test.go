@@ -0,0 +1,109 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/schedule"
+)
+
+func TestBusinessHoursValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hours   BusinessHours
+		wantErr error
+	}{
+		{
+			name: "split day and overnight",
+			hours: BusinessHours{Weekly: map[string][]OpeningInterval{
+				" Monday ": {{Open: "09:00", Close: "12:00"}, {Open: "14:00", Close: "18:00"}},
+				"friday":   {{Open: "20:00", Close: "02:00"}},
+			}},
+		},
+		{
+			name:    "unknown weekday",
+			hours:   BusinessHours{Weekly: map[string][]OpeningInterval{"mon": {{Open: "09:00", Close: "17:00"}}}},
+			wantErr: ErrInvalidWeekday,
+		},
+		{
+			name:    "invalid time",
+			hours:   BusinessHours{Weekly: map[string][]OpeningInterval{"monday": {{Open: "9am", Close: "17:00"}}}},
+			wantErr: schedule.ErrInvalidClock,
+		},
+		{
+			name:    "empty interval",
+			hours:   BusinessHours{Weekly: map[string][]OpeningInterval{"monday": {{Open: "09:00", Close: "09:00"}}}},
+			wantErr: ErrEmptyInterval,
+		},
+		{
+			name:    "invalid override date",
+			hours:   BusinessHours{Overrides: []HoursOverride{{Date: "25/12/2025"}}},
+			wantErr: ErrInvalidOverrideDate,
+		},
+		{
+			name:    "duplicate override",
+			hours:   BusinessHours{Overrides: []HoursOverride{{Date: "2025-12-25"}, {Date: "2025-12-25"}}},
+			wantErr: ErrDuplicateOverride,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.hours.Normalize()
+			err := tt.hours.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewLocationStatusResponse(t *testing.T) {
+	paris, _ := time.LoadLocation("Europe/Paris")
+	weekday := []OpeningInterval{{Open: "09:00", Close: "12:00"}, {Open: "14:00", Close: "18:00"}}
+	loc := &Location{
+		Name:     "shop",
+		Timezone: "Europe/Paris",
+		BusinessHours: &BusinessHours{
+			Weekly: map[string][]OpeningInterval{
+				"monday": weekday, "tuesday": weekday, "wednesday": weekday, "thursday": weekday, "friday": weekday,
+			},
+			Overrides: []HoursOverride{{Date: "2025-12-24", Intervals: []OpeningInterval{{Open: "09:00", Close: "12:00"}}, Note: "Christmas Eve"}},
+		},
+	}
+
+	resp := NewLocationStatusResponse(loc, paris, time.Date(2025, 12, 17, 10, 0, 0, 0, time.UTC))
+	if resp.Status != StatusOpen || resp.LocalTime != "2025-12-17T11:00:00+01:00" {
+		t.Errorf("unexpected status %s at %s", resp.Status, resp.LocalTime)
+	}
+	if resp.CurrentInterval == nil || resp.CurrentInterval.Open != "2025-12-17T09:00:00+01:00" {
+		t.Errorf("unexpected current interval: %+v", resp.CurrentInterval)
+	}
+	if resp.NextClose != "2025-12-17T12:00:00+01:00" || resp.NextOpen != "2025-12-17T14:00:00+01:00" {
+		t.Errorf("unexpected next close/open: %s / %s", resp.NextClose, resp.NextOpen)
+	}
+
+	resp = NewLocationStatusResponse(loc, paris, time.Date(2025, 12, 24, 15, 0, 0, 0, paris))
+	if resp.Status != StatusClosed || resp.CurrentInterval != nil {
+		t.Errorf("expected closed on Christmas Eve afternoon, got %s", resp.Status)
+	}
+	if resp.Override == nil || resp.Override.Note != "Christmas Eve" {
+		t.Errorf("expected the Christmas Eve override, got %+v", resp.Override)
+	}
+	if resp.NextOpen != "2025-12-25T09:00:00+01:00" {
+		t.Errorf("NextOpen = %s", resp.NextOpen)
+	}
+
+	loc.BusinessHours = nil
+	resp = NewLocationStatusResponse(loc, paris, time.Now())
+	if resp.Status != StatusUnknown || resp.NextOpen != "" {
+		t.Errorf("expected unknown status without business hours, got %+v", resp.OpenStatus)
+	}
+}
@@ -0,0 +1,349 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/schedule"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// Interval operations
+const (
+	IntervalUnion        = "union"
+	IntervalIntersection = "intersection"
+	IntervalDifference   = "difference"
+	IntervalGaps         = "gaps"
+)
+
+// Interval request limits
+const (
+	MaxIntervalSets   = 20
+	MaxIntervalInputs = 1000
+	MaxIntervalZones  = 20
+)
+
+// Interval validation errors
+var (
+	ErrInvalidIntervalOperation = errors.New("operation must be one of union, intersection, difference or gaps")
+	ErrNoIntervalSets           = errors.New("at least one interval set is required")
+	ErrTooManyIntervalSets      = fmt.Errorf("at most %d interval sets are allowed", MaxIntervalSets)
+	ErrTooManyIntervalInputs    = fmt.Errorf("at most %d intervals are allowed in total", MaxIntervalInputs)
+	ErrTooManyIntervalZones     = fmt.Errorf("at most %d zones are allowed", MaxIntervalZones)
+	ErrEmptyIntervalBound       = errors.New("interval start and end are required")
+	ErrIntervalOrder            = errors.New("interval end must be after start")
+	ErrGapsWindow               = errors.New("gaps requires a window")
+)
+
+// IntervalInput is a half-open span [Start, End) given as instants or local
+// times. Values without a UTC offset are read on the wall clock of Timezone,
+// which defaults to the timezone of its set.
+type IntervalInput struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// IntervalSet is a named list of intervals, which may be unsorted and overlap.
+// Timezone defaults to the timezone of the request.
+type IntervalSet struct {
+	Name      string          `json:"name,omitempty"`
+	Timezone  string          `json:"timezone,omitempty"`
+	Intervals []IntervalInput `json:"intervals"`
+}
+
+// IntervalsRequest represents a request to combine sets of intervals.
+//
+// Union covers every interval of every set, intersection the spans covered by
+// every set, difference the spans of the first set not covered by any other,
+// and gaps the spans of Window not covered by any set. Window, whose timezone
+// defaults to Timezone, bounds the result of every operation. Zones lists the
+// timezones or saved locations to show the result in, defaulting to the
+// timezones of the sets.
+type IntervalsRequest struct {
+	Operation string         `json:"operation"`
+	Sets      []IntervalSet  `json:"sets"`
+	Window    *IntervalInput `json:"window,omitempty"`
+	Timezone  string         `json:"timezone,omitempty"`
+	Zones     []string       `json:"zones,omitempty"`
+}
+
+// IntervalZone is a resolved timezone an interval result is shown in
+type IntervalZone struct {
+	// Name is the location or timezone as given in the request
+	Name string
+	// Location is the saved location name, empty for IANA timezones
+	Location string
+	TZ       *time.Location
+}
+
+// IntervalLocalTime represents an interval on the wall clock of one zone
+type IntervalLocalTime struct {
+	Name     string `json:"name"`
+	Location string `json:"location,omitempty"`
+	Timezone string `json:"timezone"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+// IntervalResult represents one interval of the result, in UTC and in each
+// requested zone
+type IntervalResult struct {
+	Start           string               `json:"start"`
+	End             string               `json:"end"`
+	DurationSeconds float64              `json:"duration_seconds"`
+	Duration        string               `json:"duration"`
+	Local           []*IntervalLocalTime `json:"local"`
+}
+
+// IntervalsResponse represents the normalized result of an interval operation
+type IntervalsResponse struct {
+	Operation    string            `json:"operation"`
+	WindowStart  string            `json:"window_start,omitempty"`
+	WindowEnd    string            `json:"window_end,omitempty"`
+	Count        int               `json:"count"`
+	TotalSeconds float64           `json:"total_seconds"`
+	Intervals    []*IntervalResult `json:"intervals"`
+}
+
+// Normalize normalizes the fields of an IntervalsRequest, filling in the
+// timezone of every set, interval and the window, and dropping duplicate zones
+func (r *IntervalsRequest) Normalize() {
+	r.Operation = strings.ToLower(strings.TrimSpace(r.Operation))
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+
+	for i := range r.Sets {
+		set := &r.Sets[i]
+		set.Name = strings.TrimSpace(set.Name)
+		set.Timezone = strings.TrimSpace(set.Timezone)
+		if set.Timezone == "" {
+			set.Timezone = r.Timezone
+		}
+		for j := range set.Intervals {
+			set.Intervals[j].normalize(set.Timezone)
+		}
+	}
+	if r.Window != nil {
+		r.Window.normalize(r.Timezone)
+	}
+
+	zones := r.Zones
+	if len(zones) == 0 {
+		for _, set := range r.Sets {
+			zones = append(zones, set.Timezone)
+		}
+	}
+	seen := make(map[string]bool)
+	r.Zones = nil
+	for _, name := range zones {
+		if name = strings.TrimSpace(name); name != "" && !seen[name] {
+			seen[name] = true
+			r.Zones = append(r.Zones, name)
+		}
+	}
+}
+
+// normalize trims the fields of an IntervalInput, defaulting its timezone to zone
+func (iv *IntervalInput) normalize(zone string) {
+	iv.Start = strings.TrimSpace(iv.Start)
+	iv.End = strings.TrimSpace(iv.End)
+	iv.Timezone = strings.TrimSpace(iv.Timezone)
+	if iv.Timezone == "" {
+		iv.Timezone = zone
+	}
+}
+
+// Validate validates an IntervalsRequest
+func (r *IntervalsRequest) Validate() error {
+	switch r.Operation {
+	case IntervalUnion, IntervalIntersection, IntervalDifference, IntervalGaps:
+	default:
+		return ErrInvalidIntervalOperation
+	}
+
+	if len(r.Sets) == 0 {
+		return ErrNoIntervalSets
+	}
+	if len(r.Sets) > MaxIntervalSets {
+		return ErrTooManyIntervalSets
+	}
+	total := 0
+	for i, set := range r.Sets {
+		total += len(set.Intervals)
+		for j, iv := range set.Intervals {
+			if iv.Start == "" || iv.End == "" {
+				return fmt.Errorf("%s: %w", intervalPath(i, j), ErrEmptyIntervalBound)
+			}
+		}
+	}
+	if total > MaxIntervalInputs {
+		return ErrTooManyIntervalInputs
+	}
+
+	if r.Window == nil {
+		if r.Operation == IntervalGaps {
+			return ErrGapsWindow
+		}
+	} else if r.Window.Start == "" || r.Window.End == "" {
+		return fmt.Errorf("window: %w", ErrEmptyIntervalBound)
+	}
+
+	if len(r.Zones) > MaxIntervalZones {
+		return ErrTooManyIntervalZones
+	}
+	return nil
+}
+
+// ZoneNames returns every timezone or saved location the request refers to,
+// in order of first appearance.
+// It must only be called after Normalize.
+func (r *IntervalsRequest) ZoneNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, set := range r.Sets {
+		for _, iv := range set.Intervals {
+			add(iv.Timezone)
+		}
+	}
+	if r.Window != nil {
+		add(r.Window.Timezone)
+	}
+	for _, name := range r.Zones {
+		add(name)
+	}
+	return names
+}
+
+// Parse parses the sets and window of the request, reading local times in the
+// timezones from zones, which must hold every name returned by ZoneNames.
+// The window is nil when the request has none.
+// It must only be called after Validate succeeds.
+func (r *IntervalsRequest) Parse(zones map[string]*time.Location, now time.Time) ([][]schedule.Interval, *schedule.Interval, error) {
+	sets := make([][]schedule.Interval, len(r.Sets))
+	for i, set := range r.Sets {
+		sets[i] = make([]schedule.Interval, len(set.Intervals))
+		for j, input := range set.Intervals {
+			iv, err := input.parse(zones[input.Timezone], now)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", intervalPath(i, j), err)
+			}
+			sets[i][j] = iv
+		}
+	}
+
+	if r.Window == nil {
+		return sets, nil, nil
+	}
+	window, err := r.Window.parse(zones[r.Window.Timezone], now)
+	if err != nil {
+		return nil, nil, fmt.Errorf("window: %w", err)
+	}
+	return sets, &window, nil
+}
+
+// parse parses an IntervalInput, reading local times in loc
+func (iv *IntervalInput) parse(loc *time.Location, now time.Time) (schedule.Interval, error) {
+	start, err := timeparse.Parse(iv.Start, loc, now)
+	if err != nil {
+		return schedule.Interval{}, fmt.Errorf("invalid start: %w", err)
+	}
+	end, err := timeparse.Parse(iv.End, loc, now)
+	if err != nil {
+		return schedule.Interval{}, fmt.Errorf("invalid end: %w", err)
+	}
+	if !end.After(start) {
+		return schedule.Interval{}, ErrIntervalOrder
+	}
+	return schedule.Interval{Start: start, End: end}, nil
+}
+
+// Apply combines the parsed sets with the request's operation, bounded by
+// window when it is not nil. The result is sorted and free of overlaps.
+func (r *IntervalsRequest) Apply(sets [][]schedule.Interval, window *schedule.Interval) []schedule.Interval {
+	var result []schedule.Interval
+	switch r.Operation {
+	case IntervalUnion:
+		result = schedule.Merge(flattenIntervals(sets))
+	case IntervalIntersection:
+		merged := make([][]schedule.Interval, len(sets))
+		for i, set := range sets {
+			merged[i] = schedule.Merge(set)
+		}
+		result = schedule.Intersect(merged...)
+	case IntervalDifference:
+		result = schedule.Subtract(schedule.Merge(sets[0]), schedule.Merge(flattenIntervals(sets[1:])))
+	case IntervalGaps:
+		return schedule.Gaps(flattenIntervals(sets), *window)
+	}
+
+	if window != nil {
+		result = schedule.Intersect(result, []schedule.Interval{*window})
+	}
+	return result
+}
+
+// NewIntervalsResponse creates an IntervalsResponse for the result of an
+// operation, showing each interval in UTC and on the wall clock of every zone
+func NewIntervalsResponse(operation string, result []schedule.Interval, window *schedule.Interval, zones []IntervalZone) *IntervalsResponse {
+	response := &IntervalsResponse{
+		Operation: operation,
+		Count:     len(result),
+		Intervals: make([]*IntervalResult, len(result)),
+	}
+	if window != nil {
+		response.WindowStart = window.Start.UTC().Format(time.RFC3339)
+		response.WindowEnd = window.End.UTC().Format(time.RFC3339)
+	}
+
+	var total time.Duration
+	for i, iv := range result {
+		length := iv.End.Sub(iv.Start)
+		total += length
+		ir := &IntervalResult{
+			Start:           iv.Start.UTC().Format(time.RFC3339),
+			End:             iv.End.UTC().Format(time.RFC3339),
+			DurationSeconds: length.Seconds(),
+			Duration:        length.String(),
+			Local:           make([]*IntervalLocalTime, len(zones)),
+		}
+		for j, z := range zones {
+			ir.Local[j] = &IntervalLocalTime{
+				Name:     z.Name,
+				Location: z.Location,
+				Timezone: z.TZ.String(),
+				Start:    iv.Start.In(z.TZ).Format(time.RFC3339),
+				End:      iv.End.In(z.TZ).Format(time.RFC3339),
+			}
+		}
+		response.Intervals[i] = ir
+	}
+	response.TotalSeconds = total.Seconds()
+
+	return response
+}
+
+// flattenIntervals returns the intervals of every set in one list
+func flattenIntervals(sets [][]schedule.Interval) []schedule.Interval {
+	var out []schedule.Interval
+	for _, set := range sets {
+		out = append(out, set...)
+	}
+	return out
+}
+
+// intervalPath names an interval of a request in error messages
+func intervalPath(set, interval int) string {
+	return fmt.Sprintf("sets[%d].intervals[%d]", set, interval)
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIntervalsRequestValidate(t *testing.T) {
+	set := IntervalSet{Intervals: []IntervalInput{{Start: "2025-01-06 09:00", End: "2025-01-06 17:00"}}}
+
+	tests := []struct {
+		name string
+		req  IntervalsRequest
+		want error
+	}{
+		{"valid union", IntervalsRequest{Operation: "Union", Sets: []IntervalSet{set}}, nil},
+		{"unknown operation", IntervalsRequest{Operation: "xor", Sets: []IntervalSet{set}}, ErrInvalidIntervalOperation},
+		{"no sets", IntervalsRequest{Operation: IntervalUnion}, ErrNoIntervalSets},
+		{"gaps without window", IntervalsRequest{Operation: IntervalGaps, Sets: []IntervalSet{set}}, ErrGapsWindow},
+		{"missing end", IntervalsRequest{Operation: IntervalUnion, Sets: []IntervalSet{{Intervals: []IntervalInput{{Start: "2025-01-06"}}}}}, ErrEmptyIntervalBound},
+		{"empty window", IntervalsRequest{Operation: IntervalGaps, Sets: []IntervalSet{set}, Window: &IntervalInput{Start: " "}}, ErrEmptyIntervalBound},
+		{"too many sets", IntervalsRequest{Operation: IntervalUnion, Sets: make([]IntervalSet, MaxIntervalSets+1)}, ErrTooManyIntervalSets},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalsRequestNormalize(t *testing.T) {
+	req := IntervalsRequest{
+		Operation: "intersection",
+		Timezone:  "Europe/London",
+		Sets: []IntervalSet{
+			{Timezone: "America/New_York", Intervals: []IntervalInput{{Start: "09:00", End: "17:00"}}},
+			{Intervals: []IntervalInput{{Start: "09:00", End: "17:00", Timezone: "hq"}}},
+		},
+		Window: &IntervalInput{Start: "2025-01-06", End: "2025-01-07"},
+	}
+	req.Normalize()
+
+	if req.Sets[0].Intervals[0].Timezone != "America/New_York" || req.Sets[1].Timezone != "Europe/London" || req.Window.Timezone != "Europe/London" {
+		t.Errorf("timezones not inherited: %+v, window %+v", req.Sets, req.Window)
+	}
+	if len(req.Zones) != 2 || req.Zones[0] != "America/New_York" || req.Zones[1] != "Europe/London" {
+		t.Errorf("Zones = %v, want the set timezones", req.Zones)
+	}
+	if names := req.ZoneNames(); len(names) != 3 || names[1] != "hq" {
+		t.Errorf("ZoneNames() = %v", names)
+	}
+}
+
+func TestIntervalsRequestApply(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	zones := map[string]*time.Location{"America/New_York": ny, "Europe/London": london, "UTC": time.UTC}
+
+	newRequest := func(operation string) IntervalsRequest {
+		req := IntervalsRequest{
+			Operation: operation,
+			Sets: []IntervalSet{
+				// Working days in London and New York, local times
+				{Name: "london", Timezone: "Europe/London", Intervals: []IntervalInput{{Start: "2025-01-06 09:00", End: "2025-01-06 17:00"}}},
+				{Name: "new-york", Timezone: "America/New_York", Intervals: []IntervalInput{{Start: "2025-01-06 09:00", End: "2025-01-06 17:00"}}},
+				// A meeting given as instants
+				{Name: "busy", Intervals: []IntervalInput{{Start: "2025-01-06T14:30:00Z", End: "2025-01-06T15:00:00Z"}}},
+			},
+			Window: &IntervalInput{Start: "2025-01-06T08:00:00Z", End: "2025-01-06T23:00:00Z"},
+		}
+		req.Normalize()
+		if err := req.Validate(); err != nil {
+			t.Fatalf("Validate() unexpected error: %v", err)
+		}
+		return req
+	}
+	at := func(h, m int) string { return time.Date(2025, 1, 6, h, m, 0, 0, time.UTC).Format(time.RFC3339) }
+
+	tests := []struct {
+		operation string
+		want      [][2]string
+	}{
+		{IntervalUnion, [][2]string{{at(9, 0), at(22, 0)}}},
+		{IntervalIntersection, [][2]string{{at(14, 30), at(15, 0)}}},
+		{IntervalDifference, [][2]string{{at(9, 0), at(14, 0)}}},
+		{IntervalGaps, [][2]string{{at(8, 0), at(9, 0)}, {at(22, 0), at(23, 0)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			req := newRequest(tt.operation)
+			sets, window, err := req.Parse(zones, time.Now())
+			if err != nil {
+				t.Fatalf("Parse() unexpected error: %v", err)
+			}
+			resp := NewIntervalsResponse(req.Operation, req.Apply(sets, window), window, []IntervalZone{{Name: "Europe/London", TZ: london}, {Name: "ny", Location: "ny", TZ: ny}})
+
+			if resp.Count != len(tt.want) {
+				t.Fatalf("expected %d intervals, got %+v", len(tt.want), resp.Intervals)
+			}
+			for i, want := range tt.want {
+				if got := resp.Intervals[i]; got.Start != want[0] || got.End != want[1] {
+					t.Errorf("interval %d = %s to %s, want %s to %s", i, got.Start, got.End, want[0], want[1])
+				}
+			}
+		})
+	}
+
+	// The overlap of both working days, shown on the New York wall clock
+	req := newRequest(IntervalIntersection)
+	req.Sets[2] = IntervalSet{Timezone: "UTC", Intervals: []IntervalInput{{Start: "2025-01-06T14:30:00Z", End: "2025-01-06T18:00:00Z", Timezone: "UTC"}}}
+	sets, window, _ := req.Parse(zones, time.Now())
+	resp := NewIntervalsResponse(req.Operation, req.Apply(sets, window), window, []IntervalZone{{Name: "ny", Location: "ny", TZ: ny}})
+	if resp.Count != 1 || resp.Intervals[0].Start != at(14, 30) || resp.Intervals[0].End != at(17, 0) {
+		t.Fatalf("unexpected intersection: %+v", resp.Intervals)
+	}
+	local := resp.Intervals[0].Local[0]
+	if local.Start != "2025-01-06T09:30:00-05:00" || local.End != "2025-01-06T12:00:00-05:00" || local.Location != "ny" {
+		t.Errorf("unexpected local time: %+v", local)
+	}
+	if resp.TotalSeconds != 9000 || resp.Intervals[0].Duration != "2h30m0s" {
+		t.Errorf("unexpected duration: %v (%s)", resp.TotalSeconds, resp.Intervals[0].Duration)
+	}
+	if resp.WindowStart != at(8, 0) || resp.WindowEnd != at(23, 0) {
+		t.Errorf("unexpected window: %s to %s", resp.WindowStart, resp.WindowEnd)
+	}
+}
+
+func TestIntervalsRequestParseErrors(t *testing.T) {
+	zones := map[string]*time.Location{"UTC": time.UTC}
+
+	req := IntervalsRequest{
+		Operation: IntervalUnion,
+		Sets:      []IntervalSet{{Intervals: []IntervalInput{{Start: "2025-01-06", End: "2025-01-07"}, {Start: "2025-01-07", End: "2025-01-06"}}}},
+	}
+	req.Normalize()
+	if _, _, err := req.Parse(zones, time.Now()); !errors.Is(err, ErrIntervalOrder) || err.Error() != "sets[0].intervals[1]: interval end must be after start" {
+		t.Errorf("Parse() = %v, want reversed interval error", err)
+	}
+
+	req.Sets[0].Intervals = req.Sets[0].Intervals[:1]
+	req.Window = &IntervalInput{Start: "soon", End: "2025-01-07", Timezone: "UTC"}
+	if _, _, err := req.Parse(zones, time.Now()); err == nil || err.Error() != `window: invalid start: unrecognized time format: "soon"` {
+		t.Errorf("Parse() = %v, want invalid window", err)
+	}
+}
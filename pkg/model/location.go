@@ -3,42 +3,74 @@ package model
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 	"time"
 )
 
-// Location represents a named location with a timezone
+// Location represents a named location with a timezone.
+// A nil Weekend means DefaultWeekend. Tags group locations, e.g. by region.
+// Coordinates are optional and needed for sun times.
 type Location struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Timezone    string    `json:"timezone"`
-	Description string    `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            int64          `json:"id"`
+	Name          string         `json:"name"`
+	Timezone      string         `json:"timezone"`
+	Description   string         `json:"description,omitempty"`
+	BusinessHours *BusinessHours `json:"business_hours,omitempty"`
+	Weekend       []string       `json:"weekend,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
+	Coordinates   *Coordinates   `json:"coordinates,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
 }
 
 // CreateLocationRequest represents the request body for creating a location
 type CreateLocationRequest struct {
-	Name        string `json:"name"`
-	Timezone    string `json:"timezone"`
-	Description string `json:"description,omitempty"`
+	Name          string         `json:"name"`
+	Timezone      string         `json:"timezone"`
+	Description   string         `json:"description,omitempty"`
+	BusinessHours *BusinessHours `json:"business_hours,omitempty"`
+	Weekend       []string       `json:"weekend,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
+	Coordinates   *Coordinates   `json:"coordinates,omitempty"`
 }
 
-// UpdateLocationRequest represents the request body for updating a location
+// UpdateLocationRequest represents the request body for updating a location.
+// Fields left out keep their stored values.
+// Description replaces the description when set; an empty string clears it.
+// BusinessHours replaces the stored schedule when set; an empty schedule clears it.
+// Weekend replaces the weekend days when set; an empty list means no weekend.
+// Tags replaces the tags when set; an empty list clears them.
+// Coordinates replaces the stored position when set; ClearCoordinates removes it.
 type UpdateLocationRequest struct {
-	Timezone    string `json:"timezone,omitempty"`
-	Description string `json:"description,omitempty"`
+	Timezone         string         `json:"timezone,omitempty"`
+	Description      *string        `json:"description,omitempty"`
+	BusinessHours    *BusinessHours `json:"business_hours,omitempty"`
+	Weekend          []string       `json:"weekend,omitempty"`
+	Tags             []string       `json:"tags,omitempty"`
+	Coordinates      *Coordinates   `json:"coordinates,omitempty"`
+	ClearCoordinates bool           `json:"clear_coordinates,omitempty"`
 }
 
 // LocationResponse represents a single location response
 type LocationResponse struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Timezone    string    `json:"timezone"`
-	Description string    `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID            int64          `json:"id"`
+	Name          string         `json:"name"`
+	Timezone      string         `json:"timezone"`
+	Description   string         `json:"description,omitempty"`
+	BusinessHours *BusinessHours `json:"business_hours,omitempty"`
+	Weekend       []string       `json:"weekend"`
+	Tags          []string       `json:"tags,omitempty"`
+	Coordinates   *Coordinates   `json:"coordinates,omitempty"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+// Coordinates represents a position in decimal degrees, north and east positive
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
 }
 
 // LocationListResponse represents a list of locations
@@ -53,6 +85,12 @@ type LocationTimeResponse struct {
 	CurrentTime time.Time `json:"current_time"`
 	UnixTime    int64     `json:"unix_time"`
 	Formatted   string    `json:"formatted"`
+	Locale      string    `json:"locale,omitempty"`
+	Localized   string    `json:"localized,omitempty"`
+	// RelativeOffset describes the location's UTC offset relative to the
+	// caller's zone in RelativeTo, e.g. "9 hours ahead"
+	RelativeTo     string `json:"relative_to,omitempty"`
+	RelativeOffset string `json:"relative_offset,omitempty"`
 }
 
 // Validation errors
@@ -63,8 +101,17 @@ var (
 	ErrEmptyTimezone      = errors.New("timezone cannot be empty")
 	ErrInvalidTimezone    = errors.New("invalid IANA timezone")
 	ErrDescriptionTooLong = errors.New("description must be 500 characters or less")
+	ErrTooManyTags        = fmt.Errorf("a location can have at most %d tags", MaxTags)
+	ErrInvalidTag         = errors.New("tags must be 1-50 alphanumeric characters, hyphens, and underscores")
+	ErrDuplicateTag       = errors.New("duplicate tag")
+	ErrInvalidLatitude    = errors.New("latitude must be between -90 and 90")
+	ErrInvalidLongitude   = errors.New("longitude must be between -180 and 180")
+	ErrCoordinatesCleared = errors.New("coordinates and clear_coordinates cannot both be set")
 )
 
+// MaxTags is the most tags a location can have
+const MaxTags = 20
+
 // Regular expression for valid location names (alphanumeric, hyphens, underscores)
 var nameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
@@ -91,7 +138,16 @@ func (l *Location) Validate() error {
 	if err := ValidateDescription(l.Description); err != nil {
 		return err
 	}
-	return nil
+	if err := ValidateWeekend(l.Weekend); err != nil {
+		return err
+	}
+	if err := ValidateTags(l.Tags); err != nil {
+		return err
+	}
+	if err := ValidateCoordinates(l.Coordinates); err != nil {
+		return err
+	}
+	return ValidateBusinessHours(l.BusinessHours)
 }
 
 // ValidateName validates a location name
@@ -141,6 +197,68 @@ func ValidateDescription(description string) error {
 	return nil
 }
 
+// NormalizeTags lower-cases and trims tags, keeping nil as nil
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	normalized := make([]string, len(tags))
+	for i, tag := range tags {
+		normalized[i] = strings.ToLower(strings.TrimSpace(tag))
+	}
+	return normalized
+}
+
+// ValidateTags validates an optional list of location tags
+func ValidateTags(tags []string) error {
+	if len(tags) > MaxTags {
+		return ErrTooManyTags
+	}
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if len(tag) > 50 || !nameRegex.MatchString(tag) {
+			return fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+		}
+		if seen[tag] {
+			return fmt.Errorf("%w: %s", ErrDuplicateTag, tag)
+		}
+		seen[tag] = true
+	}
+	return nil
+}
+
+// HasTag reports whether the location has tag, ignoring case
+func (l *Location) HasTag(tag string) bool {
+	for _, t := range l.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateCoordinates validates an optional position
+func ValidateCoordinates(c *Coordinates) error {
+	if c == nil {
+		return nil
+	}
+	if math.IsNaN(c.Latitude) || c.Latitude < -90 || c.Latitude > 90 {
+		return ErrInvalidLatitude
+	}
+	if math.IsNaN(c.Longitude) || c.Longitude < -180 || c.Longitude > 180 {
+		return ErrInvalidLongitude
+	}
+	return nil
+}
+
+// ValidateBusinessHours validates an optional business hours schedule
+func ValidateBusinessHours(hours *BusinessHours) error {
+	if hours == nil {
+		return nil
+	}
+	return hours.Validate()
+}
+
 // Validate validates a CreateLocationRequest
 func (r *CreateLocationRequest) Validate() error {
 	if err := ValidateName(r.Name); err != nil {
@@ -152,7 +270,16 @@ func (r *CreateLocationRequest) Validate() error {
 	if err := ValidateDescription(r.Description); err != nil {
 		return err
 	}
-	return nil
+	if err := ValidateWeekend(r.Weekend); err != nil {
+		return err
+	}
+	if err := ValidateTags(r.Tags); err != nil {
+		return err
+	}
+	if err := ValidateCoordinates(r.Coordinates); err != nil {
+		return err
+	}
+	return ValidateBusinessHours(r.BusinessHours)
 }
 
 // Normalize normalizes the fields of a CreateLocationRequest
@@ -160,12 +287,19 @@ func (r *CreateLocationRequest) Normalize() {
 	r.Name = strings.ToLower(strings.TrimSpace(r.Name))
 	r.Timezone = strings.TrimSpace(r.Timezone)
 	r.Description = strings.TrimSpace(r.Description)
+	r.Weekend = NormalizeWeekend(r.Weekend)
+	r.Tags = NormalizeTags(r.Tags)
+	if r.BusinessHours.IsEmpty() {
+		r.BusinessHours = nil
+	} else {
+		r.BusinessHours.Normalize()
+	}
 }
 
 // Validate validates an UpdateLocationRequest
 func (r *UpdateLocationRequest) Validate() error {
 	// At least one field must be provided
-	if r.Timezone == "" && r.Description == "" {
+	if r.Timezone == "" && r.Description == nil && r.BusinessHours == nil && r.Weekend == nil && r.Tags == nil && r.Coordinates == nil && !r.ClearCoordinates {
 		return errors.New("at least one field must be provided for update")
 	}
 
@@ -176,29 +310,58 @@ func (r *UpdateLocationRequest) Validate() error {
 		}
 	}
 
-	// Validate description
-	if err := ValidateDescription(r.Description); err != nil {
+	// Validate description if provided
+	if r.Description != nil {
+		if err := ValidateDescription(*r.Description); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateWeekend(r.Weekend); err != nil {
 		return err
 	}
 
-	return nil
+	if err := ValidateTags(r.Tags); err != nil {
+		return err
+	}
+
+	if r.Coordinates != nil && r.ClearCoordinates {
+		return ErrCoordinatesCleared
+	}
+	if err := ValidateCoordinates(r.Coordinates); err != nil {
+		return err
+	}
+
+	return ValidateBusinessHours(r.BusinessHours)
 }
 
 // Normalize normalizes the fields of an UpdateLocationRequest
 func (r *UpdateLocationRequest) Normalize() {
 	r.Timezone = strings.TrimSpace(r.Timezone)
-	r.Description = strings.TrimSpace(r.Description)
+	if r.Description != nil {
+		description := strings.TrimSpace(*r.Description)
+		r.Description = &description
+	}
+	r.Weekend = NormalizeWeekend(r.Weekend)
+	r.Tags = NormalizeTags(r.Tags)
+	if r.BusinessHours != nil {
+		r.BusinessHours.Normalize()
+	}
 }
 
 // ToResponse converts a Location to a LocationResponse
 func (l *Location) ToResponse() *LocationResponse {
 	return &LocationResponse{
-		ID:          l.ID,
-		Name:        l.Name,
-		Timezone:    l.Timezone,
-		Description: l.Description,
-		CreatedAt:   l.CreatedAt,
-		UpdatedAt:   l.UpdatedAt,
+		ID:            l.ID,
+		Name:          l.Name,
+		Timezone:      l.Timezone,
+		Description:   l.Description,
+		BusinessHours: l.BusinessHours,
+		Weekend:       l.EffectiveWeekend(),
+		Tags:          l.Tags,
+		Coordinates:   l.Coordinates,
+		CreatedAt:     l.CreatedAt,
+		UpdatedAt:     l.UpdatedAt,
 	}
 }
 
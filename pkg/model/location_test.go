@@ -2,6 +2,9 @@ package model
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -89,6 +92,66 @@ func TestValidateDescription(t *testing.T) {
 	}
 }
 
+func TestValidateTags(t *testing.T) {
+	tooMany := make([]string, MaxTags+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag%d", i)
+	}
+
+	tests := []struct {
+		name      string
+		input     []string
+		wantError error
+	}{
+		{"none", nil, nil},
+		{"valid", []string{"emea", "on-call_2"}, nil},
+		{"too many", tooMany, ErrTooManyTags},
+		{"empty tag", []string{""}, ErrInvalidTag},
+		{"invalid characters", []string{"north america"}, ErrInvalidTag},
+		{"too long", []string{strings.Repeat("a", 51)}, ErrInvalidTag},
+		{"duplicate", []string{"emea", "apac", "emea"}, ErrDuplicateTag},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateTags(NormalizeTags(tt.input)); !errors.Is(err, tt.wantError) {
+				t.Errorf("ValidateTags() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+
+	if got := NormalizeTags([]string{" EMEA "}); got[0] != "emea" {
+		t.Errorf("NormalizeTags() = %v, want [emea]", got)
+	}
+	loc := &Location{Tags: []string{"emea"}}
+	if !loc.HasTag("EMEA") || loc.HasTag("apac") {
+		t.Error("HasTag() did not match tags case-insensitively")
+	}
+}
+
+func TestValidateCoordinates(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     *Coordinates
+		wantError error
+	}{
+		{"none", nil, nil},
+		{"valid", &Coordinates{Latitude: -33.8688, Longitude: 151.2093}, nil},
+		{"poles and antimeridian", &Coordinates{Latitude: 90, Longitude: -180}, nil},
+		{"latitude too large", &Coordinates{Latitude: 90.1}, ErrInvalidLatitude},
+		{"latitude NaN", &Coordinates{Latitude: math.NaN()}, ErrInvalidLatitude},
+		{"longitude too small", &Coordinates{Longitude: -181}, ErrInvalidLongitude},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateCoordinates(tt.input); !errors.Is(err, tt.wantError) {
+				t.Errorf("ValidateCoordinates() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
 func TestNewLocation(t *testing.T) {
 	name := "HeadQuarters"
 	timezone := "America/New_York"
@@ -280,7 +343,7 @@ func TestUpdateLocationRequest_Validate(t *testing.T) {
 		{
 			name: "valid with description",
 			request: &UpdateLocationRequest{
-				Description: "Updated description",
+				Description: ptr("Updated description"),
 			},
 			wantError: false,
 		},
@@ -288,7 +351,7 @@ func TestUpdateLocationRequest_Validate(t *testing.T) {
 			name: "valid with both",
 			request: &UpdateLocationRequest{
 				Timezone:    "Asia/Tokyo",
-				Description: "Tokyo office",
+				Description: ptr("Tokyo office"),
 			},
 			wantError: false,
 		},
@@ -307,7 +370,43 @@ func TestUpdateLocationRequest_Validate(t *testing.T) {
 		{
 			name: "description too long",
 			request: &UpdateLocationRequest{
-				Description: strings.Repeat("a", 501),
+				Description: ptr(strings.Repeat("a", 501)),
+			},
+			wantError: true,
+		},
+		{
+			name: "valid with coordinates",
+			request: &UpdateLocationRequest{
+				Coordinates: &Coordinates{Latitude: 51.5074, Longitude: -0.1278},
+			},
+			wantError: false,
+		},
+		{
+			name: "clear description",
+			request: &UpdateLocationRequest{
+				Description: ptr(""),
+			},
+			wantError: false,
+		},
+		{
+			name: "clear coordinates",
+			request: &UpdateLocationRequest{
+				ClearCoordinates: true,
+			},
+			wantError: false,
+		},
+		{
+			name: "coordinates and clear coordinates",
+			request: &UpdateLocationRequest{
+				Coordinates:      &Coordinates{Latitude: 51.5074, Longitude: -0.1278},
+				ClearCoordinates: true,
+			},
+			wantError: true,
+		},
+		{
+			name: "longitude out of range",
+			request: &UpdateLocationRequest{
+				Coordinates: &Coordinates{Latitude: 0, Longitude: 180.5},
 			},
 			wantError: true,
 		},
@@ -326,7 +425,7 @@ func TestUpdateLocationRequest_Validate(t *testing.T) {
 func TestUpdateLocationRequest_Normalize(t *testing.T) {
 	req := &UpdateLocationRequest{
 		Timezone:    "  Europe/London  ",
-		Description: "  London office  ",
+		Description: ptr("  London office  "),
 	}
 
 	req.Normalize()
@@ -335,11 +434,16 @@ func TestUpdateLocationRequest_Normalize(t *testing.T) {
 		t.Errorf("Timezone = %q, want %q", req.Timezone, "Europe/London")
 	}
 
-	if req.Description != "London office" {
-		t.Errorf("Description = %q, want %q", req.Description, "London office")
+	if *req.Description != "London office" {
+		t.Errorf("Description = %q, want %q", *req.Description, "London office")
 	}
 }
 
+// ptr returns a pointer to v
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func TestLocation_ToResponse(t *testing.T) {
 	now := time.Now().UTC()
 	loc := &Location{
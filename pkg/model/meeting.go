@@ -0,0 +1,330 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/schedule"
+)
+
+// Meeting slot search limits and defaults
+const (
+	MaxMeetingLocations    = 20
+	MaxMeetingDays         = 31
+	MaxMeetingResults      = 100
+	DefaultMeetingResults  = 10
+	DefaultMeetingDuration = 30
+	DefaultMeetingStep     = 30
+	DefaultWorkdayStart    = "09:00"
+	DefaultWorkdayEnd      = "17:00"
+)
+
+// meetingDateLayout is the layout of meeting search dates
+const meetingDateLayout = "2006-01-02"
+
+// Meeting slot validation errors
+var (
+	ErrNoMeetingLocations      = errors.New("at least one location is required")
+	ErrTooManyMeetingLocations = fmt.Errorf("at most %d locations are allowed", MaxMeetingLocations)
+	ErrEmptyMeetingStart       = errors.New("start_date is required")
+	ErrInvalidMeetingDate      = errors.New("dates must be in YYYY-MM-DD format")
+	ErrMeetingRange            = fmt.Errorf("end_date must be on or after start_date and at most %d days later", MaxMeetingDays-1)
+	ErrInvalidMeetingDuration  = errors.New("duration_minutes must be between 5 and 1440")
+	ErrInvalidMeetingStep      = errors.New("step_minutes must be one of 5, 10, 15, 20, 30 or 60")
+	ErrInvalidMeetingResults   = fmt.Errorf("max_results must be between 1 and %d", MaxMeetingResults)
+	ErrUnknownHoursLocation    = errors.New("location_hours refers to a location that is not in locations")
+)
+
+// WorkingHours represents a daily working window as local HH:MM times
+type WorkingHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// MeetingSlotsRequest represents a request for meeting times that suit every location.
+// StartDate and EndDate are inclusive calendar dates in Timezone.
+type MeetingSlotsRequest struct {
+	Locations       []string                `json:"locations"`
+	StartDate       string                  `json:"start_date"`
+	EndDate         string                  `json:"end_date,omitempty"`
+	Timezone        string                  `json:"timezone,omitempty"`
+	DurationMinutes int                     `json:"duration_minutes,omitempty"`
+	StepMinutes     int                     `json:"step_minutes,omitempty"`
+	WorkingHours    *WorkingHours           `json:"working_hours,omitempty"`
+	LocationHours   map[string]WorkingHours `json:"location_hours,omitempty"`
+	IncludeWeekends bool                    `json:"include_weekends,omitempty"`
+	MaxResults      int                     `json:"max_results,omitempty"`
+}
+
+// MeetingAttendee is a resolved location taking part in a meeting search
+type MeetingAttendee struct {
+	// Name is the location or timezone as given in the request
+	Name string
+	// Location is the saved location name, empty for IANA timezones
+	Location string
+	TZ       *time.Location
+}
+
+// MeetingParticipant describes a participant and the working hours used for it
+type MeetingParticipant struct {
+	Name         string       `json:"name"`
+	Location     string       `json:"location,omitempty"`
+	Timezone     string       `json:"timezone"`
+	WorkingHours WorkingHours `json:"working_hours"`
+}
+
+// MeetingLocalTime represents a slot on one participant's wall clock
+type MeetingLocalTime struct {
+	Name         string `json:"name"`
+	Start        string `json:"start"`
+	End          string `json:"end"`
+	Offset       string `json:"offset"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// MeetingSlot represents a candidate meeting time.
+// MinBufferMinutes is the smallest distance, across participants, between the
+// slot and the start or end of that participant's working day.
+type MeetingSlot struct {
+	Start            string              `json:"start"`
+	End              string              `json:"end"`
+	MinBufferMinutes int                 `json:"min_buffer_minutes"`
+	Participants     []*MeetingLocalTime `json:"participants"`
+}
+
+// MeetingSlotsResponse represents the ranked meeting slots for a request
+type MeetingSlotsResponse struct {
+	RangeStart      string                `json:"range_start"`
+	RangeEnd        string                `json:"range_end"`
+	DurationMinutes int                   `json:"duration_minutes"`
+	Participants    []*MeetingParticipant `json:"participants"`
+	TotalCandidates int                   `json:"total_candidates"`
+	Count           int                   `json:"count"`
+	Slots           []*MeetingSlot        `json:"slots"`
+}
+
+// Normalize normalizes the fields of a MeetingSlotsRequest, dropping duplicate locations
+func (r *MeetingSlotsRequest) Normalize() {
+	seen := make(map[string]bool)
+	var locations []string
+	for _, name := range r.Locations {
+		if name = strings.TrimSpace(name); name != "" && !seen[name] {
+			seen[name] = true
+			locations = append(locations, name)
+		}
+	}
+	r.Locations = locations
+
+	r.StartDate = strings.TrimSpace(r.StartDate)
+	r.EndDate = strings.TrimSpace(r.EndDate)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.EndDate == "" {
+		r.EndDate = r.StartDate
+	}
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.DurationMinutes == 0 {
+		r.DurationMinutes = DefaultMeetingDuration
+	}
+	if r.StepMinutes == 0 {
+		r.StepMinutes = DefaultMeetingStep
+	}
+	if r.MaxResults == 0 {
+		r.MaxResults = DefaultMeetingResults
+	}
+	if r.WorkingHours == nil {
+		r.WorkingHours = &WorkingHours{Start: DefaultWorkdayStart, End: DefaultWorkdayEnd}
+	}
+}
+
+// Validate validates a MeetingSlotsRequest
+func (r *MeetingSlotsRequest) Validate() error {
+	if len(r.Locations) == 0 {
+		return ErrNoMeetingLocations
+	}
+	if len(r.Locations) > MaxMeetingLocations {
+		return ErrTooManyMeetingLocations
+	}
+
+	if r.StartDate == "" {
+		return ErrEmptyMeetingStart
+	}
+	start, err := time.Parse(meetingDateLayout, r.StartDate)
+	if err != nil {
+		return ErrInvalidMeetingDate
+	}
+	end, err := time.Parse(meetingDateLayout, r.EndDate)
+	if err != nil {
+		return ErrInvalidMeetingDate
+	}
+	if end.Before(start) || end.After(start.AddDate(0, 0, MaxMeetingDays-1)) {
+		return ErrMeetingRange
+	}
+
+	if r.DurationMinutes < 5 || r.DurationMinutes > 1440 {
+		return ErrInvalidMeetingDuration
+	}
+	switch r.StepMinutes {
+	case 5, 10, 15, 20, 30, 60:
+	default:
+		return ErrInvalidMeetingStep
+	}
+	if r.MaxResults < 1 || r.MaxResults > MaxMeetingResults {
+		return ErrInvalidMeetingResults
+	}
+
+	if _, err := parseWorkingHours(*r.WorkingHours); err != nil {
+		return err
+	}
+	for name, hours := range r.LocationHours {
+		if !containsString(r.Locations, name) {
+			return fmt.Errorf("%w: %s", ErrUnknownHoursLocation, name)
+		}
+		if _, err := parseWorkingHours(hours); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Range returns the searched span [start of StartDate, end of EndDate) in loc.
+// It must only be called after Validate succeeds.
+func (r *MeetingSlotsRequest) Range(loc *time.Location) (time.Time, time.Time) {
+	start, _ := time.ParseInLocation(meetingDateLayout, r.StartDate, loc)
+	end, _ := time.ParseInLocation(meetingDateLayout, r.EndDate, loc)
+	return start, end.AddDate(0, 0, 1)
+}
+
+// HoursFor returns the working hours for a location, falling back to the default.
+// It must only be called after Validate succeeds.
+func (r *MeetingSlotsRequest) HoursFor(name string) WorkingHours {
+	if hours, ok := r.LocationHours[name]; ok {
+		return hours
+	}
+	return *r.WorkingHours
+}
+
+// NewMeetingSlotsResponse finds the slots in [from, to) that fall within every
+// attendee's working hours and ranks them, most comfortable first.
+//
+// Working days are laid out on each attendee's own wall clock, so attendees
+// whose DST changes fall on different dates are handled day by day. Slots are
+// ranked by their smallest buffer to any attendee's start or end of day, then
+// chronologically.
+func NewMeetingSlotsResponse(req *MeetingSlotsRequest, attendees []MeetingAttendee, from, to time.Time) *MeetingSlotsResponse {
+	response := &MeetingSlotsResponse{
+		RangeStart:      from.Format(time.RFC3339),
+		RangeEnd:        to.Format(time.RFC3339),
+		DurationMinutes: req.DurationMinutes,
+		Participants:    make([]*MeetingParticipant, len(attendees)),
+		Slots:           []*MeetingSlot{},
+	}
+
+	// Working days are computed a day beyond the range so buffers are measured
+	// against whole days, then the overlap is clipped to the range
+	lists := make([][]schedule.Interval, 0, len(attendees)+1)
+	for i, a := range attendees {
+		hours := req.HoursFor(a.Name)
+		wh, _ := parseWorkingHours(hours)
+		lists = append(lists, wh.Intervals(a.TZ, from.AddDate(0, 0, -1), to.AddDate(0, 0, 1), req.IncludeWeekends))
+		response.Participants[i] = &MeetingParticipant{
+			Name:         a.Name,
+			Location:     a.Location,
+			Timezone:     a.TZ.String(),
+			WorkingHours: hours,
+		}
+	}
+	common := schedule.Intersect(append(lists, []schedule.Interval{{Start: from, End: to}})...)
+
+	length := time.Duration(req.DurationMinutes) * time.Minute
+	step := time.Duration(req.StepMinutes) * time.Minute
+	candidates := schedule.Slots(common, length, step)
+	response.TotalCandidates = len(candidates)
+
+	buffers := make([]time.Duration, len(candidates))
+	for i, slot := range candidates {
+		buffers[i] = minBuffer(slot, lists)
+	}
+	order := make([]int, len(candidates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return buffers[order[i]] > buffers[order[j]]
+	})
+	if len(order) > req.MaxResults {
+		order = order[:req.MaxResults]
+	}
+
+	for _, i := range order {
+		slot := candidates[i]
+		ms := &MeetingSlot{
+			Start:            slot.Start.UTC().Format(time.RFC3339),
+			End:              slot.End.UTC().Format(time.RFC3339),
+			MinBufferMinutes: int(buffers[i] / time.Minute),
+			Participants:     make([]*MeetingLocalTime, len(attendees)),
+		}
+		for j, a := range attendees {
+			start := slot.Start.In(a.TZ)
+			abbr, offset := start.Zone()
+			ms.Participants[j] = &MeetingLocalTime{
+				Name:         a.Name,
+				Start:        start.Format(time.RFC3339),
+				End:          slot.End.In(a.TZ).Format(time.RFC3339),
+				Offset:       FormatOffset(offset),
+				Abbreviation: abbr,
+			}
+		}
+		response.Slots = append(response.Slots, ms)
+	}
+	response.Count = len(response.Slots)
+
+	return response
+}
+
+// parseWorkingHours parses a WorkingHours into schedule form
+func parseWorkingHours(h WorkingHours) (schedule.WorkingHours, error) {
+	start, err := schedule.ParseClock(h.Start)
+	if err != nil {
+		return schedule.WorkingHours{}, err
+	}
+	end, err := schedule.ParseClock(h.End)
+	if err != nil {
+		return schedule.WorkingHours{}, err
+	}
+	return schedule.WorkingHours{Start: start, End: end}, nil
+}
+
+// minBuffer returns the smallest gap between slot and the edges of the
+// working period containing it, across all attendees
+func minBuffer(slot schedule.Interval, lists [][]schedule.Interval) time.Duration {
+	best := time.Duration(1<<63 - 1)
+	for _, list := range lists {
+		i := sort.Search(len(list), func(i int) bool { return list[i].End.After(slot.Start) })
+		if i == len(list) {
+			continue
+		}
+		if gap := slot.Start.Sub(list[i].Start); gap < best {
+			best = gap
+		}
+		if gap := list[i].End.Sub(slot.End); gap < best {
+			best = gap
+		}
+	}
+	return best
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/schedule"
+)
+
+func TestMeetingSlotsRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     MeetingSlotsRequest
+		wantErr error
+	}{
+		{
+			name: "defaults",
+			req:  MeetingSlotsRequest{Locations: []string{"london", "new-york"}, StartDate: "2025-03-10"},
+		},
+		{
+			name:    "no locations",
+			req:     MeetingSlotsRequest{Locations: []string{" "}, StartDate: "2025-03-10"},
+			wantErr: ErrNoMeetingLocations,
+		},
+		{
+			name:    "missing start date",
+			req:     MeetingSlotsRequest{Locations: []string{"london"}},
+			wantErr: ErrEmptyMeetingStart,
+		},
+		{
+			name:    "invalid date",
+			req:     MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "10/03/2025"},
+			wantErr: ErrInvalidMeetingDate,
+		},
+		{
+			name:    "end before start",
+			req:     MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "2025-03-10", EndDate: "2025-03-09"},
+			wantErr: ErrMeetingRange,
+		},
+		{
+			name:    "range too long",
+			req:     MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "2025-03-01", EndDate: "2025-04-01"},
+			wantErr: ErrMeetingRange,
+		},
+		{
+			name:    "invalid step",
+			req:     MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "2025-03-10", StepMinutes: 7},
+			wantErr: ErrInvalidMeetingStep,
+		},
+		{
+			name:    "invalid duration",
+			req:     MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "2025-03-10", DurationMinutes: 2},
+			wantErr: ErrInvalidMeetingDuration,
+		},
+		{
+			name: "invalid working hours",
+			req: MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "2025-03-10",
+				WorkingHours: &WorkingHours{Start: "9am", End: "17:00"}},
+			wantErr: schedule.ErrInvalidClock,
+		},
+		{
+			name: "hours for unknown location",
+			req: MeetingSlotsRequest{Locations: []string{"london"}, StartDate: "2025-03-10",
+				LocationHours: map[string]WorkingHours{"tokyo": {Start: "10:00", End: "18:00"}}},
+			wantErr: ErrUnknownHoursLocation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			err := tt.req.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewMeetingSlotsResponseDST(t *testing.T) {
+	london, _ := time.LoadLocation("Europe/London")
+	newYork, _ := time.LoadLocation("America/New_York")
+	attendees := []MeetingAttendee{
+		{Name: "london", Location: "london", TZ: london},
+		{Name: "America/New_York", TZ: newYork},
+	}
+
+	// New York moves to EDT on Sunday 9 March 2025, London stays on GMT
+	// until 30 March, so the overlap grows from three to four hours
+	req := &MeetingSlotsRequest{
+		Locations:       []string{"london", "America/New_York"},
+		StartDate:       "2025-03-07",
+		EndDate:         "2025-03-10",
+		DurationMinutes: 60,
+	}
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+	from, to := req.Range(time.UTC)
+
+	resp := NewMeetingSlotsResponse(req, attendees, from, to)
+
+	// Friday 14:00-17:00Z gives 5 starts, Monday 13:00-17:00Z gives 7
+	if resp.TotalCandidates != 12 {
+		t.Errorf("expected 12 candidates, got %d", resp.TotalCandidates)
+	}
+	if resp.Count != DefaultMeetingResults || len(resp.Slots) != resp.Count {
+		t.Fatalf("unexpected count %d for %d slots", resp.Count, len(resp.Slots))
+	}
+
+	best := resp.Slots[0]
+	if best.Start != "2025-03-10T14:30:00Z" || best.MinBufferMinutes != 90 {
+		t.Errorf("unexpected best slot: %s buffer %d", best.Start, best.MinBufferMinutes)
+	}
+	if p := best.Participants[1]; p.Start != "2025-03-10T10:30:00-04:00" || p.Abbreviation != "EDT" {
+		t.Errorf("unexpected New York local time: %+v", p)
+	}
+	if p := best.Participants[0]; p.Start != "2025-03-10T14:30:00Z" || p.Offset != "+00:00" {
+		t.Errorf("unexpected London local time: %+v", p)
+	}
+	if resp.Slots[1].Start != "2025-03-07T15:00:00Z" || resp.Slots[1].MinBufferMinutes != 60 {
+		t.Errorf("expected Friday 15:00Z second, got %s buffer %d", resp.Slots[1].Start, resp.Slots[1].MinBufferMinutes)
+	}
+
+	req.IncludeWeekends = true
+	resp = NewMeetingSlotsResponse(req, attendees, from, to)
+	if resp.TotalCandidates != 24 {
+		t.Errorf("expected 24 candidates with weekends, got %d", resp.TotalCandidates)
+	}
+}
+
+func TestNewMeetingSlotsResponseNoOverlap(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	newYork, _ := time.LoadLocation("America/New_York")
+	req := &MeetingSlotsRequest{
+		Locations: []string{"tokyo", "new-york"},
+		StartDate: "2025-06-02",
+	}
+	req.Normalize()
+	from, to := req.Range(time.UTC)
+
+	resp := NewMeetingSlotsResponse(req, []MeetingAttendee{
+		{Name: "tokyo", TZ: tokyo},
+		{Name: "new-york", TZ: newYork},
+	}, from, to)
+
+	if resp.Count != 0 || resp.Slots == nil {
+		t.Errorf("expected an empty slot list, got %+v", resp.Slots)
+	}
+	if resp.Participants[0].WorkingHours.Start != DefaultWorkdayStart {
+		t.Errorf("expected default working hours, got %+v", resp.Participants[0].WorkingHours)
+	}
+}
@@ -1,27 +1,71 @@
 package model
 
 import (
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/yourorg/timeservice/pkg/version"
 )
 
+// Time request validation errors
+var (
+	ErrTimezoneAndLocation = errors.New("tz and location cannot both be set")
+)
+
+// TimeRequest represents a request for the time at an instant, by default
+// now, in a timezone or saved location, by default the server's
+type TimeRequest struct {
+	Timezone string `json:"tz,omitempty"`
+	Location string `json:"location,omitempty"`
+	At       string `json:"at,omitempty"`
+}
+
+// Normalize normalizes the fields of a TimeRequest
+func (r *TimeRequest) Normalize() {
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.Location = strings.TrimSpace(r.Location)
+	r.At = strings.TrimSpace(r.At)
+}
+
+// Validate validates a TimeRequest
+func (r *TimeRequest) Validate() error {
+	if r.Timezone != "" && r.Location != "" {
+		return ErrTimezoneAndLocation
+	}
+	if r.Location != "" {
+		return ValidateName(r.Location)
+	}
+	return nil
+}
+
 // TimeResponse represents a time response with various formats
 type TimeResponse struct {
-	CurrentTime string `json:"current_time"`
-	UnixTime    int64  `json:"unix_time"`
-	Timezone    string `json:"timezone"`
-	Formatted   string `json:"formatted"`
+	CurrentTime   string `json:"current_time"`
+	UnixTime      int64  `json:"unix_time"`
+	Location      string `json:"location,omitempty"`
+	Timezone      string `json:"timezone"`
+	Offset        string `json:"offset"`
+	OffsetSeconds int    `json:"offset_seconds"`
+	Abbreviation  string `json:"abbreviation"`
+	IsDST         bool   `json:"is_dst"`
+	Formatted     string `json:"formatted"`
+	Locale        string `json:"locale,omitempty"`
+	Localized     string `json:"localized,omitempty"`
 }
 
 // NewTimeResponse creates a new TimeResponse from a time.Time
 func NewTimeResponse(t time.Time) *TimeResponse {
-	zone, _ := t.Zone()
+	zone, offset := t.Zone()
 	return &TimeResponse{
-		CurrentTime: t.Format(time.RFC3339Nano),
-		UnixTime:    t.Unix(),
-		Timezone:    zone,
-		Formatted:   t.Format(time.RFC3339),
+		CurrentTime:   t.Format(time.RFC3339Nano),
+		UnixTime:      t.Unix(),
+		Timezone:      zone,
+		Offset:        FormatOffset(offset),
+		OffsetSeconds: offset,
+		Abbreviation:  zone,
+		IsDST:         t.IsDST(),
+		Formatted:     t.Format(time.RFC3339),
 	}
 }
 
@@ -39,10 +83,30 @@ func NewServiceInfo() *ServiceInfo {
 		Service: version.ServiceName,
 		Version: version.Version,
 		Endpoints: map[string]string{
-			"time":    "GET /api/time",
-			"health":  "GET /health",
-			"mcp":     "POST /mcp",
-			"metrics": "GET /metrics",
+			"time":             "GET /api/time",
+			"convert":          "GET /api/convert",
+			"diff":             "GET /api/time/diff",
+			"add":              "GET /api/time/add",
+			"transitions":      "GET /api/time/transitions",
+			"offsets":          "GET /api/time/offsets",
+			"parse":            "GET /api/time/parse",
+			"relative":         "GET /api/time/relative",
+			"bucket":           "GET /api/time/bucket",
+			"timezones":        "GET /api/timezones",
+			"lookup":           "GET /api/timezones/lookup",
+			"worldclock":       "GET /api/worldclock",
+			"meetings":         "POST /api/meetings/slots",
+			"recurrence":       "POST /api/recurrence/expand",
+			"intervals":        "POST /api/intervals",
+			"cron":             "GET /api/cron/preview",
+			"calendar_convert": "GET /api/calendars/convert",
+			"calendars":        "GET /api/holidays/calendars",
+			"fiscal_calendars": "GET /api/fiscal/calendars",
+			"timescales":       "GET /api/timescales/convert",
+			"leap_seconds":     "GET /api/timescales/leap-seconds",
+			"health":           "GET /health",
+			"mcp":              "POST /mcp",
+			"metrics":          "GET /metrics",
 		},
 		MCPInfo: "Supports both stdio mode (--stdio flag) and HTTP transport (POST /mcp)",
 	}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -118,6 +119,29 @@ func TestTimeResponseWithDifferentTimezones(t *testing.T) {
 	}
 }
 
+func TestTimeRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     TimeRequest
+		wantErr error
+	}{
+		{"defaults", TimeRequest{}, nil},
+		{"timezone", TimeRequest{Timezone: " Asia/Tokyo ", At: "now"}, nil},
+		{"location", TimeRequest{Location: "tokyo"}, nil},
+		{"both", TimeRequest{Timezone: "UTC", Location: "tokyo"}, ErrTimezoneAndLocation},
+		{"invalid location name", TimeRequest{Location: "no spaces!"}, ErrInvalidNameFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestNewServiceInfo(t *testing.T) {
 	info := NewServiceInfo()
 
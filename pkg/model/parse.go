@@ -0,0 +1,103 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// Parse validation errors
+var ErrEmptyParseValue = errors.New("value is required")
+
+// TimeParseRequest represents a request to detect and parse a timestamp
+type TimeParseRequest struct {
+	Value    string `json:"value"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// ParsedTime represents one reading of a parsed timestamp
+type ParsedTime struct {
+	Time         string `json:"time"`
+	UTC          string `json:"utc"`
+	Unix         int64  `json:"unix"`
+	UnixMilli    int64  `json:"unix_milli"`
+	Offset       string `json:"offset"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// ParseAlternative represents another reading of an ambiguous timestamp
+type ParseAlternative struct {
+	ParsedTime
+	Format string `json:"format"`
+	Reason string `json:"reason"`
+}
+
+// TimeParseResponse represents a parsed timestamp and how it was read.
+// Local is set when the value had no offset and was read on the wall clock of
+// Timezone; Shifted is set when that wall-clock time does not exist there.
+type TimeParseResponse struct {
+	Input    string `json:"input"`
+	Timezone string `json:"timezone"`
+	Location string `json:"location,omitempty"`
+	Format   string `json:"format"`
+	ParsedTime
+	Local        bool                `json:"local"`
+	Shifted      bool                `json:"shifted,omitempty"`
+	Ambiguous    bool                `json:"ambiguous"`
+	Alternatives []*ParseAlternative `json:"alternatives,omitempty"`
+}
+
+// Normalize normalizes the fields of a TimeParseRequest
+func (r *TimeParseRequest) Normalize() {
+	r.Value = strings.TrimSpace(r.Value)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+}
+
+// Validate validates a TimeParseRequest
+func (r *TimeParseRequest) Validate() error {
+	if r.Value == "" {
+		return ErrEmptyParseValue
+	}
+	return nil
+}
+
+// newParsedTime describes an instant
+func newParsedTime(t time.Time) ParsedTime {
+	abbr, offset := t.Zone()
+	return ParsedTime{
+		Time:         t.Format(time.RFC3339Nano),
+		UTC:          t.UTC().Format(time.RFC3339Nano),
+		Unix:         t.Unix(),
+		UnixMilli:    t.UnixMilli(),
+		Offset:       FormatOffset(offset),
+		Abbreviation: abbr,
+	}
+}
+
+// NewTimeParseResponse creates a TimeParseResponse from a detection result.
+// location is the saved location name the timezone came from, if any.
+func NewTimeParseResponse(input string, loc *time.Location, location string, r timeparse.Result) *TimeParseResponse {
+	response := &TimeParseResponse{
+		Input:      input,
+		Timezone:   loc.String(),
+		Location:   location,
+		Format:     r.Format,
+		ParsedTime: newParsedTime(r.Time),
+		Local:      r.Local,
+		Shifted:    r.Shifted,
+		Ambiguous:  r.Ambiguous,
+	}
+	for _, alt := range r.Alternatives {
+		response.Alternatives = append(response.Alternatives, &ParseAlternative{
+			ParsedTime: newParsedTime(alt.Time),
+			Format:     alt.Format,
+			Reason:     alt.Reason,
+		})
+	}
+	return response
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+func TestTimeParseRequestValidate(t *testing.T) {
+	req := TimeParseRequest{Value: "  "}
+	req.Normalize()
+	if err := req.Validate(); !errors.Is(err, ErrEmptyParseValue) {
+		t.Errorf("expected ErrEmptyParseValue, got %v", err)
+	}
+	if req.Timezone != "UTC" {
+		t.Errorf("expected timezone to default to UTC, got %q", req.Timezone)
+	}
+}
+
+func TestNewTimeParseResponse(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	r, err := timeparse.Detect("2025-11-02 01:30", ny, time.Now())
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	resp := NewTimeParseResponse("2025-11-02 01:30", ny, "hq", r)
+
+	if resp.Format != timeparse.FormatDateTime || !resp.Local || !resp.Ambiguous || resp.Location != "hq" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.Time != "2025-11-02T01:30:00-04:00" || resp.Abbreviation != "EDT" || resp.UnixMilli != resp.Unix*1000 {
+		t.Errorf("unexpected parsed time: %+v", resp.ParsedTime)
+	}
+	if len(resp.Alternatives) != 1 || resp.Alternatives[0].UTC != "2025-11-02T06:30:00Z" || resp.Alternatives[0].Offset != "-05:00" {
+		t.Errorf("expected the EST reading as an alternative, got %+v", resp.Alternatives)
+	}
+}
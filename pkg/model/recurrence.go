@@ -0,0 +1,236 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/recurrence"
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// Recurrence expansion limits and defaults
+const (
+	MaxRecurrenceDates       = 500
+	MaxRecurrenceResults     = 1000
+	DefaultRecurrenceResults = 100
+)
+
+// Recurrence validation errors
+var (
+	ErrEmptyDTStart             = errors.New("dtstart is required")
+	ErrEmptyRecurrence          = errors.New("rrule or rdate is required")
+	ErrTooManyRecurrenceDates   = fmt.Errorf("rdate and exdate are limited to %d values each", MaxRecurrenceDates)
+	ErrInvalidRecurrenceResults = fmt.Errorf("max_results must be between 1 and %d", MaxRecurrenceResults)
+	ErrRecurrenceWindow         = errors.New("to must be after from")
+)
+
+// RecurrenceExpandRequest represents a request to expand a recurrence set.
+// Date-times without a UTC offset are read on the wall clock of Timezone, which
+// may be an IANA timezone or a saved location.
+type RecurrenceExpandRequest struct {
+	DTStart    string   `json:"dtstart"`
+	Timezone   string   `json:"timezone,omitempty"`
+	RRule      string   `json:"rrule,omitempty"`
+	RDate      []string `json:"rdate,omitempty"`
+	ExDate     []string `json:"exdate,omitempty"`
+	From       string   `json:"from,omitempty"`
+	To         string   `json:"to,omitempty"`
+	MaxResults int      `json:"max_results,omitempty"`
+}
+
+// RecurrenceOccurrence represents one occurrence of a recurrence set.
+// Repeated marks a local time that happens twice, of which the first is used;
+// Shifted marks a DTSTART or RDATE in a DST gap, moved forward by the gap.
+type RecurrenceOccurrence struct {
+	Time         string `json:"time"`
+	UTC          string `json:"utc"`
+	LocalTime    string `json:"local_time"`
+	Weekday      string `json:"weekday"`
+	Offset       string `json:"offset"`
+	Abbreviation string `json:"abbreviation"`
+	Source       string `json:"source"`
+	Repeated     bool   `json:"repeated,omitempty"`
+	Shifted      bool   `json:"shifted,omitempty"`
+}
+
+// RecurrenceExpandResponse represents the occurrences of a recurrence set in a window.
+// Skipped lists the local times of RRULE instances dropped because they fall in
+// a DST gap.
+type RecurrenceExpandResponse struct {
+	Timezone    string                  `json:"timezone"`
+	Location    string                  `json:"location,omitempty"`
+	DTStart     string                  `json:"dtstart"`
+	RRule       string                  `json:"rrule,omitempty"`
+	From        string                  `json:"from"`
+	To          string                  `json:"to,omitempty"`
+	Count       int                     `json:"count"`
+	Truncated   bool                    `json:"truncated"`
+	Occurrences []*RecurrenceOccurrence `json:"occurrences"`
+	Skipped     []string                `json:"skipped"`
+}
+
+// Normalize normalizes the fields of a RecurrenceExpandRequest, splitting
+// comma-separated RDATE and EXDATE values
+func (r *RecurrenceExpandRequest) Normalize() {
+	r.DTStart = strings.TrimSpace(r.DTStart)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	r.RRule = strings.TrimSpace(r.RRule)
+	r.From = strings.TrimSpace(r.From)
+	r.To = strings.TrimSpace(r.To)
+	r.RDate = splitDateList(r.RDate)
+	r.ExDate = splitDateList(r.ExDate)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	if r.MaxResults == 0 {
+		r.MaxResults = DefaultRecurrenceResults
+	}
+}
+
+// Validate validates a RecurrenceExpandRequest
+func (r *RecurrenceExpandRequest) Validate() error {
+	if r.DTStart == "" {
+		return ErrEmptyDTStart
+	}
+	if _, err := recurrence.ParseDateTime(r.DTStart); err != nil {
+		return fmt.Errorf("dtstart: %w", err)
+	}
+	if r.RRule == "" && len(r.RDate) == 0 {
+		return ErrEmptyRecurrence
+	}
+	if r.RRule != "" {
+		if _, err := recurrence.ParseRule(r.RRule); err != nil {
+			return err
+		}
+	}
+	if len(r.RDate) > MaxRecurrenceDates || len(r.ExDate) > MaxRecurrenceDates {
+		return ErrTooManyRecurrenceDates
+	}
+	for _, value := range r.RDate {
+		if _, err := recurrence.ParseDateTime(value); err != nil {
+			return fmt.Errorf("rdate: %w", err)
+		}
+	}
+	for _, value := range r.ExDate {
+		if _, err := recurrence.ParseDateTime(value); err != nil {
+			return fmt.Errorf("exdate: %w", err)
+		}
+	}
+
+	var from, to recurrence.DateTime
+	var err error
+	if r.From != "" {
+		if from, err = recurrence.ParseDateTime(r.From); err != nil {
+			return fmt.Errorf("from: %w", err)
+		}
+	}
+	if r.To != "" {
+		if to, err = recurrence.ParseDateTime(r.To); err != nil {
+			return fmt.Errorf("to: %w", err)
+		}
+		// Only values of the same kind can be compared before the timezone is known
+		if r.From != "" && from.Absolute == to.Absolute && !to.Time.After(from.Time) {
+			return ErrRecurrenceWindow
+		}
+	}
+
+	if r.MaxResults < 1 || r.MaxResults > MaxRecurrenceResults {
+		return ErrInvalidRecurrenceResults
+	}
+	return nil
+}
+
+// Set returns the recurrence set described by the request, in loc.
+// It must only be called after Validate succeeds.
+func (r *RecurrenceExpandRequest) Set(loc *time.Location) *recurrence.Set {
+	set := &recurrence.Set{Location: loc}
+	set.Start, _ = recurrence.ParseDateTime(r.DTStart)
+	if r.RRule != "" {
+		set.Rule, _ = recurrence.ParseRule(r.RRule)
+	}
+	for _, value := range r.RDate {
+		dt, _ := recurrence.ParseDateTime(value)
+		set.RDates = append(set.RDates, dt)
+	}
+	for _, value := range r.ExDate {
+		dt, _ := recurrence.ParseDateTime(value)
+		set.ExDates = append(set.ExDates, dt)
+	}
+	return set
+}
+
+// Window returns the window [from, to) to expand in loc. From defaults to
+// DTSTART and a missing To gives a zero time, leaving the window open.
+// It must only be called after Validate succeeds.
+func (r *RecurrenceExpandRequest) Window(loc *time.Location) (time.Time, time.Time) {
+	value := r.From
+	if value == "" {
+		value = r.DTStart
+	}
+	from, _ := recurrence.ParseDateTime(value)
+	start, _ := timecalc.ResolveWall(from.Wall(loc), loc)
+
+	var end time.Time
+	if r.To != "" {
+		to, _ := recurrence.ParseDateTime(r.To)
+		end, _ = timecalc.ResolveWall(to.Wall(loc), loc)
+	}
+	return start, end
+}
+
+// NewRecurrenceExpandResponse creates a response from the expansion of set over [from, to).
+// location is the saved location name the timezone came from, if any.
+func NewRecurrenceExpandResponse(set *recurrence.Set, location string, from, to time.Time, exp *recurrence.Expansion) *RecurrenceExpandResponse {
+	start, _ := timecalc.ResolveWall(set.Start.Wall(set.Location), set.Location)
+	response := &RecurrenceExpandResponse{
+		Timezone:    set.Location.String(),
+		Location:    location,
+		DTStart:     start.Format(time.RFC3339),
+		From:        from.Format(time.RFC3339),
+		Count:       len(exp.Occurrences),
+		Truncated:   exp.Truncated,
+		Occurrences: make([]*RecurrenceOccurrence, len(exp.Occurrences)),
+		Skipped:     make([]string, len(exp.Skipped)),
+	}
+	if set.Rule != nil {
+		response.RRule = set.Rule.String()
+	}
+	if !to.IsZero() {
+		response.To = to.Format(time.RFC3339)
+	}
+
+	for i, o := range exp.Occurrences {
+		abbr, offset := o.Time.Zone()
+		response.Occurrences[i] = &RecurrenceOccurrence{
+			Time:         o.Time.Format(time.RFC3339),
+			UTC:          o.Time.UTC().Format(time.RFC3339),
+			LocalTime:    o.Time.Format("2006-01-02 15:04:05"),
+			Weekday:      o.Time.Weekday().String(),
+			Offset:       FormatOffset(offset),
+			Abbreviation: abbr,
+			Source:       string(o.Source),
+			Repeated:     o.Resolution == timecalc.WallRepeated,
+			Shifted:      o.Resolution == timecalc.WallSkipped,
+		}
+	}
+	for i, wall := range exp.Skipped {
+		response.Skipped[i] = wall.Format("2006-01-02 15:04:05")
+	}
+
+	return response
+}
+
+// splitDateList splits comma-separated date values and drops empty ones
+func splitDateList(values []string) []string {
+	var out []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
+}
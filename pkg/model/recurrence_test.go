@@ -0,0 +1,123 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/recurrence"
+)
+
+func TestRecurrenceExpandRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     RecurrenceExpandRequest
+		wantErr error
+	}{
+		{
+			name: "rule",
+			req:  RecurrenceExpandRequest{DTStart: "20250303T090000", RRule: "FREQ=WEEKLY;BYDAY=MO,WE"},
+		},
+		{
+			name: "rdates only",
+			req:  RecurrenceExpandRequest{DTStart: "2025-03-03T09:00", RDate: []string{"20250310T090000,20250317T090000"}},
+		},
+		{
+			name:    "missing dtstart",
+			req:     RecurrenceExpandRequest{RRule: "FREQ=DAILY"},
+			wantErr: ErrEmptyDTStart,
+		},
+		{
+			name:    "invalid dtstart",
+			req:     RecurrenceExpandRequest{DTStart: "tomorrow", RRule: "FREQ=DAILY"},
+			wantErr: recurrence.ErrInvalidDateTime,
+		},
+		{
+			name:    "nothing to repeat",
+			req:     RecurrenceExpandRequest{DTStart: "20250303T090000"},
+			wantErr: ErrEmptyRecurrence,
+		},
+		{
+			name:    "invalid rule",
+			req:     RecurrenceExpandRequest{DTStart: "20250303T090000", RRule: "FREQ=DAILY;BYHOUR=25"},
+			wantErr: recurrence.ErrInvalidRule,
+		},
+		{
+			name:    "invalid exdate",
+			req:     RecurrenceExpandRequest{DTStart: "20250303T090000", RRule: "FREQ=DAILY", ExDate: []string{"20250304T090000,soon"}},
+			wantErr: recurrence.ErrInvalidDateTime,
+		},
+		{
+			name:    "window ends before it starts",
+			req:     RecurrenceExpandRequest{DTStart: "20250303T090000", RRule: "FREQ=DAILY", From: "2025-04-01", To: "2025-03-01"},
+			wantErr: ErrRecurrenceWindow,
+		},
+		{
+			name:    "too many results",
+			req:     RecurrenceExpandRequest{DTStart: "20250303T090000", RRule: "FREQ=DAILY", MaxResults: MaxRecurrenceResults + 1},
+			wantErr: ErrInvalidRecurrenceResults,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			err := tt.req.Validate()
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewRecurrenceExpandResponse(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	req := RecurrenceExpandRequest{
+		DTStart: "2025-03-07T02:30:00",
+		RRule:   "freq=daily;count=5",
+		ExDate:  []string{"20250311T023000"},
+		From:    "2025-03-08",
+	}
+	req.Normalize()
+	if err := req.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if req.Timezone != "UTC" || req.MaxResults != DefaultRecurrenceResults {
+		t.Errorf("unexpected defaults: %q, %d", req.Timezone, req.MaxResults)
+	}
+
+	set := req.Set(ny)
+	from, to := req.Window(ny)
+	exp, err := set.Expand(from, to, req.MaxResults)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	resp := NewRecurrenceExpandResponse(set, "nyc", from, to, exp)
+
+	if resp.RRule != "FREQ=DAILY;COUNT=5" || resp.To != "" || resp.Location != "nyc" {
+		t.Errorf("unexpected response header: %+v", resp)
+	}
+	// 7 March is before the window, 9 March 02:30 does not exist and 11 March is excluded
+	want := []string{"2025-03-08T02:30:00-05:00", "2025-03-10T02:30:00-04:00", "2025-03-12T02:30:00-04:00"}
+	if resp.Count != len(want) {
+		t.Fatalf("expected %d occurrences, got %+v", len(want), resp.Occurrences)
+	}
+	for i, w := range want {
+		if resp.Occurrences[i].Time != w {
+			t.Errorf("occurrence %d = %s, want %s", i, resp.Occurrences[i].Time, w)
+		}
+	}
+	if resp.Occurrences[1].Offset != "-04:00" || resp.Occurrences[1].Abbreviation != "EDT" || resp.Occurrences[1].Source != "rrule" {
+		t.Errorf("unexpected occurrence: %+v", resp.Occurrences[1])
+	}
+	if len(resp.Skipped) != 1 || resp.Skipped[0] != "2025-03-09 02:30:00" {
+		t.Errorf("expected 9 March to be skipped, got %v", resp.Skipped)
+	}
+}
@@ -0,0 +1,145 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/humanize"
+)
+
+// Relative time limits and defaults
+const (
+	MaxRelativePrecision = 7
+	DefaultGranularity   = "second"
+)
+
+// Relative time directions
+const (
+	RelativeFuture = "future"
+	RelativePast   = "past"
+	RelativeNow    = "now"
+)
+
+// Relative time validation errors
+var (
+	ErrEmptyRelativeTime = errors.New("time is required")
+	ErrInvalidPrecision  = fmt.Errorf("precision must be between 1 and %d", MaxRelativePrecision)
+)
+
+// HumanizeOptions select how an instant is described relative to another
+type HumanizeOptions struct {
+	// Granularity is the finest unit shown, from second to year
+	Granularity string `json:"granularity,omitempty"`
+	// Precision is the most units shown
+	Precision int `json:"precision,omitempty"`
+}
+
+// RelativeTimeRequest represents a request to describe an instant relative
+// to now or to a reference instant
+type RelativeTimeRequest struct {
+	Time      string `json:"time"`
+	Reference string `json:"reference,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+	Calendar  bool   `json:"calendar"`
+	HumanizeOptions
+}
+
+// RelativeTimeResponse represents an instant described relative to a
+// reference. Seconds is negative when Time is before Reference.
+type RelativeTimeResponse struct {
+	Time      *ZonedTime `json:"time"`
+	Reference *ZonedTime `json:"reference"`
+	Seconds   float64    `json:"seconds"`
+	Direction string     `json:"direction"`
+	Humanized string     `json:"humanized"`
+}
+
+// Normalize normalizes HumanizeOptions, applying defaults
+func (o *HumanizeOptions) Normalize() {
+	o.Granularity = strings.ToLower(strings.TrimSpace(o.Granularity))
+	if o.Granularity == "" {
+		o.Granularity = DefaultGranularity
+	}
+	if o.Precision == 0 {
+		o.Precision = 1
+	}
+}
+
+// Validate validates HumanizeOptions
+func (o *HumanizeOptions) Validate() error {
+	if _, err := humanize.ParseUnit(o.Granularity); err != nil {
+		return fmt.Errorf("granularity: %w", err)
+	}
+	if o.Precision < 1 || o.Precision > MaxRelativePrecision {
+		return ErrInvalidPrecision
+	}
+	return nil
+}
+
+// Describe describes t relative to ref, with calendar phrases such as
+// "tomorrow at 09:00 Tokyo time" when calendar is set. location is the saved
+// location name t's timezone came from, if any, and names the calendar
+// phrases. It must only be called after Validate succeeds.
+func (o *HumanizeOptions) Describe(t, ref time.Time, location string, calendar bool) string {
+	unit, _ := humanize.ParseUnit(o.Granularity)
+	label := humanize.ZoneLabel(t.Location())
+	if location != "" {
+		label = location + " time"
+	}
+	return humanize.Relative(t, ref, humanize.Options{
+		Granularity: unit,
+		Precision:   o.Precision,
+		Calendar:    calendar,
+		Label:       label,
+	})
+}
+
+// Normalize normalizes the fields of a RelativeTimeRequest
+func (r *RelativeTimeRequest) Normalize() {
+	r.Time = strings.TrimSpace(r.Time)
+	r.Reference = strings.TrimSpace(r.Reference)
+	r.Timezone = strings.TrimSpace(r.Timezone)
+	if r.Timezone == "" {
+		r.Timezone = "UTC"
+	}
+	r.HumanizeOptions.Normalize()
+}
+
+// Validate validates a RelativeTimeRequest
+func (r *RelativeTimeRequest) Validate() error {
+	if r.Time == "" {
+		return ErrEmptyRelativeTime
+	}
+	return r.HumanizeOptions.Validate()
+}
+
+// NewRelativeTimeResponse creates a RelativeTimeResponse describing t relative
+// to ref. location is the saved location name the timezone came from, if any.
+// It must only be called after Validate succeeds.
+func NewRelativeTimeResponse(req *RelativeTimeRequest, t, ref time.Time, location string) *RelativeTimeResponse {
+	d := t.Sub(ref)
+	direction := RelativeNow
+	switch {
+	case d > 0:
+		direction = RelativeFuture
+	case d < 0:
+		direction = RelativePast
+	}
+	return &RelativeTimeResponse{
+		Time:      NewZonedTime(t, location),
+		Reference: NewZonedTime(ref, location),
+		Seconds:   d.Seconds(),
+		Direction: direction,
+		Humanized: req.Describe(t, ref, location, req.Calendar),
+	}
+}
+
+// RelativeOffset describes the UTC offset of t relative to the offset of the
+// same instant in loc, e.g. "9 hours ahead"
+func RelativeOffset(t time.Time, loc *time.Location) string {
+	_, offset := t.Zone()
+	_, other := t.In(loc).Zone()
+	return humanize.Offset(offset - other)
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRelativeTimeRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     RelativeTimeRequest
+		wantErr error
+	}{
+		{"defaults", RelativeTimeRequest{Time: "now"}, nil},
+		{"plural granularity", RelativeTimeRequest{Time: "now", HumanizeOptions: HumanizeOptions{Granularity: "Minutes", Precision: 2}}, nil},
+		{"missing time", RelativeTimeRequest{}, ErrEmptyRelativeTime},
+		{"precision too high", RelativeTimeRequest{Time: "now", HumanizeOptions: HumanizeOptions{Precision: 8}}, ErrInvalidPrecision},
+		{"negative precision", RelativeTimeRequest{Time: "now", HumanizeOptions: HumanizeOptions{Precision: -1}}, ErrInvalidPrecision},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	req := RelativeTimeRequest{Time: "now", HumanizeOptions: HumanizeOptions{Granularity: "fortnight"}}
+	req.Normalize()
+	if err := req.Validate(); err == nil {
+		t.Error("expected an error for an unknown granularity")
+	}
+}
+
+func TestNewRelativeTimeResponse(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	ref := time.Date(2025, 6, 10, 15, 0, 0, 0, tokyo)
+
+	req := RelativeTimeRequest{Time: "x", Calendar: true}
+	req.Normalize()
+	resp := NewRelativeTimeResponse(&req, time.Date(2025, 6, 11, 9, 0, 0, 0, tokyo), ref, "")
+	if resp.Humanized != "tomorrow at 09:00 Tokyo time" || resp.Direction != RelativeFuture || resp.Seconds != 18*3600 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	resp = NewRelativeTimeResponse(&req, ref.Add(-26*time.Hour), ref, "hq")
+	if resp.Humanized != "yesterday at 13:00 hq time" || resp.Direction != RelativePast || resp.Time.Location != "hq" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	req.Calendar = false
+	req.Precision = 2
+	resp = NewRelativeTimeResponse(&req, ref.Add(-26*time.Hour), ref, "")
+	if resp.Humanized != "1 day and 2 hours ago" {
+		t.Errorf("expected a relative description, got %q", resp.Humanized)
+	}
+}
+
+func TestConvertRequestZonedTime(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	now := time.Date(2025, 6, 10, 14, 30, 0, 0, time.UTC)
+	instant := time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC)
+
+	req := ConvertRequest{To: []string{"Asia/Tokyo"}}
+	req.Normalize()
+	if z := req.ZonedTime(instant.In(tokyo), "", now); z.Humanized != "" {
+		t.Errorf("expected no description without humanize, got %q", z.Humanized)
+	}
+
+	req.Humanize = true
+	if z := req.ZonedTime(instant.In(tokyo), "", now); z.Humanized != "tomorrow at 09:00 Tokyo time" {
+		t.Errorf("unexpected description %q", z.Humanized)
+	}
+	if z := req.ZonedTime(instant, "", now); z.Humanized != "tomorrow at 00:00 UTC" {
+		t.Errorf("unexpected description %q", z.Humanized)
+	}
+}
+
+func TestRelativeOffset(t *testing.T) {
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+	ny, _ := time.LoadLocation("America/New_York")
+	now := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		t    time.Time
+		loc  *time.Location
+		want string
+	}{
+		{now.In(tokyo), ny, "13 hours ahead"},
+		{now.In(ny), kolkata, "9 hours and 30 minutes behind"},
+		{now.In(tokyo), tokyo, "same time"},
+	}
+	for _, tt := range tests {
+		if got := RelativeOffset(tt.t, tt.loc); got != tt.want {
+			t.Errorf("RelativeOffset(%s, %s) = %q, want %q", tt.t.Location(), tt.loc, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/solar"
+)
+
+// SunDateLayout is the layout of sun time dates
+const SunDateLayout = "2006-01-02"
+
+// Sun time errors
+var (
+	ErrNoCoordinates  = errors.New("location has no coordinates; set latitude and longitude first")
+	ErrInvalidSunDate = errors.New("date must be in YYYY-MM-DD format")
+)
+
+// SunTwilight represents one kind of twilight, which lasts while the centre
+// of the sun is between the horizon and Altitude. Dawn is when it begins and
+// Dusk when it ends. Both are omitted when the sun stays above Altitude all
+// night, so the sky never gets darker, or below it all day.
+type SunTwilight struct {
+	Altitude       float64 `json:"altitude"`
+	Dawn           string  `json:"dawn,omitempty"`
+	Dusk           string  `json:"dusk,omitempty"`
+	SunAlwaysAbove bool    `json:"sun_always_above,omitempty"`
+	SunAlwaysBelow bool    `json:"sun_always_below,omitempty"`
+}
+
+// SunResponse represents the sunrise, sunset and twilight times of a location
+// on one day, in the location's timezone. Sunrise and Sunset are omitted
+// during polar day and polar night.
+type SunResponse struct {
+	Location          string       `json:"location"`
+	Timezone          string       `json:"timezone"`
+	Date              string       `json:"date"`
+	Latitude          float64      `json:"latitude"`
+	Longitude         float64      `json:"longitude"`
+	Sunrise           string       `json:"sunrise,omitempty"`
+	Sunset            string       `json:"sunset,omitempty"`
+	SolarNoon         string       `json:"solar_noon"`
+	SolarNoonAltitude float64      `json:"solar_noon_altitude"`
+	DayLength         string       `json:"day_length"`
+	DayLengthSeconds  float64      `json:"day_length_seconds"`
+	PolarDay          bool         `json:"polar_day"`
+	PolarNight        bool         `json:"polar_night"`
+	Civil             *SunTwilight `json:"civil_twilight"`
+	Nautical          *SunTwilight `json:"nautical_twilight"`
+	Astronomical      *SunTwilight `json:"astronomical_twilight"`
+}
+
+// ParseSunDate parses a YYYY-MM-DD date as midnight in tz, defaulting to
+// today in tz when date is empty
+func ParseSunDate(date string, tz *time.Location, now time.Time) (time.Time, error) {
+	if date == "" {
+		now = now.In(tz)
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, tz), nil
+	}
+	t, err := time.ParseInLocation(SunDateLayout, date, tz)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidSunDate, date)
+	}
+	return t, nil
+}
+
+// NewSunResponse computes the sun times of loc on the calendar day of date in
+// tz. It returns ErrNoCoordinates when the location has no position.
+func NewSunResponse(loc *Location, tz *time.Location, date time.Time) (*SunResponse, error) {
+	if loc.Coordinates == nil {
+		return nil, ErrNoCoordinates
+	}
+	c := loc.Coordinates
+	date = date.In(tz)
+	day := solar.ForDate(date.Year(), date.Month(), date.Day(), tz, c.Latitude, c.Longitude)
+	length := day.DayLength()
+
+	response := &SunResponse{
+		Location:          loc.Name,
+		Timezone:          tz.String(),
+		Date:              date.Format(SunDateLayout),
+		Latitude:          c.Latitude,
+		Longitude:         c.Longitude,
+		SolarNoon:         day.Noon.Format(time.RFC3339),
+		SolarNoonAltitude: math.Round(day.NoonAltitude*100) / 100,
+		DayLength:         length.String(),
+		DayLengthSeconds:  length.Seconds(),
+		PolarDay:          day.PolarDay(),
+		PolarNight:        day.PolarNight(),
+		Civil:             newSunTwilight(day.Civil, solar.CivilAltitude),
+		Nautical:          newSunTwilight(day.Nautical, solar.NauticalAltitude),
+		Astronomical:      newSunTwilight(day.Astronomical, solar.AstronomicalAltitude),
+	}
+	if !day.Sun.Rise.IsZero() {
+		response.Sunrise = day.Sun.Rise.Format(time.RFC3339)
+		response.Sunset = day.Sun.Set.Format(time.RFC3339)
+	}
+	return response, nil
+}
+
+// newSunTwilight creates a SunTwilight from the crossings of its altitude
+func newSunTwilight(c solar.Crossing, altitude float64) *SunTwilight {
+	twilight := &SunTwilight{
+		Altitude:       altitude,
+		SunAlwaysAbove: c.AlwaysAbove,
+		SunAlwaysBelow: c.AlwaysBelow,
+	}
+	if !c.Rise.IsZero() {
+		twilight.Dawn = c.Rise.Format(time.RFC3339)
+		twilight.Dusk = c.Set.Format(time.RFC3339)
+	}
+	return twilight
+}
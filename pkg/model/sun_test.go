@@ -0,0 +1,64 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseSunDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	// 20:00 UTC is already the next day in Tokyo
+	now := time.Date(2025, 6, 30, 20, 0, 0, 0, time.UTC)
+	got, err := ParseSunDate("", tokyo, now)
+	if err != nil || !got.Equal(time.Date(2025, 7, 1, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("ParseSunDate() = %v, %v, want 2025-07-01 in Tokyo", got, err)
+	}
+	if _, err := ParseSunDate("2025-02-30", tokyo, now); !errors.Is(err, ErrInvalidSunDate) {
+		t.Errorf("expected invalid date, got %v", err)
+	}
+}
+
+func TestNewSunResponse(t *testing.T) {
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	loc := &Location{Name: "tromso", Timezone: "Europe/Oslo", Coordinates: &Coordinates{Latitude: 69.6492, Longitude: 18.9553}}
+
+	resp, err := NewSunResponse(loc, oslo, time.Date(2025, 3, 20, 0, 0, 0, 0, oslo))
+	if err != nil {
+		t.Fatalf("NewSunResponse() unexpected error: %v", err)
+	}
+	if resp.Date != "2025-03-20" || resp.Location != "tromso" || resp.PolarDay || resp.PolarNight {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if resp.Sunrise[:11] != "2025-03-20T" || resp.Sunrise[19:] != "+01:00" || resp.Civil.Dawn == "" || resp.Astronomical.Dusk == "" {
+		t.Errorf("expected times in Oslo time, got sunrise %s and %+v", resp.Sunrise, resp.Civil)
+	}
+	// Near the equinox days are about 12 hours long everywhere
+	if resp.DayLengthSeconds < 12*3600 || resp.DayLengthSeconds > 12.5*3600 {
+		t.Errorf("day length = %s", resp.DayLength)
+	}
+
+	resp, _ = NewSunResponse(loc, oslo, time.Date(2025, 6, 21, 0, 0, 0, 0, oslo))
+	if !resp.PolarDay || resp.Sunrise != "" || resp.DayLength != "24h0m0s" || !resp.Civil.SunAlwaysAbove {
+		t.Errorf("expected polar day, got %+v", resp)
+	}
+	if resp.SolarNoon[:16] != "2025-06-21T12:46" {
+		t.Errorf("solar noon = %s", resp.SolarNoon)
+	}
+
+	resp, _ = NewSunResponse(loc, oslo, time.Date(2025, 12, 21, 0, 0, 0, 0, oslo))
+	if !resp.PolarNight || resp.DayLengthSeconds != 0 || resp.Civil.Dawn == "" || resp.SolarNoonAltitude >= 0 {
+		t.Errorf("expected polar night with civil twilight, got %+v", resp)
+	}
+
+	if _, err := NewSunResponse(&Location{Name: "hq", Timezone: "UTC"}, time.UTC, time.Now()); err != ErrNoCoordinates {
+		t.Errorf("expected %v, got %v", ErrNoCoordinates, err)
+	}
+}
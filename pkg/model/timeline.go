@@ -0,0 +1,147 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/humanize"
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// Offset timeline validation errors
+var (
+	ErrEmptyTimelineZones = errors.New("zone and other are both required")
+)
+
+// OffsetTimelineRequest represents a request for how the offset between two
+// timezones or saved locations changes over a range. Start defaults to now
+// and End to one year after Start.
+type OffsetTimelineRequest struct {
+	Zone  string `json:"zone"`
+	Other string `json:"other"`
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+// TimelineZone identifies one side of an offset timeline
+type TimelineZone struct {
+	Location string `json:"location,omitempty"`
+	Timezone string `json:"timezone"`
+}
+
+// OffsetPeriod is a period during which Zone stays the same amount ahead of
+// Other. Usual is set for periods with the difference in effect longest.
+type OffsetPeriod struct {
+	Start             string `json:"start"`
+	End               string `json:"end"`
+	Difference        string `json:"difference"`
+	DifferenceSeconds int    `json:"difference_seconds"`
+	Description       string `json:"description"`
+	Usual             bool   `json:"usual"`
+}
+
+// OffsetTimelineResponse represents the piecewise offset of Zone relative to
+// Other within a range
+type OffsetTimelineResponse struct {
+	Zone                   *TimelineZone   `json:"zone"`
+	Other                  *TimelineZone   `json:"other"`
+	Start                  string          `json:"start"`
+	End                    string          `json:"end"`
+	UsualDifference        string          `json:"usual_difference"`
+	UsualDifferenceSeconds int             `json:"usual_difference_seconds"`
+	Changes                int             `json:"changes"`
+	Summary                string          `json:"summary"`
+	Periods                []*OffsetPeriod `json:"periods"`
+}
+
+// Normalize normalizes the fields of an OffsetTimelineRequest
+func (r *OffsetTimelineRequest) Normalize() {
+	r.Zone = strings.TrimSpace(r.Zone)
+	r.Other = strings.TrimSpace(r.Other)
+	r.Start = strings.TrimSpace(r.Start)
+	r.End = strings.TrimSpace(r.End)
+}
+
+// Validate validates an OffsetTimelineRequest
+func (r *OffsetTimelineRequest) Validate() error {
+	if r.Zone == "" || r.Other == "" {
+		return ErrEmptyTimelineZones
+	}
+	return nil
+}
+
+// Range parses the start and end of the request on the wall clock of loc,
+// with the same defaults and limits as TransitionsRequest.Range
+func (r *OffsetTimelineRequest) Range(loc *time.Location, now time.Time) (time.Time, time.Time, error) {
+	transitions := TransitionsRequest{Start: r.Start, End: r.End}
+	return transitions.Range(loc, now)
+}
+
+// NewOffsetTimelineResponse creates an OffsetTimelineResponse for how far loc
+// is ahead of other in [start, end). location and otherLocation are the saved
+// location names the timezones came from, if any.
+func NewOffsetTimelineResponse(loc *time.Location, location string, other *time.Location, otherLocation string, start, end time.Time) *OffsetTimelineResponse {
+	spans := timecalc.OffsetTimeline(loc, other, start, end)
+
+	// The usual difference is the one in effect for the longest total time;
+	// ties go to the one seen first
+	totals := make(map[int]time.Duration)
+	for _, span := range spans {
+		totals[span.Difference] += span.End.Sub(span.Start)
+	}
+	usual := 0
+	for i, span := range spans {
+		if i == 0 || totals[span.Difference] > totals[usual] {
+			usual = span.Difference
+		}
+	}
+
+	response := &OffsetTimelineResponse{
+		Zone:                   &TimelineZone{Location: location, Timezone: loc.String()},
+		Other:                  &TimelineZone{Location: otherLocation, Timezone: other.String()},
+		Start:                  start.In(loc).Format(time.RFC3339),
+		End:                    end.In(loc).Format(time.RFC3339),
+		UsualDifference:        FormatOffset(usual),
+		UsualDifferenceSeconds: usual,
+		Changes:                len(spans) - 1,
+		Periods:                make([]*OffsetPeriod, len(spans)),
+	}
+
+	var exceptions []string
+	for i, span := range spans {
+		period := &OffsetPeriod{
+			Start:             span.Start.In(loc).Format(time.RFC3339),
+			End:               span.End.In(loc).Format(time.RFC3339),
+			Difference:        FormatOffset(span.Difference),
+			DifferenceSeconds: span.Difference,
+			Description:       humanize.Offset(span.Difference),
+			Usual:             span.Difference == usual,
+		}
+		response.Periods[i] = period
+		if !period.Usual {
+			exceptions = append(exceptions, fmt.Sprintf("%s from %s to %s",
+				period.Description, span.Start.In(loc).Format("2006-01-02"), span.End.In(loc).Format("2006-01-02")))
+		}
+	}
+
+	name, otherName := timelineName(location, loc), timelineName(otherLocation, other)
+	if usual == 0 {
+		response.Summary = fmt.Sprintf("%s has the same time as %s", name, otherName)
+	} else {
+		response.Summary = fmt.Sprintf("%s is %s of %s", name, humanize.Offset(usual), otherName)
+	}
+	if len(exceptions) > 0 {
+		response.Summary += ", except " + strings.Join(exceptions, ", ")
+	}
+	return response
+}
+
+// timelineName names one side of an offset timeline in its summary
+func timelineName(location string, loc *time.Location) string {
+	if location != "" {
+		return location
+	}
+	return loc.String()
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewOffsetTimelineResponse(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, london)
+	resp := NewOffsetTimelineResponse(london, "london", ny, "", start, start.AddDate(1, 0, 0))
+
+	if resp.Zone.Location != "london" || resp.Other.Location != "" || resp.Other.Timezone != "America/New_York" {
+		t.Errorf("unexpected zones: %+v, %+v", resp.Zone, resp.Other)
+	}
+	if resp.UsualDifference != "+05:00" || resp.UsualDifferenceSeconds != 18000 {
+		t.Errorf("usual difference = %s (%d), want +05:00", resp.UsualDifference, resp.UsualDifferenceSeconds)
+	}
+	if resp.Changes != 4 || len(resp.Periods) != 5 {
+		t.Fatalf("expected 4 changes and 5 periods, got %d and %d", resp.Changes, len(resp.Periods))
+	}
+
+	spring := resp.Periods[1]
+	want := OffsetPeriod{
+		Start:             "2025-03-09T07:00:00Z",
+		End:               "2025-03-30T02:00:00+01:00",
+		Difference:        "+04:00",
+		DifferenceSeconds: 14400,
+		Description:       "4 hours ahead",
+		Usual:             false,
+	}
+	if *spring != want {
+		t.Errorf("spring period = %+v, want %+v", *spring, want)
+	}
+	if !resp.Periods[0].Usual || !resp.Periods[4].Usual {
+		t.Errorf("expected the first and last periods to be usual")
+	}
+
+	wantSummary := "london is 5 hours ahead of America/New_York, except 4 hours ahead from 2025-03-09 to 2025-03-30, 4 hours ahead from 2025-10-26 to 2025-11-02"
+	if resp.Summary != wantSummary {
+		t.Errorf("summary = %q, want %q", resp.Summary, wantSummary)
+	}
+}
+
+func TestNewOffsetTimelineResponseConstant(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	resp := NewOffsetTimelineResponse(time.UTC, "", time.UTC, "hq", start, start.AddDate(0, 6, 0))
+
+	if resp.Changes != 0 || len(resp.Periods) != 1 || !resp.Periods[0].Usual {
+		t.Errorf("expected a single usual period, got %+v", resp.Periods)
+	}
+	if resp.Summary != "UTC has the same time as hq" {
+		t.Errorf("summary = %q", resp.Summary)
+	}
+}
+
+func TestOffsetTimelineRequestValidate(t *testing.T) {
+	req := OffsetTimelineRequest{Zone: " london ", Other: " "}
+	req.Normalize()
+	if err := req.Validate(); err != ErrEmptyTimelineZones {
+		t.Errorf("Validate() = %v, want %v", err, ErrEmptyTimelineZones)
+	}
+
+	req = OffsetTimelineRequest{Zone: "london", Other: "America/New_York"}
+	if err := req.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+}
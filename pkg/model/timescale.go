@@ -0,0 +1,279 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timescale"
+)
+
+// SecondsPerGPSWeek is the length of a GPS week in seconds
+const SecondsPerGPSWeek = 604800
+
+// Time scale validation errors
+var (
+	ErrGPSWeekScale    = errors.New("week and seconds_of_week give a GPS time; use them with scale gps")
+	ErrGPSWeekConflict = errors.New("give either time or week and seconds_of_week, not both")
+	ErrInvalidGPSWeek  = fmt.Errorf("week cannot be negative and seconds_of_week must be from 0 to under %d", SecondsPerGPSWeek)
+)
+
+// TimeScaleRequest represents a request to convert a reading between time
+// scales. Time is read on Scale, default utc, and defaults to now. GPS times
+// may instead be given as a Week since 1980-01-06 and SecondsOfWeek into it.
+// To lists the scales to convert to, default all of them.
+type TimeScaleRequest struct {
+	Scale         string   `json:"scale,omitempty"`
+	Time          string   `json:"time,omitempty"`
+	Week          int      `json:"week,omitempty"`
+	SecondsOfWeek float64  `json:"seconds_of_week,omitempty"`
+	To            []string `json:"to,omitempty"`
+}
+
+// TimeScaleReading represents a reading on one time scale. Time is written
+// YYYY-MM-DDTHH:MM:SS, with a Z for UTC and second 60 during a leap second;
+// Unix time is written in seconds. Seconds counts from the Unix or GPS epoch.
+// LeapSecond marks a UTC reading during a leap second, and a Unix time that
+// repeats because of one.
+type TimeScaleReading struct {
+	Scale         string   `json:"scale"`
+	Time          string   `json:"time"`
+	Seconds       *float64 `json:"seconds,omitempty"`
+	GPSWeek       *int     `json:"gps_week,omitempty"`
+	SecondsOfWeek *float64 `json:"seconds_of_week,omitempty"`
+	LeapSecond    bool     `json:"leap_second,omitempty"`
+}
+
+// TimeScaleResponse represents a reading converted to several time scales.
+// Warning is set when the instant is past the expiry of the leap second
+// table, so leap seconds announced since may be missing.
+type TimeScaleResponse struct {
+	Input       *TimeScaleReading   `json:"input"`
+	TAIMinusUTC int                 `json:"tai_minus_utc"`
+	Readings    []*TimeScaleReading `json:"readings"`
+	Warning     string              `json:"warning,omitempty"`
+}
+
+// LeapSecond represents a change to TAI−UTC. Time is the second inserted,
+// 23:59:60 UTC, or for a negative leap second the 23:59:59 UTC left out.
+type LeapSecond struct {
+	Date        string `json:"date"`
+	Time        string `json:"time"`
+	Seconds     int    `json:"seconds"`
+	TAIMinusUTC int    `json:"tai_minus_utc"`
+}
+
+// LeapSecondTable describes the leap second table in use
+type LeapSecondTable struct {
+	Source  string `json:"source"`
+	Updated string `json:"updated,omitempty"`
+	Expires string `json:"expires"`
+	Expired bool   `json:"expired"`
+}
+
+// Next leap second statuses
+const (
+	LeapSecondScheduled = "scheduled"
+	LeapSecondNone      = "none_scheduled"
+	LeapSecondUnknown   = "unknown"
+)
+
+// LeapSecondsResponse represents the current offsets between UTC and the
+// atomic time scales and the known leap seconds. NextStatus is scheduled
+// when the table lists a leap second still to come, none_scheduled when the
+// table rules one out until it expires, and unknown once it has expired.
+type LeapSecondsResponse struct {
+	Time        string          `json:"time"`
+	TAIMinusUTC int             `json:"tai_minus_utc"`
+	GPSMinusUTC int             `json:"gps_minus_utc"`
+	TTMinusUTC  float64         `json:"tt_minus_utc"`
+	Last        *LeapSecond     `json:"last_leap_second,omitempty"`
+	Next        *LeapSecond     `json:"next_leap_second,omitempty"`
+	NextStatus  string          `json:"next_leap_second_status"`
+	Note        string          `json:"note"`
+	Table       LeapSecondTable `json:"table"`
+	LeapSeconds []*LeapSecond   `json:"leap_seconds"`
+}
+
+// Normalize normalizes the fields of a TimeScaleRequest, splitting
+// comma-separated target scales
+func (r *TimeScaleRequest) Normalize() {
+	r.Scale = strings.ToLower(strings.TrimSpace(r.Scale))
+	r.Time = strings.TrimSpace(r.Time)
+	r.To = splitList(r.To)
+	for i, to := range r.To {
+		r.To[i] = strings.ToLower(to)
+	}
+	if r.Scale == "" {
+		r.Scale = string(timescale.UTC)
+	}
+}
+
+// Validate validates a TimeScaleRequest
+func (r *TimeScaleRequest) Validate() error {
+	if _, err := timescale.ParseScale(r.Scale); err != nil {
+		return err
+	}
+	for _, to := range r.To {
+		if _, err := timescale.ParseScale(to); err != nil {
+			return err
+		}
+	}
+	if r.HasWeek() {
+		if r.Scale != string(timescale.GPS) {
+			return ErrGPSWeekScale
+		}
+		if r.Time != "" {
+			return ErrGPSWeekConflict
+		}
+		if r.Week < 0 || r.SecondsOfWeek < 0 || r.SecondsOfWeek >= SecondsPerGPSWeek {
+			return ErrInvalidGPSWeek
+		}
+	}
+	return nil
+}
+
+// HasWeek reports whether the request gives a GPS week and seconds of week
+func (r *TimeScaleRequest) HasWeek() bool {
+	return r.Week != 0 || r.SecondsOfWeek != 0
+}
+
+// Reading parses the reading to convert, now read as UTC when the request
+// gives no time. It must only be called after Validate succeeds.
+func (r *TimeScaleRequest) Reading(now time.Time) (timescale.Reading, error) {
+	if r.HasWeek() {
+		return timescale.FromGPSWeek(r.Week, r.SecondsOfWeek), nil
+	}
+	if r.Time == "" {
+		return timescale.Reading{Scale: timescale.UTC, Time: now.UTC()}, nil
+	}
+	return timescale.ParseReading(timescale.Scale(r.Scale), r.Time, now)
+}
+
+// Targets returns the scales to convert to.
+// It must only be called after Validate succeeds.
+func (r *TimeScaleRequest) Targets() []timescale.Scale {
+	if len(r.To) == 0 {
+		return timescale.Scales()
+	}
+	var targets []timescale.Scale
+	seen := make(map[string]bool)
+	for _, to := range r.To {
+		if !seen[to] {
+			seen[to] = true
+			targets = append(targets, timescale.Scale(to))
+		}
+	}
+	return targets
+}
+
+// NewTimeScaleResponse converts a reading to the target scales with a leap
+// second table, returning the table's error when the reading is before 1972
+// or claims a leap second the table does not list
+func NewTimeScaleResponse(table *timescale.Table, reading timescale.Reading, targets []timescale.Scale) (*TimeScaleResponse, error) {
+	tai, err := table.ToTAI(reading)
+	if err != nil {
+		return nil, err
+	}
+	utc, err := table.FromTAI(timescale.UTC, tai)
+	if err != nil {
+		return nil, err
+	}
+	offset, _ := table.Offset(utc.Time)
+
+	response := &TimeScaleResponse{
+		Input:       newTimeScaleReading(reading),
+		TAIMinusUTC: offset,
+		Readings:    make([]*TimeScaleReading, len(targets)),
+	}
+	for i, scale := range targets {
+		r, err := table.FromTAI(scale, tai)
+		if err != nil {
+			return nil, err
+		}
+		response.Readings[i] = newTimeScaleReading(r)
+	}
+	if table.Expired(utc.Time) {
+		response.Warning = fmt.Sprintf("the leap second table expires %s; leap seconds announced since are not included",
+			table.Expires.Format(time.DateOnly))
+	}
+	return response, nil
+}
+
+// NewLeapSecondsResponse describes the leap second table as of now
+func NewLeapSecondsResponse(table *timescale.Table, now time.Time) *LeapSecondsResponse {
+	now = now.UTC()
+	offset, _ := table.Offset(now)
+	response := &LeapSecondsResponse{
+		Time:        now.Format(time.RFC3339),
+		TAIMinusUTC: offset,
+		GPSMinusUTC: offset + int(timescale.GPSMinusTAI/time.Second),
+		TTMinusUTC:  float64(offset) + timescale.TTMinusTAI.Seconds(),
+		Table: LeapSecondTable{
+			Source:  table.Source,
+			Expires: table.Expires.Format(time.DateOnly),
+			Expired: table.Expired(now),
+		},
+	}
+	if !table.Updated.IsZero() {
+		response.Table.Updated = table.Updated.Format(time.DateOnly)
+	}
+
+	// The first entry starts the table rather than following a leap second
+	for i := 1; i < len(table.Leaps); i++ {
+		leap := newLeapSecond(table.Leaps[i-1], table.Leaps[i])
+		response.LeapSeconds = append(response.LeapSeconds, leap)
+		if !table.Leaps[i].Start.After(now) {
+			response.Last = leap
+		} else if response.Next == nil {
+			response.Next = leap
+		}
+	}
+
+	expires := response.Table.Expires
+	switch {
+	case response.Next != nil:
+		response.NextStatus = LeapSecondScheduled
+		response.Note = fmt.Sprintf("a leap second is scheduled at %s", response.Next.Time)
+	case response.Table.Expired:
+		response.NextStatus = LeapSecondUnknown
+		response.Note = fmt.Sprintf("the leap second table expired %s, so leap seconds announced since are unknown; load a newer leap-seconds.list", expires)
+	default:
+		response.NextStatus = LeapSecondNone
+		response.Note = fmt.Sprintf("no leap second is scheduled before the table expires %s", expires)
+	}
+	return response
+}
+
+// newTimeScaleReading creates a TimeScaleReading for a reading
+func newTimeScaleReading(r timescale.Reading) *TimeScaleReading {
+	reading := &TimeScaleReading{
+		Scale:      string(r.Scale),
+		Time:       r.String(),
+		LeapSecond: r.Leap,
+	}
+	if seconds, ok := r.Seconds(); ok {
+		reading.Seconds = &seconds
+	}
+	if r.Scale == timescale.GPS {
+		week, seconds := r.GPSWeek()
+		reading.GPSWeek, reading.SecondsOfWeek = &week, &seconds
+	}
+	return reading
+}
+
+// newLeapSecond creates a LeapSecond for the change between two table entries
+func newLeapSecond(prev, l timescale.Leap) *LeapSecond {
+	last := l.Start.Add(-time.Second)
+	leap := &LeapSecond{
+		Date:        last.Format(time.DateOnly),
+		Time:        last.Format(time.RFC3339),
+		Seconds:     l.Offset - prev.Offset,
+		TAIMinusUTC: l.Offset,
+	}
+	if leap.Seconds > 0 {
+		leap.Time = timescale.Reading{Scale: timescale.UTC, Time: last, Leap: true}.String()
+	}
+	return leap
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timescale"
+)
+
+func TestTimeScaleRequestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		req  TimeScaleRequest
+		want error
+	}{
+		{"defaults", TimeScaleRequest{}, nil},
+		{"gps week", TimeScaleRequest{Scale: "GPS", Week: 2347, SecondsOfWeek: 259218}, nil},
+		{"targets", TimeScaleRequest{Scale: "tai", Time: "2017-01-01T00:00:37", To: []string{"UTC, gps"}}, nil},
+		{"unknown scale", TimeScaleRequest{Scale: "tcb"}, timescale.ErrUnknownScale},
+		{"unknown target", TimeScaleRequest{To: []string{"glonass"}}, timescale.ErrUnknownScale},
+		{"week on utc", TimeScaleRequest{Week: 2347}, ErrGPSWeekScale},
+		{"week and time", TimeScaleRequest{Scale: "gps", Time: "0", Week: 2347}, ErrGPSWeekConflict},
+		{"seconds past the week", TimeScaleRequest{Scale: "gps", Week: 2347, SecondsOfWeek: SecondsPerGPSWeek}, ErrInvalidGPSWeek},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTimeScaleResponse(t *testing.T) {
+	req := TimeScaleRequest{Scale: "gps", Week: 1930, SecondsOfWeek: 17, To: []string{"utc,tai,unix,utc"}}
+	req.Normalize()
+	reading, err := req.Reading(time.Now())
+	if err != nil {
+		t.Fatalf("Reading() error: %v", err)
+	}
+
+	// GPS week 1930 starts on 2017-01-01, 17 seconds after the 2016 leap second
+	resp, err := NewTimeScaleResponse(timescale.Default(), reading, req.Targets())
+	if err != nil {
+		t.Fatalf("NewTimeScaleResponse() error: %v", err)
+	}
+	if resp.Input.Time != "2017-01-01T00:00:17" || *resp.Input.GPSWeek != 1930 || resp.TAIMinusUTC != 36 || resp.Warning != "" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if len(resp.Readings) != 3 {
+		t.Fatalf("expected 3 readings, got %d", len(resp.Readings))
+	}
+	utc, unix := resp.Readings[0], resp.Readings[2]
+	if utc.Time != "2016-12-31T23:59:60Z" || !utc.LeapSecond {
+		t.Errorf("unexpected utc reading: %+v", utc)
+	}
+	if unix.Time != "1483228800" || *unix.Seconds != 1483228800 || !unix.LeapSecond {
+		t.Errorf("unexpected unix reading: %+v", unix)
+	}
+
+	// Readings past the table's expiry carry a warning
+	late := timescale.Reading{Scale: timescale.UTC, Time: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)}
+	resp, err = NewTimeScaleResponse(timescale.Default(), late, []timescale.Scale{timescale.TAI})
+	if err != nil {
+		t.Fatalf("NewTimeScaleResponse() error: %v", err)
+	}
+	if !strings.Contains(resp.Warning, "expires 2026-06-28") {
+		t.Errorf("expected an expiry warning, got %q", resp.Warning)
+	}
+
+	early := timescale.Reading{Scale: timescale.UTC, Time: time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)}
+	if _, err := NewTimeScaleResponse(timescale.Default(), early, timescale.Scales()); !errors.Is(err, timescale.ErrBeforeTable) {
+		t.Errorf("NewTimeScaleResponse() error = %v, want %v", err, timescale.ErrBeforeTable)
+	}
+}
+
+func TestNewLeapSecondsResponse(t *testing.T) {
+	table := timescale.Default()
+
+	resp := NewLeapSecondsResponse(table, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	if resp.TAIMinusUTC != 37 || resp.GPSMinusUTC != 18 || resp.TTMinusUTC != 69.184 {
+		t.Errorf("unexpected offsets: %+v", resp)
+	}
+	if resp.Last == nil || resp.Last.Time != "2016-12-31T23:59:60Z" || resp.Last.Seconds != 1 {
+		t.Errorf("unexpected last leap second: %+v", resp.Last)
+	}
+	if resp.Next != nil || resp.NextStatus != LeapSecondNone || resp.Table.Expired || len(resp.LeapSeconds) != 27 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	resp = NewLeapSecondsResponse(table, time.Date(2016, 12, 1, 0, 0, 0, 0, time.UTC))
+	if resp.TAIMinusUTC != 36 || resp.Next == nil || resp.Next.Date != "2016-12-31" || resp.NextStatus != LeapSecondScheduled {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	resp = NewLeapSecondsResponse(table, time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
+	if !resp.Table.Expired || resp.NextStatus != LeapSecondUnknown || !strings.Contains(resp.Note, "load a newer") {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+	"github.com/yourorg/timeservice/pkg/tzcatalog"
+)
+
+// maxTimezoneFilterLength limits the length of timezone search filters
+const maxTimezoneFilterLength = 100
+
+// Timezone catalog validation errors
+var ErrTimezoneFilterTooLong = errors.New("search and prefix must be 100 characters or less")
+
+// TimezoneListRequest represents a request to list or search the timezone catalog
+type TimezoneListRequest struct {
+	Search        string `json:"search,omitempty"`
+	Prefix        string `json:"prefix,omitempty"`
+	CanonicalOnly bool   `json:"canonical_only,omitempty"`
+}
+
+// TimezoneInfo describes a timezone and its current state
+type TimezoneInfo struct {
+	Name          string `json:"name"`
+	Canonical     bool   `json:"canonical"`
+	LinkTarget    string `json:"link_target,omitempty"`
+	Offset        string `json:"offset"`
+	OffsetSeconds int    `json:"offset_seconds"`
+	Abbreviation  string `json:"abbreviation"`
+	IsDST         bool   `json:"is_dst"`
+	ObservesDST   bool   `json:"observes_dst"`
+}
+
+// TimezoneListResponse represents the matching entries of the timezone catalog
+type TimezoneListResponse struct {
+	TZDataVersion string          `json:"tzdata_version"`
+	Count         int             `json:"count"`
+	Timezones     []*TimezoneInfo `json:"timezones"`
+}
+
+// Normalize normalizes the fields of a TimezoneListRequest
+func (r *TimezoneListRequest) Normalize() {
+	r.Search = strings.TrimSpace(r.Search)
+	r.Prefix = strings.TrimSpace(r.Prefix)
+}
+
+// Validate validates a TimezoneListRequest
+func (r *TimezoneListRequest) Validate() error {
+	if len(r.Search) > maxTimezoneFilterLength || len(r.Prefix) > maxTimezoneFilterLength {
+		return ErrTimezoneFilterTooLong
+	}
+	return nil
+}
+
+// NewTimezoneListResponse lists the catalog entries matching the request as of now
+func NewTimezoneListResponse(req *TimezoneListRequest, now time.Time) *TimezoneListResponse {
+	response := &TimezoneListResponse{
+		TZDataVersion: tzcatalog.Version(),
+		Timezones:     []*TimezoneInfo{},
+	}
+
+	for _, entry := range tzcatalog.Search(req.Search, req.Prefix) {
+		if req.CanonicalOnly && !entry.Canonical() {
+			continue
+		}
+		info, err := NewTimezoneInfo(entry, now)
+		if err != nil {
+			// The embedded zoneinfo may predate the catalog; skip names it cannot load
+			continue
+		}
+		response.Timezones = append(response.Timezones, info)
+	}
+	response.Count = len(response.Timezones)
+
+	return response
+}
+
+// NewTimezoneInfo describes a catalog entry as of now.
+// A zone observes DST if DST is in effect now or begins within the next year.
+func NewTimezoneInfo(entry tzcatalog.Entry, now time.Time) (*TimezoneInfo, error) {
+	loc, err := time.LoadLocation(entry.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	local := now.In(loc)
+	abbr, offset := local.Zone()
+
+	observesDST := local.IsDST()
+	for _, tr := range timecalc.Transitions(loc, now, now.AddDate(1, 0, 0)) {
+		if tr.IsDST {
+			observesDST = true
+			break
+		}
+	}
+
+	return &TimezoneInfo{
+		Name:          entry.Name,
+		Canonical:     entry.Canonical(),
+		LinkTarget:    entry.Link,
+		Offset:        FormatOffset(offset),
+		OffsetSeconds: offset,
+		Abbreviation:  abbr,
+		IsDST:         local.IsDST(),
+		ObservesDST:   observesDST,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/tzcatalog"
+)
+
+func TestNewTimezoneInfo(t *testing.T) {
+	winter := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2025, 7, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want TimezoneInfo
+	}{
+		{
+			name: "America/New_York",
+			now:  winter,
+			want: TimezoneInfo{Name: "America/New_York", Canonical: true, Offset: "-05:00", OffsetSeconds: -18000, Abbreviation: "EST", ObservesDST: true},
+		},
+		{
+			name: "America/New_York",
+			now:  summer,
+			want: TimezoneInfo{Name: "America/New_York", Canonical: true, Offset: "-04:00", OffsetSeconds: -14400, Abbreviation: "EDT", IsDST: true, ObservesDST: true},
+		},
+		{
+			name: "Asia/Tokyo",
+			now:  winter,
+			want: TimezoneInfo{Name: "Asia/Tokyo", Canonical: true, Offset: "+09:00", OffsetSeconds: 32400, Abbreviation: "JST"},
+		},
+		{
+			name: "UTC",
+			now:  winter,
+			want: TimezoneInfo{Name: "UTC", LinkTarget: "Etc/UTC", Offset: "+00:00", Abbreviation: "UTC"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := tzcatalog.Lookup(tt.name)
+			if !ok {
+				t.Fatalf("%s not in catalog", tt.name)
+			}
+			got, err := NewTimezoneInfo(entry, tt.now)
+			if err != nil {
+				t.Fatalf("NewTimezoneInfo() unexpected error: %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("NewTimezoneInfo() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTimezoneListResponse(t *testing.T) {
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	resp := NewTimezoneListResponse(&TimezoneListRequest{Prefix: "Australia/"}, now)
+	if resp.Count != len(resp.Timezones) || resp.Count == 0 {
+		t.Fatalf("unexpected count %d for %d timezones", resp.Count, len(resp.Timezones))
+	}
+	if resp.TZDataVersion == "" {
+		t.Error("expected tzdata version")
+	}
+
+	links := 0
+	for _, tz := range resp.Timezones {
+		if !strings.HasPrefix(tz.Name, "Australia/") {
+			t.Errorf("unexpected timezone %s", tz.Name)
+		}
+		if !tz.Canonical {
+			links++
+		}
+	}
+	if links == 0 {
+		t.Error("expected links such as Australia/ACT to be listed")
+	}
+
+	canonical := NewTimezoneListResponse(&TimezoneListRequest{Prefix: "Australia/", CanonicalOnly: true}, now)
+	if canonical.Count != resp.Count-links {
+		t.Errorf("expected %d canonical zones, got %d", resp.Count-links, canonical.Count)
+	}
+
+	empty := NewTimezoneListResponse(&TimezoneListRequest{Search: "atlantis"}, now)
+	if empty.Count != 0 || empty.Timezones == nil {
+		t.Errorf("expected empty non-nil list, got %+v", empty)
+	}
+}
+
+func TestTimezoneListRequestValidate(t *testing.T) {
+	req := TimezoneListRequest{Search: "  tokyo  "}
+	req.Normalize()
+	if req.Search != "tokyo" {
+		t.Errorf("expected trimmed search, got %q", req.Search)
+	}
+	if err := req.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+
+	req = TimezoneListRequest{Prefix: strings.Repeat("a", 101)}
+	if err := req.Validate(); err != ErrTimezoneFilterTooLong {
+		t.Errorf("Validate() = %v, want %v", err, ErrTimezoneFilterTooLong)
+	}
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// MaxTransitionYears limits the length of a DST transition lookup
+const MaxTransitionYears = 100
+
+// Transition lookup validation errors
+var (
+	ErrEmptyTransitionZone = errors.New("timezone or location is required")
+	ErrTransitionOrder     = errors.New("end must be after start")
+	ErrTransitionRange     = fmt.Errorf("range cannot exceed %d years", MaxTransitionYears)
+)
+
+// TransitionsRequest represents a request for the offset transitions of a zone.
+// Start defaults to now and End to one year after Start.
+type TransitionsRequest struct {
+	Zone  string `json:"zone"`
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+// Transition represents a single change of UTC offset in a timezone
+type Transition struct {
+	Instant          string `json:"instant"`
+	UnixTime         int64  `json:"unix_time"`
+	LocalTime        string `json:"local_time"`
+	OldOffset        string `json:"old_offset"`
+	NewOffset        string `json:"new_offset"`
+	OldOffsetSeconds int    `json:"old_offset_seconds"`
+	NewOffsetSeconds int    `json:"new_offset_seconds"`
+	OldAbbreviation  string `json:"old_abbreviation"`
+	NewAbbreviation  string `json:"new_abbreviation"`
+	IsDST            bool   `json:"is_dst"`
+}
+
+// TransitionsResponse represents the offset transitions of a zone within a range
+type TransitionsResponse struct {
+	Location    string        `json:"location,omitempty"`
+	Timezone    string        `json:"timezone"`
+	Start       string        `json:"start"`
+	End         string        `json:"end"`
+	Transitions []*Transition `json:"transitions"`
+}
+
+// Normalize normalizes the fields of a TransitionsRequest
+func (r *TransitionsRequest) Normalize() {
+	r.Zone = strings.TrimSpace(r.Zone)
+	r.Start = strings.TrimSpace(r.Start)
+	r.End = strings.TrimSpace(r.End)
+}
+
+// Validate validates a TransitionsRequest
+func (r *TransitionsRequest) Validate() error {
+	if r.Zone == "" {
+		return ErrEmptyTransitionZone
+	}
+	return nil
+}
+
+// Range parses the start and end of the request on the wall clock of loc.
+// A missing start means now and a missing end means one year after start.
+func (r *TransitionsRequest) Range(loc *time.Location, now time.Time) (time.Time, time.Time, error) {
+	start, err := timeparse.Parse(r.Start, loc, now)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid start: %w", err)
+	}
+
+	end := start.AddDate(1, 0, 0)
+	if r.End != "" {
+		if end, err = timeparse.Parse(r.End, loc, now); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid end: %w", err)
+		}
+	}
+
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, ErrTransitionOrder
+	}
+	if end.After(start.AddDate(MaxTransitionYears, 0, 0)) {
+		return time.Time{}, time.Time{}, ErrTransitionRange
+	}
+	return start, end, nil
+}
+
+// NewTransitionsResponse creates a TransitionsResponse for the transitions of loc in [start, end).
+// location is the saved location name the timezone came from, if any.
+func NewTransitionsResponse(loc *time.Location, location string, start, end time.Time) *TransitionsResponse {
+	transitions := timecalc.Transitions(loc, start, end)
+
+	response := &TransitionsResponse{
+		Location:    location,
+		Timezone:    loc.String(),
+		Start:       start.In(loc).Format(time.RFC3339),
+		End:         end.In(loc).Format(time.RFC3339),
+		Transitions: make([]*Transition, len(transitions)),
+	}
+	for i, tr := range transitions {
+		response.Transitions[i] = &Transition{
+			Instant:          tr.At.UTC().Format(time.RFC3339),
+			UnixTime:         tr.At.Unix(),
+			LocalTime:        tr.At.In(loc).Format(time.RFC3339),
+			OldOffset:        FormatOffset(tr.OldOffset),
+			NewOffset:        FormatOffset(tr.NewOffset),
+			OldOffsetSeconds: tr.OldOffset,
+			NewOffsetSeconds: tr.NewOffset,
+			OldAbbreviation:  tr.OldAbbreviation,
+			NewAbbreviation:  tr.NewAbbreviation,
+			IsDST:            tr.IsDST,
+		}
+	}
+
+	return response
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+func TestNewTransitionsResponse(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, ny)
+	resp := NewTransitionsResponse(ny, "hq", start, start.AddDate(1, 0, 0))
+
+	if resp.Location != "hq" || resp.Timezone != "America/New_York" {
+		t.Errorf("unexpected zone: location=%q timezone=%q", resp.Location, resp.Timezone)
+	}
+	if resp.Start != "2025-01-01T00:00:00-05:00" || resp.End != "2026-01-01T00:00:00-05:00" {
+		t.Errorf("unexpected range: %s to %s", resp.Start, resp.End)
+	}
+	if len(resp.Transitions) != 2 {
+		t.Fatalf("expected 2 transitions, got %d", len(resp.Transitions))
+	}
+
+	spring := resp.Transitions[0]
+	want := Transition{
+		Instant:          "2025-03-09T07:00:00Z",
+		UnixTime:         time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC).Unix(),
+		LocalTime:        "2025-03-09T03:00:00-04:00",
+		OldOffset:        "-05:00",
+		NewOffset:        "-04:00",
+		OldOffsetSeconds: -18000,
+		NewOffsetSeconds: -14400,
+		OldAbbreviation:  "EST",
+		NewAbbreviation:  "EDT",
+		IsDST:            true,
+	}
+	if *spring != want {
+		t.Errorf("spring forward = %+v, want %+v", *spring, want)
+	}
+}
+
+func TestTransitionsRequestValidate(t *testing.T) {
+	req := TransitionsRequest{Zone: "  "}
+	req.Normalize()
+	if err := req.Validate(); err != ErrEmptyTransitionZone {
+		t.Errorf("Validate() = %v, want %v", err, ErrEmptyTransitionZone)
+	}
+
+	req = TransitionsRequest{Zone: "hq"}
+	if err := req.Validate(); err != nil {
+		t.Errorf("Validate() unexpected error: %v", err)
+	}
+}
+
+func TestTransitionsRequestRange(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		req       TransitionsRequest
+		wantStart time.Time
+		wantEnd   time.Time
+		wantError error
+	}{
+		{
+			name:      "defaults to one year from now",
+			req:       TransitionsRequest{},
+			wantStart: now,
+			wantEnd:   now.AddDate(1, 0, 0),
+		},
+		{
+			name:      "explicit range",
+			req:       TransitionsRequest{Start: "2025-01-01", End: "2030-01-01"},
+			wantStart: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "maximum range",
+			req:       TransitionsRequest{Start: "2000-01-01", End: "2100-01-01"},
+			wantStart: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "too long",
+			req:       TransitionsRequest{Start: "2000-01-01", End: "2100-01-02"},
+			wantError: ErrTransitionRange,
+		},
+		{
+			name:      "reversed",
+			req:       TransitionsRequest{Start: "2025-01-01", End: "2024-01-01"},
+			wantError: ErrTransitionOrder,
+		},
+		{
+			name:      "invalid start",
+			req:       TransitionsRequest{Start: "soon"},
+			wantError: timeparse.ErrUnrecognizedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := tt.req.Range(time.UTC, now)
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("Range() error = %v, want %v", err, tt.wantError)
+			}
+			if err != nil {
+				return
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Range() = %v, %v, want %v, %v", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
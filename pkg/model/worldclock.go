@@ -0,0 +1,201 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MaxWorldClockFilters is the most names or tags a world clock request can select
+const MaxWorldClockFilters = 100
+
+// World clock validation errors
+var (
+	ErrTooManyWorldClockFilters = fmt.Errorf("at most %d names and %d tags are allowed", MaxWorldClockFilters, MaxWorldClockFilters)
+	ErrWorldClockNotFound       = errors.New("location not found")
+)
+
+// WorldClockRequest represents a request for the current time at saved
+// locations. Without names or tags it selects every location; with both, a
+// location must match one of the names and one of the tags.
+type WorldClockRequest struct {
+	Names []string `json:"names,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+	// Reference is the timezone or location day offsets are relative to
+	Reference string `json:"reference,omitempty"`
+}
+
+// WorldClockEntry is the time at one saved location. DayOffset is how many
+// calendar days the location's date is ahead of the reference date, and Day
+// labels it as "+1 day" or "-1 day", empty on the same day.
+type WorldClockEntry struct {
+	*ZonedTime
+	Tags           []string `json:"tags,omitempty"`
+	RelativeOffset string   `json:"relative_offset"`
+	DayOffset      int      `json:"day_offset"`
+	Day            string   `json:"day,omitempty"`
+}
+
+// WorldClockResponse represents the time at many saved locations at once,
+// sorted by UTC offset
+type WorldClockResponse struct {
+	Time      string             `json:"time"`
+	UnixTime  int64              `json:"unix_time"`
+	Reference *ZonedTime         `json:"reference"`
+	Count     int                `json:"count"`
+	Locations []*WorldClockEntry `json:"locations"`
+}
+
+// Normalize normalizes the fields of a WorldClockRequest, splitting
+// comma-separated names and tags
+func (r *WorldClockRequest) Normalize() {
+	r.Names = splitList(r.Names)
+	r.Tags = splitList(r.Tags)
+	r.Reference = strings.TrimSpace(r.Reference)
+	if r.Reference == "" {
+		r.Reference = "UTC"
+	}
+}
+
+// Validate validates a WorldClockRequest
+func (r *WorldClockRequest) Validate() error {
+	if len(r.Names) > MaxWorldClockFilters || len(r.Tags) > MaxWorldClockFilters {
+		return ErrTooManyWorldClockFilters
+	}
+	for _, name := range r.Names {
+		if err := ValidateName(name); err != nil {
+			return fmt.Errorf("%w: %s", err, name)
+		}
+	}
+	for _, tag := range r.Tags {
+		if len(tag) > 50 || !nameRegex.MatchString(tag) {
+			return fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+		}
+	}
+	return nil
+}
+
+// Matches reports whether the request selects loc
+func (r *WorldClockRequest) Matches(loc *Location) bool {
+	if len(r.Names) > 0 && !containsFold(r.Names, loc.Name) {
+		return false
+	}
+	if len(r.Tags) == 0 {
+		return true
+	}
+	for _, tag := range r.Tags {
+		if loc.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckNames returns an error wrapping ErrWorldClockNotFound when any
+// requested name matches none of locations
+func (r *WorldClockRequest) CheckNames(locations []*Location) error {
+	names := make([]string, len(locations))
+	for i, loc := range locations {
+		names[i] = loc.Name
+	}
+	var missing []string
+	for _, name := range r.Names {
+		if !containsFold(names, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrWorldClockNotFound, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// NewWorldClockResponse creates a WorldClockResponse with the time at now for
+// the locations the request selects. ref is the reference zone, and
+// refLocation the saved location name it came from, if any. Locations whose
+// timezone cannot be loaded are skipped.
+func NewWorldClockResponse(req *WorldClockRequest, now time.Time, ref *time.Location, refLocation string, locations []*Location) *WorldClockResponse {
+	refTime := now.In(ref)
+	response := &WorldClockResponse{
+		Time:      now.UTC().Format(time.RFC3339),
+		UnixTime:  now.Unix(),
+		Reference: NewZonedTime(refTime, refLocation),
+		Locations: []*WorldClockEntry{},
+	}
+
+	for _, loc := range locations {
+		if !req.Matches(loc) {
+			continue
+		}
+		tz, err := time.LoadLocation(loc.Timezone)
+		if err != nil {
+			continue
+		}
+		t := now.In(tz)
+		days := dayOffset(t, refTime)
+		response.Locations = append(response.Locations, &WorldClockEntry{
+			ZonedTime:      NewZonedTime(t, loc.Name),
+			Tags:           loc.Tags,
+			RelativeOffset: RelativeOffset(t, ref),
+			DayOffset:      days,
+			Day:            dayLabel(days),
+		})
+	}
+
+	sort.SliceStable(response.Locations, func(i, j int) bool {
+		a, b := response.Locations[i], response.Locations[j]
+		if a.OffsetSeconds != b.OffsetSeconds {
+			return a.OffsetSeconds < b.OffsetSeconds
+		}
+		return a.Location < b.Location
+	})
+	response.Count = len(response.Locations)
+	return response
+}
+
+// dayOffset returns how many calendar days t's local date is after ref's
+// local date
+func dayOffset(t, ref time.Time) int {
+	y, m, d := t.Date()
+	ry, rm, rd := ref.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	refDate := time.Date(ry, rm, rd, 0, 0, 0, 0, time.UTC)
+	return int(date.Sub(refDate).Hours() / 24)
+}
+
+// dayLabel labels a day offset as "+1 day" or "-2 days", empty for zero
+func dayLabel(days int) string {
+	switch days {
+	case 0:
+		return ""
+	case 1, -1:
+		return fmt.Sprintf("%+d day", days)
+	default:
+		return fmt.Sprintf("%+d days", days)
+	}
+}
+
+// splitList splits comma-separated values, dropping empty ones
+func splitList(values []string) []string {
+	var parts []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				parts = append(parts, part)
+			}
+		}
+	}
+	return parts
+}
+
+// containsFold reports whether values contains s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWorldClockRequestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     WorldClockRequest
+		wantErr error
+	}{
+		{"defaults", WorldClockRequest{}, nil},
+		{"comma-separated filters", WorldClockRequest{Names: []string{"tokyo, nyc"}, Tags: []string{"apac", "emea"}}, nil},
+		{"invalid name", WorldClockRequest{Names: []string{"new york"}}, ErrInvalidNameFormat},
+		{"invalid tag", WorldClockRequest{Tags: []string{"a/b"}}, ErrInvalidTag},
+		{"too many names", WorldClockRequest{Names: []string{strings.Repeat("a,", MaxWorldClockFilters+1)}}, ErrTooManyWorldClockFilters},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewWorldClockResponse(t *testing.T) {
+	locations := []*Location{
+		{Name: "tokyo", Timezone: "Asia/Tokyo", Tags: []string{"apac"}},
+		{Name: "nyc", Timezone: "America/New_York", Tags: []string{"amer"}},
+		{Name: "london", Timezone: "Europe/London", Tags: []string{"emea"}},
+		{Name: "kiritimati", Timezone: "Pacific/Kiritimati", Tags: []string{"apac"}},
+		{Name: "broken", Timezone: "Mars/Olympus"},
+	}
+	now := time.Date(2025, 6, 10, 20, 0, 0, 0, time.UTC)
+
+	req := WorldClockRequest{}
+	req.Normalize()
+	resp := NewWorldClockResponse(&req, now, time.UTC, "", locations)
+
+	var order []string
+	for _, e := range resp.Locations {
+		order = append(order, e.Location)
+	}
+	if got := strings.Join(order, ","); got != "nyc,london,tokyo,kiritimati" {
+		t.Fatalf("locations = %s, want nyc,london,tokyo,kiritimati", got)
+	}
+	if resp.Count != 4 || resp.Time != "2025-06-10T20:00:00Z" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	nyc, tokyo := resp.Locations[0], resp.Locations[2]
+	if nyc.DayOffset != 0 || nyc.Day != "" || nyc.RelativeOffset != "4 hours behind" {
+		t.Errorf("unexpected nyc entry: %+v", nyc)
+	}
+	if tokyo.DayOffset != 1 || tokyo.Day != "+1 day" || tokyo.LocalTime != "2025-06-11 05:00:00" {
+		t.Errorf("unexpected tokyo entry: %+v", tokyo)
+	}
+
+	// Day offsets follow the reference zone
+	tz, _ := time.LoadLocation("Pacific/Kiritimati")
+	req = WorldClockRequest{Names: []string{"NYC"}, Reference: "kiritimati"}
+	req.Normalize()
+	resp = NewWorldClockResponse(&req, now, tz, "kiritimati", locations)
+	if resp.Count != 1 || resp.Locations[0].Day != "-1 day" || resp.Reference.Location != "kiritimati" {
+		t.Errorf("unexpected response: %+v", resp.Locations[0])
+	}
+
+	req = WorldClockRequest{Tags: []string{"apac,emea"}}
+	req.Normalize()
+	if resp = NewWorldClockResponse(&req, now, time.UTC, "", locations); resp.Count != 3 {
+		t.Errorf("expected 3 tagged locations, got %d", resp.Count)
+	}
+}
+
+func TestWorldClockRequestCheckNames(t *testing.T) {
+	locations := []*Location{{Name: "tokyo"}, {Name: "nyc"}}
+
+	req := WorldClockRequest{Names: []string{"Tokyo", "paris", "nyc", "berlin"}}
+	err := req.CheckNames(locations)
+	if !errors.Is(err, ErrWorldClockNotFound) || !strings.HasSuffix(err.Error(), ": paris, berlin") {
+		t.Errorf("CheckNames() = %v, want not found for paris, berlin", err)
+	}
+
+	req.Names = []string{"tokyo"}
+	if err := req.CheckNames(locations); err != nil {
+		t.Errorf("CheckNames() = %v, want nil", err)
+	}
+}
+
+func TestDayLabel(t *testing.T) {
+	for days, want := range map[int]string{0: "", 1: "+1 day", -1: "-1 day", 2: "+2 days", -2: "-2 days"} {
+		if got := dayLabel(days); got != want {
+			t.Errorf("dayLabel(%d) = %q, want %q", days, got, want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package model
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/tzcatalog"
+)
+
+// Reverse lookup validation errors
+var ErrEmptyZoneQuery = errors.New("abbreviation or UTC offset is required")
+
+// ZoneLookupRequest represents a request to find the zones behind an abbreviation or offset
+type ZoneLookupRequest struct {
+	Query string `json:"query"`
+	At    string `json:"at,omitempty"`
+}
+
+// ZoneMatch represents a zone that uses the queried abbreviation or offset
+type ZoneMatch struct {
+	Timezone            string   `json:"timezone"`
+	Status              string   `json:"status"`
+	Offset              string   `json:"offset"`
+	OffsetSeconds       int      `json:"offset_seconds"`
+	Abbreviation        string   `json:"abbreviation"`
+	Until               string   `json:"until,omitempty"`
+	CurrentOffset       string   `json:"current_offset"`
+	CurrentAbbreviation string   `json:"current_abbreviation"`
+	SavedLocations      []string `json:"saved_locations,omitempty"`
+	Preferred           bool     `json:"preferred"`
+}
+
+// ZoneLookupResponse represents the ranked zones matching an abbreviation or offset.
+// Ambiguous is set when the current and seasonal matches disagree on the UTC
+// offset, as with IST (India, Ireland and Israel).
+type ZoneLookupResponse struct {
+	Query     string       `json:"query"`
+	Kind      string       `json:"kind"`
+	At        string       `json:"at"`
+	Ambiguous bool         `json:"ambiguous"`
+	Offsets   []string     `json:"offsets"`
+	Count     int          `json:"count"`
+	Matches   []*ZoneMatch `json:"matches"`
+}
+
+// Normalize normalizes the fields of a ZoneLookupRequest
+func (r *ZoneLookupRequest) Normalize() {
+	r.Query = strings.TrimSpace(r.Query)
+	r.At = strings.TrimSpace(r.At)
+}
+
+// Validate validates a ZoneLookupRequest
+func (r *ZoneLookupRequest) Validate() error {
+	if r.Query == "" {
+		return ErrEmptyZoneQuery
+	}
+	_, err := tzcatalog.ParseQuery(r.Query)
+	return err
+}
+
+// ZoneQuery returns the parsed query. It must only be called after Validate succeeds.
+func (r *ZoneLookupRequest) ZoneQuery() tzcatalog.Query {
+	q, _ := tzcatalog.ParseQuery(r.Query)
+	return q
+}
+
+// NewZoneLookupResponse finds and ranks the zones matching q at the instant at.
+//
+// Matches in use within a year of the instant rank ahead of historical ones.
+// Within each group, zones used by more saved locations come first, then the
+// built-in preference table, then current matches before seasonal ones.
+func NewZoneLookupResponse(q tzcatalog.Query, at time.Time, locations []*Location) *ZoneLookupResponse {
+	// Saved locations count toward the canonical zone behind their timezone
+	saved := make(map[string][]string)
+	for _, l := range locations {
+		zone := l.Timezone
+		if e, ok := tzcatalog.Lookup(zone); ok && !e.Canonical() {
+			zone = e.Link
+		}
+		saved[zone] = append(saved[zone], l.Name)
+	}
+
+	response := &ZoneLookupResponse{
+		At:      at.UTC().Format(time.RFC3339),
+		Kind:    "abbreviation",
+		Query:   q.Abbreviation,
+		Offsets: []string{},
+		Matches: []*ZoneMatch{},
+	}
+	if q.IsOffset() {
+		response.Kind = "offset"
+		response.Query = FormatOffset(q.Offset)
+	}
+
+	matches := tzcatalog.Find(q, at)
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if ha, hb := a.Status == tzcatalog.StatusHistorical, b.Status == tzcatalog.StatusHistorical; ha != hb {
+			return hb
+		}
+		if na, nb := len(saved[a.Zone]), len(saved[b.Zone]); na != nb {
+			return na > nb
+		}
+		if pa, pb := preferenceRank(q, a.Zone), preferenceRank(q, b.Zone); pa != pb {
+			return pa < pb
+		}
+		return a.Status == tzcatalog.StatusCurrent && b.Status != tzcatalog.StatusCurrent
+	})
+
+	offsets := make(map[int]bool)
+	for _, m := range matches {
+		loc, err := time.LoadLocation(m.Zone)
+		if err != nil {
+			continue
+		}
+		currentAbbr, currentOffset := at.In(loc).Zone()
+
+		match := &ZoneMatch{
+			Timezone:            m.Zone,
+			Status:              string(m.Status),
+			Offset:              FormatOffset(m.Offset),
+			OffsetSeconds:       m.Offset,
+			Abbreviation:        m.Abbreviation,
+			CurrentOffset:       FormatOffset(currentOffset),
+			CurrentAbbreviation: currentAbbr,
+			SavedLocations:      saved[m.Zone],
+			Preferred:           tzcatalog.Preference(q, m.Zone) > 0,
+		}
+		if !m.Until.IsZero() {
+			match.Until = m.Until.UTC().Format(time.RFC3339)
+		}
+		response.Matches = append(response.Matches, match)
+
+		if m.Status != tzcatalog.StatusHistorical && !offsets[m.Offset] {
+			offsets[m.Offset] = true
+			response.Offsets = append(response.Offsets, match.Offset)
+		}
+	}
+	response.Count = len(response.Matches)
+	response.Ambiguous = len(response.Offsets) > 1
+
+	return response
+}
+
+// preferenceRank orders preferred zones first, in table order
+func preferenceRank(q tzcatalog.Query, zone string) int {
+	if p := tzcatalog.Preference(q, zone); p > 0 {
+		return p
+	}
+	return int(^uint(0) >> 1)
+}
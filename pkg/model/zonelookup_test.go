@@ -0,0 +1,97 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/tzcatalog"
+)
+
+func TestNewZoneLookupResponse(t *testing.T) {
+	winter := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	resp := NewZoneLookupResponse(tzcatalog.Query{Abbreviation: "IST"}, winter, nil)
+
+	if resp.Kind != "abbreviation" || resp.Query != "IST" {
+		t.Errorf("unexpected query: %s %s", resp.Kind, resp.Query)
+	}
+	if !resp.Ambiguous {
+		t.Errorf("expected IST to be ambiguous, offsets %v", resp.Offsets)
+	}
+	if resp.Count < 3 || resp.Count != len(resp.Matches) {
+		t.Fatalf("unexpected count %d for %d matches", resp.Count, len(resp.Matches))
+	}
+
+	want := []string{"Asia/Kolkata", "Europe/Dublin", "Asia/Jerusalem"}
+	for i, zone := range want {
+		if resp.Matches[i].Timezone != zone || !resp.Matches[i].Preferred {
+			t.Errorf("match %d = %+v, want preferred %s", i, resp.Matches[i], zone)
+		}
+	}
+	dublin := resp.Matches[1]
+	if dublin.Status != "seasonal" || dublin.Offset != "+01:00" || dublin.CurrentAbbreviation != "GMT" {
+		t.Errorf("unexpected Dublin match: %+v", dublin)
+	}
+}
+
+func TestNewZoneLookupResponseSavedLocations(t *testing.T) {
+	winter := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	locations := []*Location{
+		{Name: "tel-aviv", Timezone: "Asia/Jerusalem"},
+		{Name: "haifa", Timezone: "Israel"},
+	}
+
+	resp := NewZoneLookupResponse(tzcatalog.Query{Abbreviation: "IST"}, winter, locations)
+
+	first := resp.Matches[0]
+	if first.Timezone != "Asia/Jerusalem" {
+		t.Fatalf("expected saved locations to rank Asia/Jerusalem first, got %s", first.Timezone)
+	}
+	if len(first.SavedLocations) != 2 {
+		t.Errorf("expected both saved locations, got %v", first.SavedLocations)
+	}
+}
+
+func TestNewZoneLookupResponseOffset(t *testing.T) {
+	at := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	resp := NewZoneLookupResponse(tzcatalog.Query{Offset: 5*3600 + 1800}, at, nil)
+
+	if resp.Kind != "offset" || resp.Query != "+05:30" {
+		t.Errorf("unexpected query: %s %s", resp.Kind, resp.Query)
+	}
+	if resp.Ambiguous {
+		t.Error("expected an offset query not to be ambiguous")
+	}
+	if resp.Matches[0].Timezone != "Asia/Kolkata" {
+		t.Errorf("expected Asia/Kolkata first, got %s", resp.Matches[0].Timezone)
+	}
+	for _, m := range resp.Matches {
+		if m.Status == "historical" && m.Until == "" {
+			t.Errorf("expected historical match %s to report until", m.Timezone)
+		}
+	}
+}
+
+func TestZoneLookupRequestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       ZoneLookupRequest
+		wantError error
+	}{
+		{"abbreviation", ZoneLookupRequest{Query: "PST"}, nil},
+		{"offset", ZoneLookupRequest{Query: "+05:30"}, nil},
+		{"empty", ZoneLookupRequest{Query: " "}, ErrEmptyZoneQuery},
+		{"invalid", ZoneLookupRequest{Query: "Pacific Time"}, tzcatalog.ErrInvalidQuery},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Normalize()
+			if err := tt.req.Validate(); !errors.Is(err, tt.wantError) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantError)
+			}
+		})
+	}
+}
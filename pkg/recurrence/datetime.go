@@ -0,0 +1,88 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// ErrInvalidDateTime is returned for a value that is not a supported date or date-time
+var ErrInvalidDateTime = errors.New("invalid date or date-time")
+
+// Layouts of absolute date-times, which carry a UTC offset or Z
+var absoluteLayouts = []string{
+	"20060102T150405Z",
+	time.RFC3339Nano,
+	time.RFC3339,
+}
+
+// Layouts of floating date-times, which are read on a timezone's wall clock
+var floatingLayouts = []string{
+	"20060102T150405",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// Layouts of dates
+var dateLayouts = []string{
+	"20060102",
+	"2006-01-02",
+}
+
+// DateTime is a DTSTART, RDATE, EXDATE or UNTIL value.
+//
+// Absolute values are instants. Other values are wall-clock times, held as a
+// time in UTC whose fields are the local date and time; DateOnly values are
+// midnight on their date.
+type DateTime struct {
+	Time     time.Time
+	Absolute bool
+	DateOnly bool
+}
+
+// ParseDateTime parses an iCalendar value such as "20250309T023000",
+// "20250309T073000Z" or "20250309", or the equivalent ISO 8601 forms
+func ParseDateTime(value string) (DateTime, error) {
+	value = strings.TrimSpace(value)
+	for _, layout := range absoluteLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return DateTime{Time: t.UTC(), Absolute: true}, nil
+		}
+	}
+	for _, layout := range floatingLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return DateTime{Time: t}, nil
+		}
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return DateTime{Time: t, DateOnly: true}, nil
+		}
+	}
+	return DateTime{}, fmt.Errorf("%w: %q", ErrInvalidDateTime, value)
+}
+
+// Wall returns the value as a wall-clock time in loc
+func (d DateTime) Wall(loc *time.Location) time.Time {
+	if d.Absolute {
+		return timecalc.Floating(d.Time.In(loc))
+	}
+	return d.Time
+}
+
+// String returns the value in iCalendar form
+func (d DateTime) String() string {
+	switch {
+	case d.Absolute:
+		return d.Time.UTC().Format("20060102T150405Z")
+	case d.DateOnly:
+		return d.Time.Format("20060102")
+	default:
+		return d.Time.Format("20060102T150405")
+	}
+}
@@ -0,0 +1,499 @@
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// MaxPeriods bounds the number of FREQ periods walked in one expansion
+const MaxPeriods = 500000
+
+// maxYear is the last year instances are generated for
+const maxYear = 9999
+
+// ErrExpansionLimit is returned when an expansion walks more than MaxPeriods periods
+var ErrExpansionLimit = fmt.Errorf("expansion exceeded %d recurrence periods", MaxPeriods)
+
+// Source identifies where an occurrence came from
+type Source string
+
+// Occurrence sources
+const (
+	SourceDTStart Source = "dtstart"
+	SourceRRule   Source = "rrule"
+	SourceRDate   Source = "rdate"
+)
+
+// Set is a recurrence set: DTSTART plus the instances of an optional RRULE and
+// any RDATEs, minus any EXDATEs
+type Set struct {
+	Start    DateTime
+	Location *time.Location
+	Rule     *Rule
+	RDates   []DateTime
+	ExDates  []DateTime
+}
+
+// Occurrence is one instance of a recurrence set
+type Occurrence struct {
+	// Time is the instant, in the set's location
+	Time time.Time
+	// Wall is the wall-clock time the instance was generated for
+	Wall       time.Time
+	Source     Source
+	Resolution timecalc.WallResolution
+}
+
+// Expansion is the result of expanding a recurrence set over a window
+type Expansion struct {
+	Occurrences []Occurrence
+	// Skipped holds the wall-clock times of RRULE instances that fall in a
+	// DST gap. RFC 5545 section 3.3.10 drops them and they are not counted.
+	Skipped []time.Time
+	// Truncated reports that more occurrences exist in the window than were returned
+	Truncated bool
+}
+
+// Expand returns the occurrences of the set in [from, to), in order.
+//
+// RRULE instances are generated on the wall clock and then placed on the
+// timezone: an instance whose local time does not exist is dropped, and a
+// repeated local time takes its first occurrence. DTSTART and RDATE values in a
+// gap are moved forward instead, as they are ordinary DATE-TIME values. DTSTART
+// always counts as the first instance. A zero to leaves the window open, and at
+// most limit occurrences are returned.
+func (s *Set) Expand(from, to time.Time, limit int) (*Expansion, error) {
+	loc := s.Location
+	exp := &Expansion{}
+	inWindow := func(t time.Time) bool {
+		return !t.Before(from) && (to.IsZero() || t.Before(to))
+	}
+
+	exdates := make([]DateTime, len(s.ExDates))
+	for i, ex := range s.ExDates {
+		exdates[i] = DateTime{Time: ex.Wall(loc), DateOnly: ex.DateOnly}
+	}
+	excluded := func(t, wall time.Time) bool {
+		for _, ex := range exdates {
+			if ex.DateOnly && sameDate(wall, ex.Time) {
+				return true
+			}
+			if !ex.DateOnly {
+				if at, _ := timecalc.ResolveWall(ex.Time, loc); at.Equal(t) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// collect keeps an occurrence and reports whether more are wanted. A few
+	// spare occurrences allow for RDATEs that repeat RRULE instances.
+	var occurrences []Occurrence
+	collect := func(o Occurrence) bool {
+		if inWindow(o.Time) && !excluded(o.Time, o.Wall) {
+			occurrences = append(occurrences, o)
+		}
+		return limit <= 0 || len(occurrences) <= limit+len(s.RDates)
+	}
+
+	startWall := s.Start.Wall(loc)
+	start, res := timecalc.ResolveWall(startWall, loc)
+	collect(Occurrence{Time: start, Wall: startWall, Source: SourceDTStart, Resolution: res})
+
+	if s.Rule != nil {
+		if err := s.expandRule(startWall, from, to, exp, collect); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, rd := range s.RDates {
+		wall := rd.Wall(loc)
+		if rd.DateOnly && !s.Start.DateOnly {
+			// A date takes its time of day from DTSTART
+			wall = time.Date(wall.Year(), wall.Month(), wall.Day(),
+				startWall.Hour(), startWall.Minute(), startWall.Second(), 0, time.UTC)
+		}
+		t, res := timecalc.ResolveWall(wall, loc)
+		collect(Occurrence{Time: t, Wall: wall, Source: SourceRDate, Resolution: res})
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool { return occurrences[i].Time.Before(occurrences[j].Time) })
+	for i, o := range occurrences {
+		if i > 0 && o.Time.Equal(exp.Occurrences[len(exp.Occurrences)-1].Time) {
+			continue
+		}
+		exp.Occurrences = append(exp.Occurrences, o)
+	}
+	if limit > 0 && len(exp.Occurrences) > limit {
+		exp.Occurrences = exp.Occurrences[:limit]
+		exp.Truncated = true
+	}
+
+	return exp, nil
+}
+
+// expandRule adds the RRULE instances after DTSTART to the expansion
+func (s *Set) expandRule(startWall, from, to time.Time, exp *Expansion, collect func(Occurrence) bool) error {
+	r := s.Rule
+	loc := s.Location
+
+	// Without COUNT, instances before the window don't matter, so the walk can
+	// start at the period holding the window
+	first := 0
+	if r.Count == 0 && !from.IsZero() {
+		first = r.periodsBetween(startWall, timecalc.Floating(from.In(loc))) - 1
+		if first < 0 {
+			first = 0
+		}
+	}
+
+	var until time.Time
+	if r.Until != nil {
+		until = r.Until.Wall(loc)
+		if r.Until.DateOnly {
+			until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+	}
+
+	counted := 1 // DTSTART
+	return r.walk(startWall, first, func(wall time.Time) bool {
+		if !wall.After(startWall) {
+			return true
+		}
+		if r.Until != nil && wall.After(until) {
+			return false
+		}
+		if r.Count > 0 && counted >= r.Count {
+			return false
+		}
+
+		t, res := timecalc.ResolveWall(wall, loc)
+		if res == timecalc.WallSkipped {
+			if shifted, _ := timecalc.ResolveWall(wall, loc); !shifted.Before(from) && (to.IsZero() || shifted.Before(to)) {
+				exp.Skipped = append(exp.Skipped, wall)
+			}
+			return true
+		}
+		if r.Until != nil && r.Until.Absolute && t.After(r.Until.Time) {
+			return false
+		}
+		counted++
+		if !to.IsZero() && !t.Before(to) {
+			return false
+		}
+
+		return collect(Occurrence{Time: t, Wall: wall, Source: SourceRRule, Resolution: res})
+	})
+}
+
+// walk calls yield with the candidate instances of the rule, in order, from
+// the period numbered first onwards, until yield returns false
+func (r *Rule) walk(start time.Time, first int, yield func(time.Time) bool) error {
+	r = r.withDefaults(start)
+	for i := first; i < first+MaxPeriods; i++ {
+		candidates := r.period(start, i)
+		if len(r.BySetPos) > 0 {
+			candidates = selectPositions(candidates, r.BySetPos)
+		}
+		for _, c := range candidates {
+			if !yield(c) {
+				return nil
+			}
+		}
+		if r.periodStart(start, i).Year() > maxYear {
+			return nil
+		}
+	}
+	return ErrExpansionLimit
+}
+
+// withDefaults returns a copy of the rule with the parts RFC 5545 takes from
+// DTSTART filled in
+func (r *Rule) withDefaults(start time.Time) *Rule {
+	c := *r
+	if len(c.ByWeekNo) == 0 && len(c.ByYearDay) == 0 && len(c.ByMonthDay) == 0 && len(c.ByDay) == 0 {
+		switch c.Freq {
+		case Yearly:
+			if len(c.ByMonth) == 0 {
+				c.ByMonth = []int{int(start.Month())}
+			}
+			c.ByMonthDay = []int{start.Day()}
+		case Monthly:
+			c.ByMonthDay = []int{start.Day()}
+		case Weekly:
+			c.ByDay = []WeekdayNum{{Weekday: start.Weekday()}}
+		}
+	}
+	if c.Freq >= Daily && len(c.ByHour) == 0 {
+		c.ByHour = []int{start.Hour()}
+	}
+	if c.Freq >= Hourly && len(c.ByMinute) == 0 {
+		c.ByMinute = []int{start.Minute()}
+	}
+	if c.Freq >= Minutely && len(c.BySecond) == 0 {
+		c.BySecond = []int{start.Second()}
+	}
+	return &c
+}
+
+// periodStart returns the first wall-clock time of period i
+func (r *Rule) periodStart(start time.Time, i int) time.Time {
+	n := i * r.Interval
+	year, month, day := start.Date()
+	switch r.Freq {
+	case Yearly:
+		return date(year+n, 1, 1)
+	case Monthly:
+		return date(year, month+time.Month(n), 1)
+	case Weekly:
+		back := (int(start.Weekday()) - int(r.WeekStart) + 7) % 7
+		return date(year, month, day-back+7*n)
+	case Daily:
+		return date(year, month, day+n)
+	case Hourly:
+		return start.Truncate(time.Hour).Add(time.Duration(n) * time.Hour)
+	case Minutely:
+		return start.Truncate(time.Minute).Add(time.Duration(n) * time.Minute)
+	default:
+		return start.Truncate(time.Second).Add(time.Duration(n) * time.Second)
+	}
+}
+
+// period returns the candidate instances of period i, in order
+func (r *Rule) period(start time.Time, i int) []time.Time {
+	first := r.periodStart(start, i)
+
+	var days []time.Time
+	switch r.Freq {
+	case Yearly:
+		days = r.filterDays(first, first.AddDate(1, 0, 0))
+	case Monthly:
+		days = r.filterDays(first, first.AddDate(0, 1, 0))
+	case Weekly:
+		days = r.filterDays(first, first.AddDate(0, 0, 7))
+	case Daily:
+		days = r.filterDays(first, first.AddDate(0, 0, 1))
+	default:
+		return r.subDaily(first)
+	}
+
+	var out []time.Time
+	for _, d := range days {
+		for _, h := range r.ByHour {
+			for _, m := range r.ByMinute {
+				for _, s := range r.BySecond {
+					out = append(out, time.Date(d.Year(), d.Month(), d.Day(), h, m, s, 0, time.UTC))
+				}
+			}
+		}
+	}
+	return out
+}
+
+// subDaily returns the candidate instances of the HOURLY, MINUTELY or
+// SECONDLY period starting at at
+func (r *Rule) subDaily(at time.Time) []time.Time {
+	if !r.matchDay(at) || !allows(r.ByHour, at.Hour()) {
+		return nil
+	}
+	minutes := r.ByMinute
+	if r.Freq < Hourly {
+		if !allows(minutes, at.Minute()) {
+			return nil
+		}
+		minutes = []int{at.Minute()}
+	}
+	seconds := r.BySecond
+	if r.Freq == Secondly {
+		if !allows(seconds, at.Second()) {
+			return nil
+		}
+		seconds = []int{at.Second()}
+	}
+
+	var out []time.Time
+	for _, m := range minutes {
+		for _, s := range seconds {
+			out = append(out, time.Date(at.Year(), at.Month(), at.Day(), at.Hour(), m, s, 0, time.UTC))
+		}
+	}
+	return out
+}
+
+// filterDays returns the days in [first, end) that match the day-level parts
+func (r *Rule) filterDays(first, end time.Time) []time.Time {
+	var out []time.Time
+	for d := first; d.Before(end); d = d.AddDate(0, 0, 1) {
+		if r.matchDay(d) {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// matchDay reports whether d matches BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY
+func (r *Rule) matchDay(d time.Time) bool {
+	if len(r.ByMonth) > 0 && !matchesAny(r.ByMonth, int(d.Month())) {
+		return false
+	}
+	if len(r.ByWeekNo) > 0 {
+		week, weeks := weekNumber(d, r.WeekStart)
+		if !matchesAny(r.ByWeekNo, week) && !matchesAny(r.ByWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+	if len(r.ByYearDay) > 0 {
+		yearDays := date(d.Year()+1, 1, 1).Sub(date(d.Year(), 1, 1)).Hours() / 24
+		if !matchesAny(r.ByYearDay, d.YearDay()) && !matchesAny(r.ByYearDay, d.YearDay()-int(yearDays)-1) {
+			return false
+		}
+	}
+	if len(r.ByMonthDay) > 0 {
+		monthDays := daysInMonth(d)
+		if !matchesAny(r.ByMonthDay, d.Day()) && !matchesAny(r.ByMonthDay, d.Day()-monthDays-1) {
+			return false
+		}
+	}
+	if len(r.ByDay) > 0 && !r.matchWeekday(d) {
+		return false
+	}
+	return true
+}
+
+// matchWeekday reports whether d matches a BYDAY entry. Numbered entries count
+// within the month for MONTHLY rules and YEARLY rules with BYMONTH, and within
+// the year otherwise.
+func (r *Rule) matchWeekday(d time.Time) bool {
+	for _, wd := range r.ByDay {
+		if wd.Weekday != d.Weekday() {
+			continue
+		}
+		if wd.N == 0 {
+			return true
+		}
+		var index, length int
+		if r.Freq == Monthly || len(r.ByMonth) > 0 {
+			index, length = d.Day(), daysInMonth(d)
+		} else {
+			index = d.YearDay()
+			length = int(date(d.Year()+1, 1, 1).Sub(date(d.Year(), 1, 1)).Hours() / 24)
+		}
+		if wd.N == (index-1)/7+1 || wd.N == -((length-index)/7+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// periodsBetween returns the number of whole periods from the one holding start
+// to the one holding t
+func (r *Rule) periodsBetween(start, t time.Time) int {
+	var units int
+	switch r.Freq {
+	case Yearly:
+		units = t.Year() - start.Year()
+	case Monthly:
+		units = (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	case Weekly:
+		units = int(t.Sub(start).Hours()/24) / 7
+	case Daily:
+		units = int(t.Sub(start).Hours() / 24)
+	case Hourly:
+		units = int(t.Sub(start) / time.Hour)
+	case Minutely:
+		units = int(t.Sub(start) / time.Minute)
+	default:
+		units = int(t.Sub(start) / time.Second)
+	}
+	return units / r.Interval
+}
+
+// weekNumber returns the RFC 5545 week number of d for weeks starting on
+// wkst, and the number of weeks in that week-numbering year. Week 1 is the
+// first week with at least four days in the year.
+func weekNumber(d time.Time, wkst time.Weekday) (int, int) {
+	year := d.Year()
+	start := firstWeekStart(year, wkst)
+	if d.Before(start) {
+		year--
+		start = firstWeekStart(year, wkst)
+	} else if next := firstWeekStart(year+1, wkst); !d.Before(next) {
+		year++
+		start = next
+	}
+	weeks := int(firstWeekStart(year+1, wkst).Sub(start).Hours()/24) / 7
+	return int(d.Sub(start).Hours()/24)/7 + 1, weeks
+}
+
+// firstWeekStart returns the first day of week 1 of year
+func firstWeekStart(year int, wkst time.Weekday) time.Time {
+	jan1 := date(year, 1, 1)
+	offset := (int(jan1.Weekday()) - int(wkst) + 7) % 7
+	if offset <= 3 {
+		return jan1.AddDate(0, 0, -offset)
+	}
+	return jan1.AddDate(0, 0, 7-offset)
+}
+
+// selectPositions applies BYSETPOS to the candidates of one period
+func selectPositions(candidates []time.Time, positions []int) []time.Time {
+	var indexes []int
+	for _, pos := range positions {
+		i := pos - 1
+		if pos < 0 {
+			i = len(candidates) + pos
+		}
+		if i >= 0 && i < len(candidates) {
+			indexes = append(indexes, i)
+		}
+	}
+	indexes = sortedUnique(indexes)
+
+	out := make([]time.Time, len(indexes))
+	for j, i := range indexes {
+		out[j] = candidates[i]
+	}
+	return out
+}
+
+// date returns midnight UTC on the given date, normalizing out-of-range values
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// daysInMonth returns the number of days in d's month
+func daysInMonth(d time.Time) int {
+	return date(d.Year(), d.Month()+1, 0).Day()
+}
+
+// sameDate reports whether two wall-clock times fall on the same date
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// matchesAny reports whether values contains v
+func matchesAny(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// allows reports whether v passes a limiting part, which allows anything when empty
+func allows(values []int, v int) bool {
+	return len(values) == 0 || matchesAny(values, v)
+}
+
+// IsClientError reports whether err was caused by bad input rather than a backend failure
+func IsClientError(err error) bool {
+	return errors.Is(err, ErrInvalidRule) || errors.Is(err, ErrInvalidDateTime) || errors.Is(err, ErrExpansionLimit)
+}
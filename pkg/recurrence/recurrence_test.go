@@ -0,0 +1,293 @@
+package recurrence
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+// expand expands rule from dtstart in loc and returns the local times of the occurrences
+func expand(t *testing.T, loc *time.Location, dtstart, rule string, exdates ...string) []string {
+	t.Helper()
+	start, err := ParseDateTime(dtstart)
+	if err != nil {
+		t.Fatalf("ParseDateTime(%q) error = %v", dtstart, err)
+	}
+	set := &Set{Start: start, Location: loc}
+	if rule != "" {
+		if set.Rule, err = ParseRule(rule); err != nil {
+			t.Fatalf("ParseRule(%q) error = %v", rule, err)
+		}
+	}
+	for _, ex := range exdates {
+		dt, err := ParseDateTime(ex)
+		if err != nil {
+			t.Fatalf("ParseDateTime(%q) error = %v", ex, err)
+		}
+		set.ExDates = append(set.ExDates, dt)
+	}
+
+	exp, err := set.Expand(time.Time{}, time.Time{}, 50)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	out := make([]string, len(exp.Occurrences))
+	for i, o := range exp.Occurrences {
+		out[i] = o.Time.Format("2006-01-02 15:04")
+	}
+	return out
+}
+
+func TestExpandRFC5545Examples(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name    string
+		dtstart string
+		rule    string
+		exdates []string
+		want    []string
+	}{
+		{
+			name:    "daily for 4 occurrences",
+			dtstart: "19970902T090000",
+			rule:    "FREQ=DAILY;COUNT=4",
+			want:    []string{"1997-09-02 09:00", "1997-09-03 09:00", "1997-09-04 09:00", "1997-09-05 09:00"},
+		},
+		{
+			name:    "monthly on the first Friday",
+			dtstart: "19970905T090000",
+			rule:    "FREQ=MONTHLY;COUNT=4;BYDAY=1FR",
+			want:    []string{"1997-09-05 09:00", "1997-10-03 09:00", "1997-11-07 09:00", "1997-12-05 09:00"},
+		},
+		{
+			name:    "last weekday of the month",
+			dtstart: "19970930T090000",
+			rule:    "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=4",
+			want:    []string{"1997-09-30 09:00", "1997-10-31 09:00", "1997-11-28 09:00", "1997-12-31 09:00"},
+		},
+		{
+			name:    "every Friday the 13th",
+			dtstart: "19970902T090000",
+			rule:    "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13;COUNT=5",
+			exdates: []string{"19970902T090000"},
+			want:    []string{"1998-02-13 09:00", "1998-03-13 09:00", "1998-11-13 09:00", "1999-08-13 09:00"},
+		},
+		{
+			name:    "Monday of week 20",
+			dtstart: "19970512T090000",
+			rule:    "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3",
+			want:    []string{"1997-05-12 09:00", "1998-05-11 09:00", "1999-05-17 09:00"},
+		},
+		{
+			name:    "US presidential election day",
+			dtstart: "19961105T090000",
+			rule:    "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+			want:    []string{"1996-11-05 09:00", "2000-11-07 09:00", "2004-11-02 09:00"},
+		},
+		{
+			name:    "monthly on the 31st skips short months",
+			dtstart: "20250131T090000",
+			rule:    "FREQ=MONTHLY;COUNT=3",
+			want:    []string{"2025-01-31 09:00", "2025-03-31 09:00", "2025-05-31 09:00"},
+		},
+		{
+			name:    "every other week on Tuesday and Thursday until a UTC instant",
+			dtstart: "19970902T090000",
+			rule:    "FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;UNTIL=19970918T130000Z",
+			want:    []string{"1997-09-02 09:00", "1997-09-04 09:00", "1997-09-16 09:00", "1997-09-18 09:00"},
+		},
+		{
+			name:    "every 20 minutes within working hours",
+			dtstart: "19970902T090000",
+			rule:    "FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10;COUNT=7",
+			want: []string{"1997-09-02 09:00", "1997-09-02 09:20", "1997-09-02 09:40",
+				"1997-09-02 10:00", "1997-09-02 10:20", "1997-09-02 10:40", "1997-09-03 09:00"},
+		},
+		{
+			name:    "yearly on the last day of February",
+			dtstart: "20230228T120000",
+			rule:    "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=-1;COUNT=3",
+			want:    []string{"2023-02-28 12:00", "2024-02-29 12:00", "2025-02-28 12:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expand(t, ny, tt.dtstart, tt.rule, tt.exdates...)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	// 02:30 does not exist on 9 March 2025, so that instance is dropped and not counted
+	rule, _ := ParseRule("FREQ=DAILY;COUNT=3")
+	set := &Set{Start: DateTime{Time: time.Date(2025, 3, 8, 2, 30, 0, 0, time.UTC)}, Location: ny, Rule: rule}
+	exp, err := set.Expand(time.Time{}, time.Time{}, 10)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	var got []string
+	for _, o := range exp.Occurrences {
+		got = append(got, o.Time.Format(time.RFC3339))
+	}
+	want := []string{"2025-03-08T02:30:00-05:00", "2025-03-10T02:30:00-04:00", "2025-03-11T02:30:00-04:00"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if len(exp.Skipped) != 1 || exp.Skipped[0].Day() != 9 {
+		t.Errorf("expected 9 March to be skipped, got %v", exp.Skipped)
+	}
+
+	// 01:30 happens twice on 2 November 2025; the first (EDT) one is used
+	rule, _ = ParseRule("FREQ=DAILY;COUNT=3")
+	set = &Set{Start: DateTime{Time: time.Date(2025, 11, 1, 1, 30, 0, 0, time.UTC)}, Location: ny, Rule: rule}
+	exp, err = set.Expand(time.Time{}, time.Time{}, 10)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	if o := exp.Occurrences[1]; o.Time.Format(time.RFC3339) != "2025-11-02T01:30:00-04:00" || o.Resolution != timecalc.WallRepeated {
+		t.Errorf("expected first 01:30 on 2 November, got %v (%v)", o.Time, o.Resolution)
+	}
+	if o := exp.Occurrences[2]; o.Time.Format(time.RFC3339) != "2025-11-03T01:30:00-05:00" {
+		t.Errorf("expected 01:30 EST on 3 November, got %v", o.Time)
+	}
+
+	// A DTSTART or RDATE in the gap is moved forward by the gap instead
+	set = &Set{
+		Start:    DateTime{Time: time.Date(2025, 3, 9, 2, 30, 0, 0, time.UTC)},
+		Location: ny,
+		RDates:   []DateTime{{Time: time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC), DateOnly: true}},
+	}
+	exp, err = set.Expand(time.Time{}, time.Time{}, 10)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	if len(exp.Occurrences) != 2 {
+		t.Fatalf("expected 2 occurrences, got %v", exp.Occurrences)
+	}
+	if o := exp.Occurrences[0]; o.Time.Format(time.RFC3339) != "2025-03-09T03:30:00-04:00" || o.Resolution != timecalc.WallSkipped {
+		t.Errorf("expected DTSTART at 03:30 EDT, got %v (%v)", o.Time, o.Resolution)
+	}
+	if o := exp.Occurrences[1]; o.Source != SourceRDate || o.Time.Format(time.RFC3339) != "2025-03-12T02:30:00-04:00" {
+		t.Errorf("expected date RDATE at DTSTART's time of day, got %v from %s", o.Time, o.Source)
+	}
+}
+
+func TestExpandWindow(t *testing.T) {
+	london := mustLoad(t, "Europe/London")
+	rule, _ := ParseRule("FREQ=WEEKLY;BYDAY=MO")
+	set := &Set{
+		Start:    DateTime{Time: time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC)},
+		Location: london,
+		Rule:     rule,
+		ExDates:  []DateTime{{Time: time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC), DateOnly: true}},
+	}
+
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, london)
+	to := time.Date(2025, 7, 1, 0, 0, 0, 0, london)
+	exp, err := set.Expand(from, to, 10)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	var days []int
+	for _, o := range exp.Occurrences {
+		days = append(days, o.Time.Day())
+	}
+	if len(days) != 4 || days[0] != 2 || days[1] != 16 || exp.Truncated {
+		t.Errorf("expected Mondays 2, 16, 23 and 30 June, got %v (truncated %v)", days, exp.Truncated)
+	}
+
+	exp, err = set.Expand(from, time.Time{}, 3)
+	if err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+	if len(exp.Occurrences) != 3 || !exp.Truncated {
+		t.Errorf("expected 3 occurrences and truncation, got %d (truncated %v)", len(exp.Occurrences), exp.Truncated)
+	}
+
+	// Rules that never match end at year 9999 or the period limit
+	never, _ := ParseRule("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	set = &Set{Start: DateTime{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}, Location: time.UTC, Rule: never}
+	if exp, err := set.Expand(time.Time{}, time.Time{}, 10); err != nil || len(exp.Occurrences) != 1 {
+		t.Errorf("expected only DTSTART from a rule that never matches, got %v, %v", exp, err)
+	}
+	set.Rule, _ = ParseRule("FREQ=DAILY;BYMONTH=2;BYMONTHDAY=30")
+	if _, err := set.Expand(time.Time{}, time.Time{}, 10); !errors.Is(err, ErrExpansionLimit) {
+		t.Errorf("expected ErrExpansionLimit, got %v", err)
+	}
+}
+
+func TestParseRule(t *testing.T) {
+	rule, err := ParseRule("RRULE:byday=-1fr,MO;freq=monthly;interval=2;wkst=SU")
+	if err != nil {
+		t.Fatalf("ParseRule() error = %v", err)
+	}
+	if got := rule.String(); got != "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR,MO;WKST=SU" {
+		t.Errorf("String() = %s", got)
+	}
+
+	invalid := []string{
+		"",
+		"BYDAY=MO",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20250101",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=MONTHLY;BYWEEKNO=3",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=DAILY;BYDAY=XX",
+		"FREQ=DAILY;COLOR=RED",
+	}
+	for _, s := range invalid {
+		if _, err := ParseRule(s); !errors.Is(err, ErrInvalidRule) {
+			t.Errorf("ParseRule(%q) error = %v, want ErrInvalidRule", s, err)
+		}
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	tests := []struct {
+		input    string
+		want     string
+		absolute bool
+		dateOnly bool
+	}{
+		{"20250309T023000", "20250309T023000", false, false},
+		{"2025-03-09T02:30", "20250309T023000", false, false},
+		{"20250309T073000Z", "20250309T073000Z", true, false},
+		{"2025-03-09T02:30:00-05:00", "20250309T073000Z", true, false},
+		{"20250309", "20250309", false, true},
+		{"2025-03-09", "20250309", false, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDateTime(tt.input)
+		if err != nil || got.String() != tt.want || got.Absolute != tt.absolute || got.DateOnly != tt.dateOnly {
+			t.Errorf("ParseDateTime(%q) = %+v, %v, want %s", tt.input, got, err, tt.want)
+		}
+	}
+
+	if _, err := ParseDateTime("next tuesday"); !errors.Is(err, ErrInvalidDateTime) {
+		t.Errorf("expected ErrInvalidDateTime, got %v", err)
+	}
+}
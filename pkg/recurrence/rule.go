@@ -0,0 +1,322 @@
+// Package recurrence expands RFC 5545 recurrence sets (DTSTART, RRULE, RDATE
+// and EXDATE) into occurrences on a timezone's wall clock.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRule is returned for a malformed or unsupported RRULE
+var ErrInvalidRule = errors.New("invalid RRULE")
+
+// Frequency is the FREQ of a recurrence rule
+type Frequency int
+
+// Frequencies in increasing period length
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = []string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+// String returns the RRULE name of the frequency
+func (f Frequency) String() string {
+	return frequencyNames[f]
+}
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// WeekdayNum is a BYDAY entry such as "MO", "2TU" or "-1FR".
+// N is zero when every such weekday in the period matches.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// String returns the RRULE form of the entry
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayCodes[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+}
+
+// Rule is a parsed RRULE
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *DateTime
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+	WeekStart  time.Weekday
+}
+
+// ParseRule parses an RRULE value such as "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10".
+// A leading "RRULE:" is accepted. Part names are case-insensitive and may appear
+// in any order, but each at most once.
+func ParseRule(s string) (*Rule, error) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 6 && strings.EqualFold(s[:6], "RRULE:") {
+		s = s[6:]
+	}
+
+	r := &Rule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidRule, part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %s appears more than once", ErrInvalidRule, name)
+		}
+		seen[name] = true
+
+		var err error
+		switch name {
+		case "FREQ":
+			err = r.parseFreq(value)
+		case "INTERVAL":
+			r.Interval, err = parseInt(name, value, 1, 1<<20)
+		case "COUNT":
+			r.Count, err = parseInt(name, value, 1, 1<<20)
+		case "UNTIL":
+			var until DateTime
+			if until, err = ParseDateTime(value); err == nil {
+				r.Until = &until
+			}
+		case "BYSECOND":
+			r.BySecond, err = parseIntList(name, value, 0, 59, false)
+		case "BYMINUTE":
+			r.ByMinute, err = parseIntList(name, value, 0, 59, false)
+		case "BYHOUR":
+			r.ByHour, err = parseIntList(name, value, 0, 23, false)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseIntList(name, value, 1, 31, true)
+		case "BYYEARDAY":
+			r.ByYearDay, err = parseIntList(name, value, 1, 366, true)
+		case "BYWEEKNO":
+			r.ByWeekNo, err = parseIntList(name, value, 1, 53, true)
+		case "BYMONTH":
+			r.ByMonth, err = parseIntList(name, value, 1, 12, false)
+		case "BYSETPOS":
+			r.BySetPos, err = parseIntList(name, value, 1, 366, true)
+		case "WKST":
+			r.WeekStart, err = parseWeekday(value)
+		default:
+			return nil, fmt.Errorf("%w: unknown part %s", ErrInvalidRule, name)
+		}
+		if err != nil {
+			if errors.Is(err, ErrInvalidRule) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidRule, name, err)
+		}
+	}
+
+	if !seen["FREQ"] {
+		return nil, fmt.Errorf("%w: FREQ is required", ErrInvalidRule)
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// validate checks the combinations of parts that RFC 5545 rules out
+func (r *Rule) validate() error {
+	switch {
+	case r.Count > 0 && r.Until != nil:
+		return fmt.Errorf("%w: COUNT and UNTIL cannot both be set", ErrInvalidRule)
+	case len(r.ByWeekNo) > 0 && r.Freq != Yearly:
+		return fmt.Errorf("%w: BYWEEKNO is only valid with FREQ=YEARLY", ErrInvalidRule)
+	case len(r.ByYearDay) > 0 && (r.Freq == Daily || r.Freq == Weekly || r.Freq == Monthly):
+		return fmt.Errorf("%w: BYYEARDAY is not valid with FREQ=%s", ErrInvalidRule, r.Freq)
+	case len(r.ByMonthDay) > 0 && r.Freq == Weekly:
+		return fmt.Errorf("%w: BYMONTHDAY is not valid with FREQ=WEEKLY", ErrInvalidRule)
+	case len(r.BySetPos) > 0 && !r.hasByRule():
+		return fmt.Errorf("%w: BYSETPOS requires another BYxxx part", ErrInvalidRule)
+	}
+	for _, wd := range r.ByDay {
+		if wd.N == 0 {
+			continue
+		}
+		if r.Freq != Monthly && r.Freq != Yearly {
+			return fmt.Errorf("%w: numbered BYDAY (%s) is only valid with FREQ=MONTHLY or YEARLY", ErrInvalidRule, wd)
+		}
+		if r.Freq == Yearly && len(r.ByWeekNo) > 0 {
+			return fmt.Errorf("%w: numbered BYDAY (%s) cannot be combined with BYWEEKNO", ErrInvalidRule, wd)
+		}
+		if r.Freq == Monthly && (wd.N > 5 || wd.N < -5) {
+			return fmt.Errorf("%w: BYDAY %s is out of range for FREQ=MONTHLY", ErrInvalidRule, wd)
+		}
+	}
+	return nil
+}
+
+// String returns the rule in canonical RRULE form, without the "RRULE:" prefix
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+	if r.Interval != 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.String())
+	}
+	add := func(name string, values []int) {
+		if len(values) == 0 {
+			return
+		}
+		s := make([]string, len(values))
+		for i, v := range values {
+			s[i] = strconv.Itoa(v)
+		}
+		parts = append(parts, name+"="+strings.Join(s, ","))
+	}
+	add("BYMONTH", r.ByMonth)
+	add("BYWEEKNO", r.ByWeekNo)
+	add("BYYEARDAY", r.ByYearDay)
+	add("BYMONTHDAY", r.ByMonthDay)
+	if len(r.ByDay) > 0 {
+		s := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			s[i] = wd.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(s, ","))
+	}
+	add("BYHOUR", r.ByHour)
+	add("BYMINUTE", r.ByMinute)
+	add("BYSECOND", r.BySecond)
+	add("BYSETPOS", r.BySetPos)
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayCodes[r.WeekStart])
+	}
+	return strings.Join(parts, ";")
+}
+
+// hasByRule reports whether any BYxxx part other than BYSETPOS is set
+func (r *Rule) hasByRule() bool {
+	return len(r.BySecond) > 0 || len(r.ByMinute) > 0 || len(r.ByHour) > 0 ||
+		len(r.ByDay) > 0 || len(r.ByMonthDay) > 0 || len(r.ByYearDay) > 0 ||
+		len(r.ByWeekNo) > 0 || len(r.ByMonth) > 0
+}
+
+// parseFreq parses the FREQ part
+func (r *Rule) parseFreq(value string) error {
+	for i, name := range frequencyNames {
+		if value == name {
+			r.Freq = Frequency(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown frequency %s", value)
+}
+
+// parseInt parses a number between min and max
+func parseInt(name, value string, min, max int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < min || n > max {
+		return 0, fmt.Errorf("%w: %s must be a number between %d and %d", ErrInvalidRule, name, min, max)
+	}
+	return n, nil
+}
+
+// parseIntList parses a comma-separated list of numbers between min and max,
+// or their negatives when signed, returning them sorted and without duplicates
+func parseIntList(name, value string, min, max int, signed bool) ([]int, error) {
+	var out []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(strings.TrimPrefix(item, "+"))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s has invalid value %q", ErrInvalidRule, name, item)
+		}
+		abs := n
+		if signed && n < 0 {
+			abs = -n
+		}
+		if abs < min || abs > max {
+			return nil, fmt.Errorf("%w: %s value %d is out of range", ErrInvalidRule, name, n)
+		}
+		out = append(out, n)
+	}
+	return sortedUnique(out), nil
+}
+
+// parseByDay parses a BYDAY list
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var out []WeekdayNum
+	seen := make(map[WeekdayNum]bool)
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("%w: BYDAY has invalid value %q", ErrInvalidRule, item)
+		}
+		wd, err := parseWeekday(item[len(item)-2:])
+		if err != nil {
+			return nil, err
+		}
+		var n int
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err = strconv.Atoi(strings.TrimPrefix(prefix, "+"))
+			if err != nil || n == 0 || n > 53 || n < -53 {
+				return nil, fmt.Errorf("%w: BYDAY has invalid value %q", ErrInvalidRule, item)
+			}
+		}
+		entry := WeekdayNum{N: n, Weekday: wd}
+		if !seen[entry] {
+			seen[entry] = true
+			out = append(out, entry)
+		}
+	}
+	return out, nil
+}
+
+// parseWeekday parses a two-letter weekday code
+func parseWeekday(code string) (time.Weekday, error) {
+	for i, c := range weekdayCodes {
+		if code == c {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: unknown weekday %q", ErrInvalidRule, code)
+}
+
+// sortedUnique sorts values and drops duplicates
+func sortedUnique(values []int) []int {
+	sort.Ints(values)
+	out := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
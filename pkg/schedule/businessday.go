@@ -0,0 +1,57 @@
+package schedule
+
+import "time"
+
+// BusinessDays decides which local dates are business days: every date that
+// is neither a weekend day nor a holiday.
+type BusinessDays struct {
+	// Weekend marks the non-working days of the week, indexed by time.Weekday
+	Weekend [7]bool
+	// Holidays holds non-working dates in DateLayout
+	Holidays map[string]bool
+}
+
+// IsBusinessDay reports whether the local date of t is a business day
+func (b BusinessDays) IsBusinessDay(t time.Time) bool {
+	return !b.Weekend[t.Weekday()] && !b.Holidays[t.Format(DateLayout)]
+}
+
+// Add moves t by n business days, counting from the day after t (or before it
+// when n is negative), and keeps t's wall-clock time. t itself need not be a
+// business day; Add(t, 0) returns t.
+//
+// At least one day of the week must be a working day.
+func (b BusinessDays) Add(t time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		t = t.AddDate(0, 0, step)
+		if b.IsBusinessDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// Count returns the number of business days passed when walking from from's
+// date to to's date, counting to but not from, so that Count(t, Add(t, n)) == n.
+// The count is negative when to is before from. Both must be in the same location.
+func (b BusinessDays) Count(from, to time.Time) int {
+	first, last := from.Format(DateLayout), to.Format(DateLayout)
+	step := 1
+	if last < first {
+		step = -1
+	}
+
+	// Compare dates rather than instants so DST changes cannot end the walk early
+	count := 0
+	for day := from; day.Format(DateLayout) != last; {
+		day = day.AddDate(0, 0, step)
+		if b.IsBusinessDay(day) {
+			count += step
+		}
+	}
+	return count
+}
@@ -0,0 +1,199 @@
+// Package schedule finds times that fall within the working hours of several
+// participants in different timezones.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidClock is returned for a malformed time of day
+var ErrInvalidClock = errors.New("time of day must be HH:MM between 00:00 and 24:00")
+
+// Interval is a half-open span of time [Start, End)
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// WorkingHours is a daily window of local wall-clock time, as offsets from midnight.
+// An End at or before Start means the window runs past midnight.
+type WorkingHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// ParseClock parses a time of day such as "09:00" or "17:30" as an offset from midnight.
+// "24:00" is accepted as the end of the day.
+func ParseClock(s string) (time.Duration, error) {
+	hours, minutes, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok || len(hours) == 0 || len(hours) > 2 || len(minutes) != 2 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidClock, s)
+	}
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidClock, s)
+	}
+	m, err := strconv.Atoi(minutes)
+	if err != nil || h < 0 || m < 0 || m >= 60 || h > 24 || (h == 24 && m != 0) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidClock, s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// FormatClock formats an offset from midnight as HH:MM
+func FormatClock(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// Length returns the length of the working day
+func (w WorkingHours) Length() time.Duration {
+	if w.End > w.Start {
+		return w.End - w.Start
+	}
+	return 24*time.Hour - w.Start + w.End
+}
+
+// On returns the window that opens on the local date of day, in day's location
+func (w WorkingHours) On(day time.Time) Interval {
+	start := atClock(day, w.Start)
+	end := atClock(day, w.End)
+	if w.End <= w.Start {
+		end = atClock(day.AddDate(0, 0, 1), w.End)
+	}
+	return Interval{start, end}
+}
+
+// Intervals returns the working periods in loc that overlap [from, to).
+//
+// Each local date gets its own window built on the wall clock, so the window
+// keeps its local hours when a DST transition changes the zone's offset.
+// Saturdays and Sundays are skipped unless weekends is set; a window that runs
+// past midnight belongs to the day it starts on.
+func (w WorkingHours) Intervals(loc *time.Location, from, to time.Time, weekends bool) []Interval {
+	var out []Interval
+
+	// Start a day early to catch windows that began before from and run past midnight
+	first := from.In(loc).AddDate(0, 0, -1)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	for !day.After(to) {
+		if weekends || (day.Weekday() != time.Saturday && day.Weekday() != time.Sunday) {
+			if iv, ok := clip(w.On(day), from, to); ok {
+				out = append(out, iv)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return out
+}
+
+// Intersect returns the spans covered by every list of intervals.
+// Each list must be sorted and free of overlaps.
+func Intersect(lists ...[]Interval) []Interval {
+	if len(lists) == 0 {
+		return nil
+	}
+
+	result := lists[0]
+	for _, list := range lists[1:] {
+		var merged []Interval
+		i, j := 0, 0
+		for i < len(result) && j < len(list) {
+			start := later(result[i].Start, list[j].Start)
+			end := earlier(result[i].End, list[j].End)
+			if start.Before(end) {
+				merged = append(merged, Interval{start, end})
+			}
+			if result[i].End.Before(list[j].End) {
+				i++
+			} else {
+				j++
+			}
+		}
+		result = merged
+	}
+
+	return result
+}
+
+// Subtract returns the spans of a that are not covered by b.
+// Both lists must be sorted and free of overlaps.
+func Subtract(a, b []Interval) []Interval {
+	var out []Interval
+	j := 0
+	for _, iv := range a {
+		// Skip the parts of b that end before this interval starts
+		for j < len(b) && !b[j].End.After(iv.Start) {
+			j++
+		}
+		start := iv.Start
+		for k := j; k < len(b) && b[k].Start.Before(iv.End); k++ {
+			if b[k].Start.After(start) {
+				out = append(out, Interval{start, b[k].Start})
+			}
+			start = later(start, b[k].End)
+		}
+		if start.Before(iv.End) {
+			out = append(out, Interval{start, iv.End})
+		}
+	}
+	return out
+}
+
+// Gaps returns the spans of window not covered by any of the intervals,
+// which may be unsorted and overlap
+func Gaps(intervals []Interval, window Interval) []Interval {
+	return Subtract([]Interval{window}, Merge(intervals))
+}
+
+// Slots returns every slot of the given length inside the intervals, in
+// chronological order. Slots start on multiples of step counted from midnight
+// UTC, so a step that divides an hour gives slots on round UTC times.
+func Slots(intervals []Interval, length, step time.Duration) []Interval {
+	var out []Interval
+	for _, iv := range intervals {
+		start := iv.Start.Truncate(step)
+		if start.Before(iv.Start) {
+			start = start.Add(step)
+		}
+		for end := start.Add(length); !end.After(iv.End); start, end = start.Add(step), end.Add(step) {
+			out = append(out, Interval{start, end})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Start.Before(out[j].Start) })
+	return out
+}
+
+// atClock returns the wall-clock time offset from midnight of day in its location
+func atClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(),
+		int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, day.Location())
+}
+
+// clip limits iv to [from, to), reporting whether anything is left
+func clip(iv Interval, from, to time.Time) (Interval, bool) {
+	iv.Start = later(iv.Start, from)
+	iv.End = earlier(iv.End, to)
+	return iv, iv.Start.Before(iv.End)
+}
+
+// later returns the later of two instants
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// earlier returns the earlier of two instants
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,215 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Duration
+	}{
+		{"09:00", 9 * time.Hour},
+		{"9:30", 9*time.Hour + 30*time.Minute},
+		{"00:00", 0},
+		{"24:00", 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		got, err := ParseClock(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseClock(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+		}
+		if tt.want < 24*time.Hour && FormatClock(got) != (time.Time{}).Add(got).Format("15:04") {
+			t.Errorf("FormatClock(%v) = %s", got, FormatClock(got))
+		}
+	}
+
+	for _, input := range []string{"", "9", "09:0", "25:00", "24:30", "12:60", "ab:cd", "-1:00"} {
+		if _, err := ParseClock(input); !errors.Is(err, ErrInvalidClock) {
+			t.Errorf("ParseClock(%q) error = %v, want ErrInvalidClock", input, err)
+		}
+	}
+}
+
+func TestIntervalsAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	hours := WorkingHours{Start: 9 * time.Hour, End: 17 * time.Hour}
+
+	// Friday 7 March to Tuesday 11 March 2025; DST starts Sunday 9 March
+	from := time.Date(2025, 3, 7, 0, 0, 0, 0, ny)
+	to := time.Date(2025, 3, 12, 0, 0, 0, 0, ny)
+	got := hours.Intervals(ny, from, to, false)
+
+	if len(got) != 3 {
+		t.Fatalf("expected 3 weekday intervals, got %d: %v", len(got), got)
+	}
+	if got[0].Start.UTC().Hour() != 14 {
+		t.Errorf("expected Friday to start at 14:00 UTC (EST), got %v", got[0].Start.UTC())
+	}
+	if got[1].Start.UTC().Hour() != 13 {
+		t.Errorf("expected Monday to start at 13:00 UTC (EDT), got %v", got[1].Start.UTC())
+	}
+	for _, iv := range got {
+		if iv.End.Sub(iv.Start) != 8*time.Hour {
+			t.Errorf("expected 8 hour interval, got %v", iv.End.Sub(iv.Start))
+		}
+	}
+}
+
+func TestIntervalsOvernightAndClipped(t *testing.T) {
+	hours := WorkingHours{Start: 22 * time.Hour, End: 6 * time.Hour}
+	from := time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC) // Monday
+	to := time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	got := hours.Intervals(time.UTC, from, to, true)
+	want := []Interval{
+		{time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC), time.Date(2025, 1, 6, 6, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 1, 6, 22, 0, 0, 0, time.UTC), time.Date(2025, 1, 7, 0, 0, 0, 0, time.UTC)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Intervals() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if hours.Length() != 8*time.Hour {
+		t.Errorf("Length() = %v, want 8h", hours.Length())
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2025, 1, 6, h, 0, 0, 0, time.UTC) }
+
+	a := []Interval{{at(8), at(12)}, {at(13), at(17)}}
+	b := []Interval{{at(10), at(14)}}
+	c := []Interval{{at(0), at(11)}, {at(13), at(23)}}
+
+	got := Intersect(a, b, c)
+	want := []Interval{{at(10), at(11)}, {at(13), at(14)}}
+	if len(got) != len(want) {
+		t.Fatalf("Intersect() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := Intersect(a, []Interval{{at(18), at(20)}}); len(got) != 0 {
+		t.Errorf("expected no overlap, got %v", got)
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2025, 1, 6, h, 0, 0, 0, time.UTC) }
+
+	a := []Interval{{at(8), at(12)}, {at(13), at(17)}, {at(20), at(21)}}
+	b := []Interval{{at(7), at(9)}, {at(10), at(11)}, {at(16), at(22)}}
+
+	got := Subtract(a, b)
+	want := []Interval{{at(9), at(10)}, {at(11), at(12)}, {at(13), at(16)}}
+	if len(got) != len(want) {
+		t.Fatalf("Subtract() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("interval %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := Subtract(a, nil); len(got) != len(a) {
+		t.Errorf("subtracting nothing = %v, want %v", got, a)
+	}
+}
+
+func TestGaps(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2025, 1, 6, h, 0, 0, 0, time.UTC) }
+
+	// Unsorted and overlapping input, partly outside the window
+	busy := []Interval{{at(14), at(15)}, {at(6), at(10)}, {at(9), at(11)}}
+
+	got := Gaps(busy, Interval{at(8), at(18)})
+	want := []Interval{{at(11), at(14)}, {at(15), at(18)}}
+	if len(got) != len(want) {
+		t.Fatalf("Gaps() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("gap %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := Gaps(nil, Interval{at(8), at(18)}); len(got) != 1 {
+		t.Errorf("expected the whole window, got %v", got)
+	}
+}
+
+func TestSlots(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2025, 1, 6, h, m, 0, 0, time.UTC) }
+
+	got := Slots([]Interval{{at(9, 10), at(10, 30)}, {at(14, 0), at(14, 20)}}, 30*time.Minute, 15*time.Minute)
+
+	wantStarts := []time.Time{at(9, 15), at(9, 30), at(9, 45), at(10, 0)}
+	if len(got) != len(wantStarts) {
+		t.Fatalf("Slots() returned %d slots, want %d: %v", len(got), len(wantStarts), got)
+	}
+	for i, start := range wantStarts {
+		if !got[i].Start.Equal(start) || got[i].End.Sub(got[i].Start) != 30*time.Minute {
+			t.Errorf("slot %d = %v, want start %v", i, got[i], start)
+		}
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	tokyo := mustLoad(t, "Asia/Tokyo")
+	date := func(month time.Month, day int) time.Time { return time.Date(2025, month, day, 10, 30, 0, 0, tokyo) }
+
+	jp := BusinessDays{
+		Weekend:  [7]bool{time.Saturday: true, time.Sunday: true},
+		Holidays: map[string]bool{"2025-05-05": true, "2025-05-06": true},
+	}
+
+	// Friday 2 May plus 1 business day skips the weekend and two holidays
+	if got := jp.Add(date(5, 2), 1); !got.Equal(date(5, 7)) {
+		t.Errorf("Add(+1) = %v, want 2025-05-07 10:30", got)
+	}
+	if got := jp.Add(date(5, 7), -1); !got.Equal(date(5, 2)) {
+		t.Errorf("Add(-1) = %v, want 2025-05-02 10:30", got)
+	}
+	// Starting on a weekend counts from the next business day
+	if got := jp.Add(date(5, 3), 2); !got.Equal(date(5, 8)) {
+		t.Errorf("Add(+2) from Saturday = %v, want 2025-05-08", got)
+	}
+	if got := jp.Add(date(5, 3), 0); !got.Equal(date(5, 3)) {
+		t.Errorf("Add(0) = %v, want unchanged", got)
+	}
+
+	for _, n := range []int{-15, -3, -1, 0, 1, 4, 22} {
+		start := date(5, 3)
+		if got := jp.Count(start, jp.Add(start, n)); got != n {
+			t.Errorf("Count(t, Add(t, %d)) = %d", n, got)
+		}
+	}
+
+	// A Friday-Saturday weekend
+	gulf := BusinessDays{Weekend: [7]bool{time.Friday: true, time.Saturday: true}}
+	if got := gulf.Add(date(5, 1), 1); got.Weekday() != time.Sunday {
+		t.Errorf("expected Thursday + 1 business day to be Sunday, got %v", got.Weekday())
+	}
+	if got := gulf.Count(date(5, 1), date(5, 8)); got != 5 {
+		t.Errorf("Count over a week = %d, want 5", got)
+	}
+}
@@ -0,0 +1,110 @@
+package schedule
+
+import (
+	"sort"
+	"time"
+)
+
+// DateLayout is the layout of the dates keying Weekly overrides
+const DateLayout = "2006-01-02"
+
+// statusHorizon is how far ahead Status looks for the next opening or closing
+const statusHorizon = 366 * 24 * time.Hour
+
+// Weekly is a recurring weekly schedule of opening windows.
+// Days is indexed by time.Weekday. Overrides replace the windows of specific
+// local dates, keyed by DateLayout; an empty override means closed all day.
+type Weekly struct {
+	Days      [7][]WorkingHours
+	Overrides map[string][]WorkingHours
+}
+
+// Status describes whether a Weekly schedule is open at an instant.
+// Current is set while open; NextOpen and NextClose are zero when there is no
+// such change within a year.
+type Status struct {
+	Open      bool
+	Current   *Interval
+	NextOpen  time.Time
+	NextClose time.Time
+}
+
+// Hours returns the windows that open on the local date of day
+func (w Weekly) Hours(day time.Time) []WorkingHours {
+	if hours, ok := w.Overrides[day.Format(DateLayout)]; ok {
+		return hours
+	}
+	return w.Days[day.Weekday()]
+}
+
+// Intervals returns the opening periods in loc that overlap [from, to).
+// Windows that touch or overlap, including across midnight, are merged.
+func (w Weekly) Intervals(loc *time.Location, from, to time.Time) []Interval {
+	var out []Interval
+
+	// Start a day early to catch windows that began before from and run past midnight
+	first := from.In(loc).AddDate(0, 0, -1)
+	day := time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, loc)
+	for !day.After(to) {
+		for _, hours := range w.Hours(day) {
+			if iv, ok := clip(hours.On(day), from, to); ok {
+				out = append(out, iv)
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return Merge(out)
+}
+
+// Status reports whether the schedule is open at t in loc, with the period
+// containing t and the next opening and closing times.
+func (w Weekly) Status(loc *time.Location, t time.Time) Status {
+	var status Status
+
+	// Look back a day so an open period usually reports its true start
+	horizon := t.Add(statusHorizon)
+	intervals := w.Intervals(loc, t.Add(-24*time.Hour), horizon)
+	i := sort.Search(len(intervals), func(i int) bool { return intervals[i].End.After(t) })
+	if i == len(intervals) {
+		return status
+	}
+
+	iv := Interval{intervals[i].Start.In(loc), intervals[i].End.In(loc)}
+	if iv.End.Before(horizon) {
+		status.NextClose = iv.End
+	}
+	if iv.Start.After(t) {
+		status.NextOpen = iv.Start
+		return status
+	}
+
+	status.Open = true
+	status.Current = &iv
+	if i+1 < len(intervals) {
+		status.NextOpen = intervals[i+1].Start.In(loc)
+	}
+	return status
+}
+
+// Merge sorts intervals and joins those that overlap or touch
+func Merge(intervals []Interval) []Interval {
+	if len(intervals) == 0 {
+		return intervals
+	}
+
+	sorted := append([]Interval(nil), intervals...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+
+	out := []Interval{sorted[0]}
+	for _, iv := range sorted[1:] {
+		last := &out[len(out)-1]
+		if iv.Start.After(last.End) {
+			out = append(out, iv)
+			continue
+		}
+		last.End = later(last.End, iv.End)
+	}
+
+	return out
+}
@@ -0,0 +1,128 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeeklyStatus(t *testing.T) {
+	paris := mustLoad(t, "Europe/Paris")
+	at := func(day, hour, min int) time.Time { return time.Date(2025, 12, day, hour, min, 0, 0, paris) }
+
+	weekday := []WorkingHours{{9 * time.Hour, 12 * time.Hour}, {14 * time.Hour, 18 * time.Hour}}
+	shop := Weekly{
+		Overrides: map[string][]WorkingHours{
+			"2025-12-24": {{9 * time.Hour, 12 * time.Hour}},
+			"2025-12-25": {},
+		},
+	}
+	for d := time.Monday; d <= time.Friday; d++ {
+		shop.Days[d] = weekday
+	}
+	shop.Days[time.Saturday] = []WorkingHours{{10 * time.Hour, 16 * time.Hour}}
+
+	tests := []struct {
+		name      string
+		at        time.Time
+		open      bool
+		current   *Interval
+		nextOpen  time.Time
+		nextClose time.Time
+	}{
+		{
+			name:      "morning",
+			at:        at(17, 10, 0),
+			open:      true,
+			current:   &Interval{at(17, 9, 0), at(17, 12, 0)},
+			nextOpen:  at(17, 14, 0),
+			nextClose: at(17, 12, 0),
+		},
+		{
+			name:      "lunch",
+			at:        at(17, 12, 30),
+			nextOpen:  at(17, 14, 0),
+			nextClose: at(17, 18, 0),
+		},
+		{
+			name:      "closing instant",
+			at:        at(17, 18, 0),
+			nextOpen:  at(18, 9, 0),
+			nextClose: at(18, 12, 0),
+		},
+		{
+			name:      "Christmas override",
+			at:        at(24, 13, 0),
+			nextOpen:  at(26, 9, 0),
+			nextClose: at(26, 12, 0),
+		},
+		{
+			name:      "Sunday",
+			at:        at(21, 11, 0),
+			nextOpen:  at(22, 9, 0),
+			nextClose: at(22, 12, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shop.Status(paris, tt.at)
+			if got.Open != tt.open {
+				t.Errorf("Open = %v, want %v", got.Open, tt.open)
+			}
+			if (got.Current == nil) != (tt.current == nil) ||
+				got.Current != nil && (!got.Current.Start.Equal(tt.current.Start) || !got.Current.End.Equal(tt.current.End)) {
+				t.Errorf("Current = %v, want %v", got.Current, tt.current)
+			}
+			if !got.NextOpen.Equal(tt.nextOpen) || !got.NextClose.Equal(tt.nextClose) {
+				t.Errorf("next open/close = %v / %v, want %v / %v", got.NextOpen, got.NextClose, tt.nextOpen, tt.nextClose)
+			}
+		})
+	}
+}
+
+func TestWeeklyStatusOvernight(t *testing.T) {
+	paris := mustLoad(t, "Europe/Paris")
+	var bar Weekly
+	bar.Days[time.Friday] = []WorkingHours{{20 * time.Hour, 2 * time.Hour}}
+
+	got := bar.Status(paris, time.Date(2025, 12, 20, 1, 0, 0, 0, paris))
+	if !got.Open || !got.Current.Start.Equal(time.Date(2025, 12, 19, 20, 0, 0, 0, paris)) {
+		t.Errorf("expected the Friday session to be open, got %+v", got)
+	}
+	if want := time.Date(2025, 12, 26, 20, 0, 0, 0, paris); !got.NextOpen.Equal(want) {
+		t.Errorf("NextOpen = %v, want %v", got.NextOpen, want)
+	}
+}
+
+func TestWeeklyStatusAlwaysOpen(t *testing.T) {
+	utc := time.UTC
+	var always Weekly
+	for d := range always.Days {
+		always.Days[d] = []WorkingHours{{0, 24 * time.Hour}}
+	}
+
+	got := always.Status(utc, time.Date(2025, 6, 1, 12, 0, 0, 0, utc))
+	if !got.Open || !got.NextClose.IsZero() || !got.NextOpen.IsZero() {
+		t.Errorf("expected open with no transitions, got %+v", got)
+	}
+
+	got = Weekly{}.Status(utc, time.Date(2025, 6, 1, 12, 0, 0, 0, utc))
+	if got.Open || !got.NextOpen.IsZero() || !got.NextClose.IsZero() {
+		t.Errorf("expected an empty schedule to stay closed, got %+v", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	at := func(hour int) time.Time { return time.Date(2025, 1, 1, hour, 0, 0, 0, time.UTC) }
+
+	got := Merge([]Interval{{at(14), at(16)}, {at(9), at(12)}, {at(11), at(13)}, {at(16), at(18)}})
+	want := []Interval{{at(9), at(13)}, {at(14), at(18)}}
+	if len(got) != len(want) {
+		t.Fatalf("Merge = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) {
+			t.Errorf("Merge[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Package solar computes sunrise, sunset, solar noon and twilight times
+// offline from the NOAA solar position equations. Times are accurate to
+// about a minute at latitudes within ±72° and degrade slowly beyond that.
+package solar
+
+import (
+	"math"
+	"time"
+)
+
+// Altitudes of the centre of the sun, in degrees, that define each event.
+// Sunrise allows for refraction and the radius of the solar disc.
+const (
+	SunriseAltitude      = -0.833
+	CivilAltitude        = -6.0
+	NauticalAltitude     = -12.0
+	AstronomicalAltitude = -18.0
+)
+
+// Crossing is when the sun rises above and sets below an altitude on a day.
+// Rise and Set are zero when the sun stays on one side of the altitude all
+// day, which AlwaysAbove and AlwaysBelow report.
+type Crossing struct {
+	Rise        time.Time
+	Set         time.Time
+	AlwaysAbove bool
+	AlwaysBelow bool
+}
+
+// Day holds the solar events of one local calendar day at a position
+type Day struct {
+	Noon time.Time
+	// NoonAltitude is the altitude of the sun at solar noon, in degrees
+	NoonAltitude float64
+	Sun          Crossing
+	Civil        Crossing
+	Nautical     Crossing
+	Astronomical Crossing
+}
+
+// DayLength returns how long the sun is above the horizon: 24 hours during
+// polar day and zero during polar night
+func (d Day) DayLength() time.Duration {
+	switch {
+	case d.Sun.AlwaysAbove:
+		return 24 * time.Hour
+	case d.Sun.AlwaysBelow:
+		return 0
+	}
+	return d.Sun.Set.Sub(d.Sun.Rise)
+}
+
+// PolarDay reports whether the sun stays above the horizon all day
+func (d Day) PolarDay() bool {
+	return d.Sun.AlwaysAbove
+}
+
+// PolarNight reports whether the sun stays below the horizon all day
+func (d Day) PolarNight() bool {
+	return d.Sun.AlwaysBelow
+}
+
+// ForDate returns the solar events of the given calendar day in loc at a
+// latitude and longitude in decimal degrees, north and east positive. The
+// events are those around the solar noon nearest local midday, so a rise or
+// set near midnight may fall on the neighbouring calendar day. All times are
+// in loc.
+func ForDate(year int, month time.Month, day int, loc *time.Location, latitude, longitude float64) Day {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	noon := time.Date(year, month, day, 12, 0, 0, 0, loc)
+	for i := 0; i < 2; i++ {
+		noon = transit(noon, longitude)
+	}
+	declination, _ := position(noon)
+
+	return Day{
+		Noon:         noon.In(loc),
+		NoonAltitude: 90 - math.Abs(latitude-declination),
+		Sun:          crossing(noon, loc, latitude, SunriseAltitude),
+		Civil:        crossing(noon, loc, latitude, CivilAltitude),
+		Nautical:     crossing(noon, loc, latitude, NauticalAltitude),
+		Astronomical: crossing(noon, loc, latitude, AstronomicalAltitude),
+	}
+}
+
+// crossing finds when the sun passes altitude on either side of noon
+func crossing(noon time.Time, loc *time.Location, latitude, altitude float64) Crossing {
+	declination, _ := position(noon)
+	switch cos := hourAngleCos(latitude, declination, altitude); {
+	case cos > 1:
+		return Crossing{AlwaysBelow: true}
+	case cos < -1:
+		return Crossing{AlwaysAbove: true}
+	}
+	return Crossing{
+		Rise: refine(noon, latitude, altitude, -1).In(loc),
+		Set:  refine(noon, latitude, altitude, 1).In(loc),
+	}
+}
+
+// refine iterates towards the instant before (sign -1) or after (sign 1)
+// noon at which the sun is at altitude, recomputing the sun's position at
+// each estimate
+func refine(noon time.Time, latitude, altitude float64, sign float64) time.Time {
+	_, noonEquation := position(noon)
+	t := noon
+	for i := 0; i < 3; i++ {
+		declination, equation := position(t)
+		cos := math.Max(-1, math.Min(1, hourAngleCos(latitude, declination, altitude)))
+		hourAngle := degrees(math.Acos(cos))
+		// The equation of time shifts the transit as the estimate moves away from noon
+		t = noon.Add(minutes(noonEquation - equation + sign*4*hourAngle))
+	}
+	return t
+}
+
+// transit returns the solar noon nearest t at longitude
+func transit(t time.Time, longitude float64) time.Time {
+	_, equation := position(t)
+	utc := t.UTC()
+	midnight := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC)
+	noon := midnight.Add(minutes(720 - 4*longitude - equation))
+	switch {
+	case noon.Sub(t) > 12*time.Hour:
+		noon = noon.AddDate(0, 0, -1)
+	case t.Sub(noon) > 12*time.Hour:
+		noon = noon.AddDate(0, 0, 1)
+	}
+	return noon
+}
+
+// hourAngleCos returns the cosine of the hour angle at which the sun is at
+// altitude. It is above 1 when the sun never reaches the altitude and below
+// -1 when it never drops to it.
+func hourAngleCos(latitude, declination, altitude float64) float64 {
+	lat, dec := radians(latitude), radians(declination)
+	return (math.Sin(radians(altitude)) - math.Sin(lat)*math.Sin(dec)) / (math.Cos(lat) * math.Cos(dec))
+}
+
+// Longitude returns the apparent ecliptic longitude of the sun at t in
+// degrees, from 0 at the March equinox to 360
+func Longitude(t time.Time) float64 {
+	_, _, longitude := ephemeris(t)
+	return longitude
+}
+
+// position returns the sun's declination in degrees and the equation of time
+// in minutes at t
+func position(t time.Time) (declination, equation float64) {
+	declination, equation, _ = ephemeris(t)
+	return declination, equation
+}
+
+// ephemeris returns the sun's declination and apparent longitude in degrees
+// and the equation of time in minutes at t
+func ephemeris(t time.Time) (declination, equation, longitude float64) {
+	julianDay := float64(t.Unix())/86400 + 2440587.5
+	c := (julianDay - 2451545) / 36525
+
+	meanLongitude := math.Mod(280.46646+c*(36000.76983+c*0.0003032), 360)
+	meanAnomaly := 357.52911 + c*(35999.05029-0.0001537*c)
+	eccentricity := 0.016708634 - c*(0.000042037+0.0000001267*c)
+
+	m := radians(meanAnomaly)
+	center := math.Sin(m)*(1.914602-c*(0.004817+0.000014*c)) +
+		math.Sin(2*m)*(0.019993-0.000101*c) +
+		math.Sin(3*m)*0.000289
+	omega := radians(125.04 - 1934.136*c)
+	apparentLongitude := meanLongitude + center - 0.00569 - 0.00478*math.Sin(omega)
+
+	meanObliquity := 23 + (26+(21.448-c*(46.815+c*(0.00059-c*0.001813)))/60)/60
+	obliquity := radians(meanObliquity + 0.00256*math.Cos(omega))
+
+	declination = degrees(math.Asin(math.Sin(obliquity) * math.Sin(radians(apparentLongitude))))
+
+	y := math.Pow(math.Tan(obliquity/2), 2)
+	l := radians(meanLongitude)
+	equation = 4 * degrees(y*math.Sin(2*l)-
+		2*eccentricity*math.Sin(m)+
+		4*eccentricity*y*math.Sin(m)*math.Cos(2*l)-
+		0.5*y*y*math.Sin(4*l)-
+		1.25*eccentricity*eccentricity*math.Sin(2*m))
+	longitude = math.Mod(apparentLongitude, 360)
+	if longitude < 0 {
+		longitude += 360
+	}
+	return declination, equation, longitude
+}
+
+// minutes converts fractional minutes to a duration rounded to the second
+func minutes(m float64) time.Duration {
+	return time.Duration(m * float64(time.Minute)).Round(time.Second)
+}
+
+func radians(d float64) float64 { return d * math.Pi / 180 }
+
+func degrees(r float64) float64 { return r * 180 / math.Pi }
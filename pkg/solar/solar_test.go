@@ -0,0 +1,102 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+// within reports whether got is within two minutes of hh:mm on its own day
+func within(got time.Time, hour, minute int) bool {
+	want := time.Date(got.Year(), got.Month(), got.Day(), hour, minute, 0, 0, got.Location())
+	diff := got.Sub(want)
+	return diff > -2*time.Minute && diff < 2*time.Minute
+}
+
+func TestForDate(t *testing.T) {
+	tests := []struct {
+		name      string
+		zone      string
+		lat, lon  float64
+		date      time.Time
+		rise, set [2]int
+		noon      [2]int
+	}{
+		{"london midsummer", "Europe/London", 51.5074, -0.1278, time.Date(2025, 6, 21, 0, 0, 0, 0, time.UTC), [2]int{4, 43}, [2]int{21, 21}, [2]int{13, 2}},
+		{"sydney midsummer", "Australia/Sydney", -33.8688, 151.2093, time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC), [2]int{5, 41}, [2]int{20, 5}, [2]int{12, 53}},
+		{"new york equinox", "America/New_York", 40.7128, -74.006, time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC), [2]int{6, 59}, [2]int{19, 8}, [2]int{13, 3}},
+		// UTC+14 on a longitude west of Greenwich
+		{"kiritimati", "Pacific/Kiritimati", 1.87, -157.4, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), [2]int{6, 32}, [2]int{18, 33}, [2]int{12, 33}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := mustLoad(t, tt.zone)
+			day := ForDate(tt.date.Year(), tt.date.Month(), tt.date.Day(), loc, tt.lat, tt.lon)
+
+			if !within(day.Sun.Rise, tt.rise[0], tt.rise[1]) || !within(day.Sun.Set, tt.set[0], tt.set[1]) {
+				t.Errorf("sunrise %v, sunset %v, want about %02d:%02d and %02d:%02d",
+					day.Sun.Rise, day.Sun.Set, tt.rise[0], tt.rise[1], tt.set[0], tt.set[1])
+			}
+			if !within(day.Noon, tt.noon[0], tt.noon[1]) {
+				t.Errorf("solar noon %v, want about %02d:%02d", day.Noon, tt.noon[0], tt.noon[1])
+			}
+			if day.Sun.Rise.Day() != tt.date.Day() || day.Noon.Location() != loc {
+				t.Errorf("expected events on %s in %s, got %v", tt.date.Format("2006-01-02"), loc, day.Sun.Rise)
+			}
+			if day.DayLength() != day.Sun.Set.Sub(day.Sun.Rise) {
+				t.Errorf("DayLength() = %v", day.DayLength())
+			}
+
+			// Each twilight starts before the next brighter one
+			events := []Crossing{day.Astronomical, day.Nautical, day.Civil, day.Sun}
+			for i := 1; i < len(events); i++ {
+				if events[i-1].AlwaysAbove {
+					continue
+				}
+				if !events[i-1].Rise.Before(events[i].Rise) || !events[i-1].Set.After(events[i].Set) {
+					t.Errorf("twilight %d does not enclose %d: %+v %+v", i-1, i, events[i-1], events[i])
+				}
+			}
+		})
+	}
+}
+
+func TestForDatePolar(t *testing.T) {
+	oslo := mustLoad(t, "Europe/Oslo")
+	const lat, lon = 69.6492, 18.9553 // Tromsø
+
+	summer := ForDate(2025, 6, 21, oslo, lat, lon)
+	if !summer.PolarDay() || summer.PolarNight() || summer.DayLength() != 24*time.Hour {
+		t.Errorf("expected polar day, got %+v", summer)
+	}
+	if !summer.Sun.Rise.IsZero() || !summer.Astronomical.AlwaysAbove {
+		t.Errorf("expected no sunrise and no darkness, got %+v", summer)
+	}
+
+	winter := ForDate(2025, 12, 21, oslo, lat, lon)
+	if !winter.PolarNight() || winter.DayLength() != 0 {
+		t.Errorf("expected polar night, got %+v", winter)
+	}
+	if winter.NoonAltitude > -2 || winter.NoonAltitude < -4 {
+		t.Errorf("NoonAltitude = %.1f, want about -3", winter.NoonAltitude)
+	}
+	// The sun still rises high enough for civil twilight around noon
+	if winter.Civil.AlwaysBelow || !within(winter.Civil.Rise, 9, 31) || !within(winter.Civil.Set, 13, 53) {
+		t.Errorf("unexpected civil twilight: %+v", winter.Civil)
+	}
+
+	// London midsummer nights never get astronomically dark
+	london := ForDate(2025, 6, 21, mustLoad(t, "Europe/London"), 51.5074, -0.1278)
+	if !london.Astronomical.AlwaysAbove || london.Nautical.AlwaysAbove {
+		t.Errorf("unexpected London twilight: %+v", london)
+	}
+}
@@ -0,0 +1,94 @@
+package timecalc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Overflow controls what happens when adding months or years lands on a day
+// that does not exist in the target month (e.g. January 31 + 1 month)
+type Overflow string
+
+const (
+	// OverflowClamp moves the result back to the last day of the target month
+	OverflowClamp Overflow = "clamp"
+	// OverflowRollover carries the extra days into the following month
+	OverflowRollover Overflow = "rollover"
+)
+
+// ErrInvalidOverflow is returned for an unknown overflow policy
+var ErrInvalidOverflow = errors.New("overflow must be 'clamp' or 'rollover'")
+
+// ParseOverflow parses an overflow policy name, defaulting to clamp when empty
+func ParseOverflow(s string) (Overflow, error) {
+	switch Overflow(strings.ToLower(strings.TrimSpace(s))) {
+	case "", OverflowClamp:
+		return OverflowClamp, nil
+	case OverflowRollover:
+		return OverflowRollover, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidOverflow, s)
+	}
+}
+
+// Add adds p to t on the wall clock of loc.
+//
+// Years and months are applied first using the overflow policy, then weeks
+// and days, all as calendar units that keep the local time of day. Hours,
+// minutes and seconds are then added as elapsed time. Components may be
+// negative; a Negative period subtracts every component.
+func Add(t time.Time, p Period, loc *time.Location, overflow Overflow) time.Time {
+	if loc == nil {
+		loc = t.Location()
+	}
+	t = t.In(loc)
+
+	sign := 1
+	if p.Negative {
+		sign = -1
+	}
+
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	months := sign * (p.Years*12 + p.Months)
+	if months != 0 {
+		total := int(month) - 1 + months
+		year += floorDiv(total, 12)
+		month = time.Month(total-floorDiv(total, 12)*12) + 1
+		if overflow != OverflowRollover {
+			if last := DaysInMonth(year, month); day > last {
+				day = last
+			}
+		}
+	}
+
+	day += sign * (p.Weeks*7 + p.Days)
+	result := time.Date(year, month, day, hour, minute, second, t.Nanosecond(), loc)
+
+	return result.Add(time.Duration(sign) * p.clockDuration())
+}
+
+// DaysInMonth returns the number of days in the given month
+func DaysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// clockDuration returns the elapsed-time components of the period
+func (p Period) clockDuration() time.Duration {
+	return time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second +
+		time.Duration(p.Nanoseconds)
+}
+
+// floorDiv divides rounding toward negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
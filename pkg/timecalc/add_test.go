@@ -0,0 +1,145 @@
+package timecalc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAdd(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		period   Period
+		loc      *time.Location
+		overflow Overflow
+		want     time.Time
+	}{
+		{
+			name:   "one day across spring forward keeps wall clock",
+			start:  time.Date(2025, 3, 8, 12, 0, 0, 0, ny),
+			period: Period{Days: 1},
+			loc:    ny,
+			want:   time.Date(2025, 3, 9, 12, 0, 0, 0, ny),
+		},
+		{
+			name:   "24 hours across spring forward is elapsed time",
+			start:  time.Date(2025, 3, 8, 12, 0, 0, 0, ny),
+			period: Period{Hours: 24},
+			loc:    ny,
+			want:   time.Date(2025, 3, 9, 13, 0, 0, 0, ny),
+		},
+		{
+			name:     "month end clamps",
+			start:    time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			period:   Period{Months: 1},
+			overflow: OverflowClamp,
+			want:     time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "month end rolls over",
+			start:    time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC),
+			period:   Period{Months: 1},
+			overflow: OverflowRollover,
+			want:     time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "leap day plus one year clamps",
+			start:    time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			period:   Period{Years: 1},
+			overflow: OverflowClamp,
+			want:     time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "months across year boundary",
+			start:  time.Date(2025, 11, 15, 0, 0, 0, 0, time.UTC),
+			period: Period{Months: 3},
+			want:   time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "negative period",
+			start:  time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+			period: Period{Months: 1, Days: 1, Negative: true},
+			want:   time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "negative month component",
+			start:  time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC),
+			period: Period{Months: -2},
+			want:   time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "weeks and clock units",
+			start:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			period: Period{Weeks: 2, Hours: 1, Minutes: 30, Seconds: 15, Nanoseconds: 5},
+			want:   time.Date(2025, 1, 15, 1, 30, 15, 5, time.UTC),
+		},
+		{
+			name:   "calendar units apply in target zone",
+			start:  time.Date(2025, 3, 8, 17, 0, 0, 0, time.UTC),
+			period: Period{Days: 1},
+			loc:    ny,
+			want:   time.Date(2025, 3, 9, 16, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Add(tt.start, tt.period, tt.loc, tt.overflow)
+			if !got.Equal(tt.want) {
+				t.Errorf("Add() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOverflow(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Overflow
+		wantErr bool
+	}{
+		{"", OverflowClamp, false},
+		{"clamp", OverflowClamp, false},
+		{" Rollover ", OverflowRollover, false},
+		{"wrap", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseOverflow(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidOverflow) {
+					t.Errorf("ParseOverflow(%q) error = %v, want ErrInvalidOverflow", tt.input, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseOverflow(%q) = %v, %v, want %v", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDaysInMonth(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2025, time.January, 31},
+		{2025, time.February, 28},
+		{2024, time.February, 29},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{2025, time.April, 30},
+	}
+
+	for _, tt := range tests {
+		if got := DaysInMonth(tt.year, tt.month); got != tt.want {
+			t.Errorf("DaysInMonth(%d, %s) = %d, want %d", tt.year, tt.month, got, tt.want)
+		}
+	}
+}
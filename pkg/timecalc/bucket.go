@@ -0,0 +1,236 @@
+package timecalc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// BucketUnit is the unit of a time bucket
+type BucketUnit string
+
+// Bucket units
+const (
+	BucketMinute  BucketUnit = "minute"
+	BucketHour    BucketUnit = "hour"
+	BucketDay     BucketUnit = "day"
+	BucketWeek    BucketUnit = "week"
+	BucketMonth   BucketUnit = "month"
+	BucketQuarter BucketUnit = "quarter"
+	BucketYear    BucketUnit = "year"
+)
+
+// Bucket errors
+var (
+	ErrInvalidBucketUnit = errors.New("unit must be one of minute, hour, day, week, month, quarter or year")
+	ErrInvalidBucketSize = errors.New("invalid bucket size")
+)
+
+// bucketSizes lists the sizes allowed for each unit. Sizes divide the next
+// larger unit so buckets line up with it, e.g. 15 minutes within an hour.
+var bucketSizes = map[BucketUnit][]int{
+	BucketMinute:  {1, 2, 3, 4, 5, 6, 10, 12, 15, 20, 30},
+	BucketHour:    {1, 2, 3, 4, 6, 8, 12},
+	BucketDay:     {1},
+	BucketWeek:    {1},
+	BucketMonth:   {1, 2, 3, 4, 6},
+	BucketQuarter: {1},
+	BucketYear:    {1},
+}
+
+// Bucket divides the local timeline of a zone into consecutive buckets of
+// Size units, counted from the start of the enclosing hour, day or year.
+// Weeks are ISO weeks starting on Monday.
+type Bucket struct {
+	Unit BucketUnit
+	Size int
+}
+
+// BucketUnits returns the names of the bucket units, finest first
+func BucketUnits() []string {
+	return []string{
+		string(BucketMinute), string(BucketHour), string(BucketDay), string(BucketWeek),
+		string(BucketMonth), string(BucketQuarter), string(BucketYear),
+	}
+}
+
+// ParseBucket parses a unit name, singular or plural, and checks the size is
+// allowed for it. A size of 0 means 1.
+func ParseBucket(unit string, size int) (Bucket, error) {
+	u := BucketUnit(strings.TrimSuffix(strings.ToLower(strings.TrimSpace(unit)), "s"))
+	sizes, ok := bucketSizes[u]
+	if !ok {
+		return Bucket{}, fmt.Errorf("%w: %q", ErrInvalidBucketUnit, unit)
+	}
+	if size == 0 {
+		size = 1
+	}
+	for _, s := range sizes {
+		if s == size {
+			return Bucket{Unit: u, Size: size}, nil
+		}
+	}
+	return Bucket{}, fmt.Errorf("%w: %s buckets must be %s", ErrInvalidBucketSize, u, joinSizes(sizes))
+}
+
+// String returns the bucket as e.g. "15 minute" or "day"
+func (b Bucket) String() string {
+	if b.Size == 1 {
+		return string(b.Unit)
+	}
+	return fmt.Sprintf("%d %s", b.Size, b.Unit)
+}
+
+// Floor returns the start of the bucket containing t, on the wall clock of loc.
+//
+// A bucket is a run of instants whose local times fall in the same slot, so
+// buckets follow the wall clock across DST transitions: a day bucket lasts
+// 23 or 25 hours, a bucket whose local start is skipped by a transition
+// starts when the clocks jump, and an hour repeated when the clocks go back
+// gets its own hourly bucket.
+func (b Bucket) Floor(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	start := b.start(wall(t, loc))
+	next := b.next(start)
+
+	// Walk back towards the slot start, stopping at any transition that
+	// leaves the slot or jumps back to its start
+	s, sWall := t, wall(t, loc)
+	limit := t.Add(time.Nanosecond)
+	for {
+		c := s.Add(-sWall.Sub(start))
+		transitions := Transitions(loc, c, limit)
+		if len(transitions) == 0 {
+			return c
+		}
+		tr := transitions[len(transitions)-1]
+		after := wall(tr.At, loc)
+		before := after.Add(-time.Duration(tr.NewOffset-tr.OldOffset) * time.Second)
+		if after.Equal(start) || !before.After(start) || before.After(next) {
+			return tr.At
+		}
+		s, sWall, limit = tr.At, before, tr.At
+	}
+}
+
+// Next returns the end of the bucket containing t, which is the start of the
+// following bucket
+func (b Bucket) Next(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	sWall := wall(t, loc)
+	start := b.start(sWall)
+	next := b.next(start)
+
+	// Walk forward towards the next slot, stopping at any transition that
+	// leaves the slot or jumps back to its start
+	s := t
+	for {
+		c := s.Add(next.Sub(sWall))
+		transitions := Transitions(loc, s.Add(time.Nanosecond), c.Add(time.Nanosecond))
+		if len(transitions) == 0 {
+			return c
+		}
+		tr := transitions[0]
+		after := wall(tr.At, loc)
+		if !after.After(start) || !after.Before(next) {
+			return tr.At
+		}
+		s, sWall = tr.At, after
+	}
+}
+
+// Ceil returns t if it starts a bucket, or the start of the following bucket
+func (b Bucket) Ceil(t time.Time, loc *time.Location) time.Time {
+	if floor := b.Floor(t, loc); floor.Equal(t) {
+		return floor
+	}
+	return b.Next(t, loc)
+}
+
+// Round returns the nearer of Floor and Next in elapsed time, rounding
+// halfway cases up
+func (b Bucket) Round(t time.Time, loc *time.Location) time.Time {
+	floor := b.Floor(t, loc)
+	next := b.Next(t, loc)
+	if t.Sub(floor) < next.Sub(t) {
+		return floor
+	}
+	return next
+}
+
+// Boundaries returns the starts of the buckets in [start, end), in
+// chronological order, stopping after limit boundaries. It reports whether
+// boundaries were left out because of the limit.
+func (b Bucket) Boundaries(loc *time.Location, start, end time.Time, limit int) ([]time.Time, bool) {
+	var out []time.Time
+	for t := b.Ceil(start, loc); t.Before(end); t = b.Next(t, loc) {
+		if len(out) == limit {
+			return out, true
+		}
+		out = append(out, t)
+	}
+	return out, false
+}
+
+// start returns the local start of the slot containing the wall-clock time w
+func (b Bucket) start(w time.Time) time.Time {
+	y, m, d := w.Date()
+	switch b.Unit {
+	case BucketMinute:
+		return time.Date(y, m, d, w.Hour(), w.Minute()/b.Size*b.Size, 0, 0, time.UTC)
+	case BucketHour:
+		return time.Date(y, m, d, w.Hour()/b.Size*b.Size, 0, 0, 0, time.UTC)
+	case BucketDay:
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	case BucketWeek:
+		return time.Date(y, m, d-(int(w.Weekday())+6)%7, 0, 0, 0, 0, time.UTC)
+	case BucketMonth:
+		return time.Date(y, time.Month((int(m)-1)/b.Size*b.Size+1), 1, 0, 0, 0, 0, time.UTC)
+	case BucketQuarter:
+		return time.Date(y, time.Month((int(m)-1)/3*3+1), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(y, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// next returns the local start of the slot after the one starting at w
+func (b Bucket) next(w time.Time) time.Time {
+	switch b.Unit {
+	case BucketMinute:
+		return w.Add(time.Duration(b.Size) * time.Minute)
+	case BucketHour:
+		return w.Add(time.Duration(b.Size) * time.Hour)
+	case BucketDay:
+		return w.AddDate(0, 0, 1)
+	case BucketWeek:
+		return w.AddDate(0, 0, 7)
+	case BucketMonth:
+		return w.AddDate(0, b.Size, 0)
+	case BucketQuarter:
+		return w.AddDate(0, 3, 0)
+	}
+	return w.AddDate(1, 0, 0)
+}
+
+// wall returns the wall-clock time of t in loc, as the same fields in UTC
+func wall(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	y, m, d := t.Date()
+	return time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// joinSizes lists sizes for an error message, e.g. "1, 2 or 3"
+func joinSizes(sizes []int) string {
+	parts := make([]string, len(sizes))
+	for i, s := range sizes {
+		parts[i] = fmt.Sprint(s)
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " or " + parts[len(parts)-1]
+}
@@ -0,0 +1,140 @@
+package timecalc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseBucket(t *testing.T) {
+	b, err := ParseBucket(" Minutes ", 15)
+	if err != nil || b != (Bucket{BucketMinute, 15}) || b.String() != "15 minute" {
+		t.Errorf("ParseBucket() = %v, %v", b, err)
+	}
+	if b, err := ParseBucket("day", 0); err != nil || b.Size != 1 || b.String() != "day" {
+		t.Errorf("ParseBucket() = %v, %v", b, err)
+	}
+	if _, err := ParseBucket("fortnight", 1); !errors.Is(err, ErrInvalidBucketUnit) {
+		t.Errorf("expected invalid unit, got %v", err)
+	}
+	if _, err := ParseBucket("minute", 7); !errors.Is(err, ErrInvalidBucketSize) || err.Error() != "invalid bucket size: minute buckets must be 1, 2, 3, 4, 5, 6, 10, 12, 15, 20 or 30" {
+		t.Errorf("expected invalid size, got %v", err)
+	}
+}
+
+func TestBucketDaysAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	day := Bucket{BucketDay, 1}
+
+	tests := []struct {
+		name   string
+		at     time.Time
+		start  time.Time
+		length time.Duration
+	}{
+		{"spring forward", time.Date(2025, 3, 9, 12, 0, 0, 0, ny), time.Date(2025, 3, 9, 5, 0, 0, 0, time.UTC), 23 * time.Hour},
+		{"fall back", time.Date(2025, 11, 2, 1, 30, 0, 0, ny).Add(time.Hour), time.Date(2025, 11, 2, 4, 0, 0, 0, time.UTC), 25 * time.Hour},
+		{"ordinary day", time.Date(2025, 6, 1, 23, 59, 0, 0, ny), time.Date(2025, 6, 1, 4, 0, 0, 0, time.UTC), 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			floor := day.Floor(tt.at, ny)
+			if !floor.Equal(tt.start) {
+				t.Errorf("Floor() = %v, want %v", floor.UTC(), tt.start)
+			}
+			if got := day.Next(tt.at, ny).Sub(floor); got != tt.length {
+				t.Errorf("day length = %v, want %v", got, tt.length)
+			}
+		})
+	}
+
+	// Sao Paulo's clocks jumped from 00:00 to 01:00, so the day started at 01:00
+	saoPaulo := mustLoad(t, "America/Sao_Paulo")
+	floor := day.Floor(time.Date(2018, 11, 4, 12, 0, 0, 0, saoPaulo), saoPaulo)
+	if want := time.Date(2018, 11, 4, 3, 0, 0, 0, time.UTC); !floor.Equal(want) {
+		t.Errorf("Floor() = %v, want %v", floor.UTC(), want)
+	}
+}
+
+func TestBucketHoursAcrossDST(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	hour := Bucket{BucketHour, 1}
+
+	spring := time.Date(2025, 3, 9, 0, 0, 0, 0, ny)
+	got, truncated := hour.Boundaries(ny, spring, spring.AddDate(0, 0, 1), 100)
+	if len(got) != 23 || truncated {
+		t.Errorf("expected 23 hourly buckets on the spring forward day, got %d", len(got))
+	}
+
+	fall := time.Date(2025, 11, 2, 0, 0, 0, 0, ny)
+	got, _ = hour.Boundaries(ny, fall, fall.AddDate(0, 0, 1), 100)
+	if len(got) != 25 {
+		t.Fatalf("expected 25 hourly buckets on the fall back day, got %d", len(got))
+	}
+	// Both 01:00s start a bucket
+	if !got[1].Equal(time.Date(2025, 11, 2, 5, 0, 0, 0, time.UTC)) || !got[2].Equal(time.Date(2025, 11, 2, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected repeated hour: %v, %v", got[1].UTC(), got[2].UTC())
+	}
+
+	// Two-hour buckets keep the repeated hour in the 00:00 bucket
+	twoHours := Bucket{BucketHour, 2}
+	at := time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC)
+	if floor := twoHours.Floor(at, ny); !floor.Equal(time.Date(2025, 11, 2, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("Floor() = %v, want 00:00 EDT", floor.In(ny))
+	}
+	if next := twoHours.Next(at, ny); !next.Equal(time.Date(2025, 11, 2, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("Next() = %v, want 02:00 EST", next.In(ny))
+	}
+
+	// The 02:00 bucket on the spring forward day starts when the clocks jump
+	at = time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC)
+	if floor := twoHours.Floor(at, ny); !floor.Equal(time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("Floor() = %v, want 03:00 EDT", floor.In(ny))
+	}
+}
+
+func TestBucketRoundingAndUnits(t *testing.T) {
+	kolkata := mustLoad(t, "Asia/Kolkata")
+	quarterHour := Bucket{BucketMinute, 15}
+
+	at := time.Date(2025, 1, 6, 10, 7, 30, 0, kolkata)
+	if got := quarterHour.Floor(at, kolkata); !got.Equal(time.Date(2025, 1, 6, 10, 0, 0, 0, kolkata)) {
+		t.Errorf("Floor() = %v", got)
+	}
+	if got := quarterHour.Ceil(at, kolkata); !got.Equal(time.Date(2025, 1, 6, 10, 15, 0, 0, kolkata)) {
+		t.Errorf("Ceil() = %v", got)
+	}
+	if got := quarterHour.Round(at, kolkata); !got.Equal(time.Date(2025, 1, 6, 10, 15, 0, 0, kolkata)) {
+		t.Errorf("Round() = %v, want halfway rounded up", got)
+	}
+	exact := time.Date(2025, 1, 6, 10, 30, 0, 0, kolkata)
+	if got := quarterHour.Ceil(exact, kolkata); !got.Equal(exact) {
+		t.Errorf("Ceil() of a boundary = %v, want %v", got, exact)
+	}
+
+	// Wednesday 1 January 2025
+	wednesday := time.Date(2025, 1, 1, 15, 0, 0, 0, kolkata)
+	tests := []struct {
+		bucket Bucket
+		want   time.Time
+	}{
+		{Bucket{BucketWeek, 1}, time.Date(2024, 12, 30, 0, 0, 0, 0, kolkata)},
+		{Bucket{BucketMonth, 1}, time.Date(2025, 1, 1, 0, 0, 0, 0, kolkata)},
+		{Bucket{BucketQuarter, 1}, time.Date(2025, 1, 1, 0, 0, 0, 0, kolkata)},
+		{Bucket{BucketYear, 1}, time.Date(2025, 1, 1, 0, 0, 0, 0, kolkata)},
+	}
+	for _, tt := range tests {
+		if got := tt.bucket.Floor(wednesday, kolkata); !got.Equal(tt.want) {
+			t.Errorf("%s Floor() = %v, want %v", tt.bucket, got, tt.want)
+		}
+	}
+	if got := (Bucket{BucketMonth, 6}).Next(time.Date(2025, 8, 1, 0, 0, 0, 0, kolkata), kolkata); !got.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, kolkata)) {
+		t.Errorf("half-year Next() = %v", got)
+	}
+
+	got, truncated := quarterHour.Boundaries(kolkata, at, at.Add(time.Hour), 2)
+	if len(got) != 2 || !truncated {
+		t.Errorf("expected 2 boundaries and truncation, got %v %v", got, truncated)
+	}
+}
@@ -0,0 +1,147 @@
+package timecalc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidISO8601 is returned when a string is not a valid ISO 8601 duration
+var ErrInvalidISO8601 = errors.New("invalid ISO 8601 duration")
+
+// ParseISO8601 parses an ISO 8601 duration such as P1Y2M3DT4H5M6S, P2W or -PT90M.
+//
+// Date components must be whole numbers. The hour, minute and second
+// components may carry a decimal fraction (e.g. PT1.5H), which is carried
+// into the smaller units.
+func ParseISO8601(s string) (Period, error) {
+	input := s
+	s = strings.ToUpper(strings.TrimSpace(s))
+
+	var p Period
+	switch {
+	case strings.HasPrefix(s, "-"):
+		p.Negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	if !strings.HasPrefix(s, "P") {
+		return Period{}, fmt.Errorf("%w: %q", ErrInvalidISO8601, input)
+	}
+	s = s[1:]
+
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+	if (datePart == "" && !hasTime) || (hasTime && timePart == "") {
+		return Period{}, fmt.Errorf("%w: %q", ErrInvalidISO8601, input)
+	}
+
+	// Date components, in order
+	if err := parseComponents(datePart, "YMWD", func(designator byte, whole int64, frac string) error {
+		if frac != "" {
+			return fmt.Errorf("%w: %q: fractional %c not supported", ErrInvalidISO8601, input, designator)
+		}
+		switch designator {
+		case 'Y':
+			p.Years = int(whole)
+		case 'M':
+			p.Months = int(whole)
+		case 'W':
+			p.Weeks = int(whole)
+		case 'D':
+			p.Days = int(whole)
+		}
+		return nil
+	}); err != nil {
+		return Period{}, wrapISO(err, input)
+	}
+
+	// Time components, in order; fractions are carried down as elapsed time
+	var carry time.Duration
+	if err := parseComponents(timePart, "HMS", func(designator byte, whole int64, frac string) error {
+		var unit time.Duration
+		switch designator {
+		case 'H':
+			p.Hours, unit = int(whole), time.Hour
+		case 'M':
+			p.Minutes, unit = int(whole), time.Minute
+		case 'S':
+			p.Seconds, unit = int(whole), time.Second
+		}
+		if frac != "" {
+			// Read the fraction as nanoseconds of the unit to avoid float rounding
+			nanos, err := strconv.Atoi((frac + "000000000")[:9])
+			if err != nil {
+				return fmt.Errorf("invalid fraction %q", frac)
+			}
+			carry += time.Duration(nanos) * (unit / time.Second)
+		}
+		return nil
+	}); err != nil {
+		return Period{}, wrapISO(err, input)
+	}
+
+	if carry != 0 {
+		extra := carry
+		h := extra / time.Hour
+		extra -= h * time.Hour
+		m := extra / time.Minute
+		extra -= m * time.Minute
+		sec := extra / time.Second
+		extra -= sec * time.Second
+		p.Hours += int(h)
+		p.Minutes += int(m)
+		p.Seconds += int(sec)
+		p.Nanoseconds += int(extra)
+	}
+
+	return p, nil
+}
+
+// parseComponents parses a sequence of <number><designator> pairs whose
+// designators must appear in the order given by allowed, each at most once
+func parseComponents(s, allowed string, apply func(designator byte, whole int64, frac string) error) error {
+	next := 0
+	for s != "" {
+		i := 0
+		for i < len(s) && (s[i] >= '0' && s[i] <= '9' || s[i] == '.' || s[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(s) {
+			return errors.New("expected number followed by designator")
+		}
+
+		number, designator := strings.ReplaceAll(s[:i], ",", "."), s[i]
+		pos := strings.IndexByte(allowed[next:], designator)
+		if pos < 0 {
+			return fmt.Errorf("unexpected designator %c", designator)
+		}
+		next += pos + 1
+
+		wholeStr, frac, _ := strings.Cut(number, ".")
+		if wholeStr == "" {
+			wholeStr = "0"
+		}
+		whole, err := strconv.ParseInt(wholeStr, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", number)
+		}
+		if err := apply(designator, whole, strings.TrimRight(frac, "0")); err != nil {
+			return err
+		}
+
+		s = s[i+1:]
+	}
+	return nil
+}
+
+// wrapISO wraps a component parse error with ErrInvalidISO8601 unless it already is one
+func wrapISO(err error, input string) error {
+	if errors.Is(err, ErrInvalidISO8601) {
+		return err
+	}
+	return fmt.Errorf("%w: %q: %v", ErrInvalidISO8601, input, err)
+}
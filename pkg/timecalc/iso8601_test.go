@@ -0,0 +1,65 @@
+package timecalc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseISO8601(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Period
+	}{
+		{"P1Y2M3DT4H5M6S", Period{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+		{"P1D", Period{Days: 1}},
+		{"P2W", Period{Weeks: 2}},
+		{"PT36H", Period{Hours: 36}},
+		{"pt90m", Period{Minutes: 90}},
+		{"-P1M", Period{Months: 1, Negative: true}},
+		{"+P1Y", Period{Years: 1}},
+		{"PT1.5H", Period{Hours: 1, Minutes: 30}},
+		{"PT0,5S", Period{Nanoseconds: 500000000}},
+		{"PT0.3S", Period{Nanoseconds: 300000000}},
+		{"P0D", Period{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseISO8601(tt.input)
+			if err != nil {
+				t.Fatalf("ParseISO8601(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseISO8601(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseISO8601Invalid(t *testing.T) {
+	inputs := []string{"", "P", "PT", "1D", "P1H", "PT1D", "P1.5D", "P1D2Y", "P1DD", "PXD", "P1", "P1DT"}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseISO8601(input); !errors.Is(err, ErrInvalidISO8601) {
+				t.Errorf("ParseISO8601(%q) error = %v, want ErrInvalidISO8601", input, err)
+			}
+		})
+	}
+}
+
+func TestISO8601RoundTrip(t *testing.T) {
+	inputs := []string{"P1Y2M3DT4H5M6S", "P2W", "-PT90M", "PT1.25S"}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			p, err := ParseISO8601(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := p.ISO8601(); got != input {
+				t.Errorf("round trip = %s, want %s", got, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package timecalc
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a calendar-based amount of time.
+// Unlike time.Duration, its day, month and year components follow wall-clock
+// rules, so one day may span 23 or 25 hours across a DST transition.
+type Period struct {
+	Years       int  `json:"years"`
+	Months      int  `json:"months"`
+	Weeks       int  `json:"weeks,omitempty"`
+	Days        int  `json:"days"`
+	Hours       int  `json:"hours"`
+	Minutes     int  `json:"minutes"`
+	Seconds     int  `json:"seconds"`
+	Nanoseconds int  `json:"nanoseconds,omitempty"`
+	Negative    bool `json:"negative,omitempty"`
+}
+
+// Between returns the calendar period from start to end, measured in loc.
+//
+// Whole years, months and days are counted on the wall clock, so the period
+// between 12:00 on consecutive days is exactly one day even when a DST
+// transition makes the elapsed time 23 or 25 hours. The remainder is
+// reported as elapsed hours, minutes and seconds.
+func Between(start, end time.Time, loc *time.Location) Period {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	a, b := start.In(loc), end.In(loc)
+	negative := false
+	if b.Before(a) {
+		a, b = b, a
+		negative = true
+	}
+
+	// Whole months: start from the calendar estimate and back off while it overshoots
+	months := (b.Year()-a.Year())*12 + int(b.Month()-a.Month())
+	for months > 0 && a.AddDate(0, months, 0).After(b) {
+		months--
+	}
+	anchor := a.AddDate(0, months, 0)
+
+	// Whole days: elapsed hours are within a day of the answer, so refine from there
+	days := int(b.Sub(anchor).Hours() / 24)
+	for days > 0 && anchor.AddDate(0, 0, days).After(b) {
+		days--
+	}
+	for !anchor.AddDate(0, 0, days+1).After(b) {
+		days++
+	}
+	anchor = anchor.AddDate(0, 0, days)
+
+	rest := b.Sub(anchor)
+	p := Period{
+		Years:    months / 12,
+		Months:   months % 12,
+		Days:     days,
+		Negative: negative,
+	}
+	p.Hours = int(rest / time.Hour)
+	rest -= time.Duration(p.Hours) * time.Hour
+	p.Minutes = int(rest / time.Minute)
+	rest -= time.Duration(p.Minutes) * time.Minute
+	p.Seconds = int(rest / time.Second)
+	rest -= time.Duration(p.Seconds) * time.Second
+	p.Nanoseconds = int(rest)
+
+	return p
+}
+
+// IsZero reports whether the period has no length
+func (p Period) IsZero() bool {
+	return p.Years == 0 && p.Months == 0 && p.Weeks == 0 && p.Days == 0 &&
+		p.Hours == 0 && p.Minutes == 0 && p.Seconds == 0 && p.Nanoseconds == 0
+}
+
+// ISO8601 formats the period as an ISO 8601 duration (e.g. P1Y2M3DT4H5M6S).
+// A negative period is prefixed with a minus sign.
+func (p Period) ISO8601() string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	if p.Negative {
+		b.WriteByte('-')
+	}
+	b.WriteByte('P')
+	writeComponent(&b, p.Years, 'Y')
+	writeComponent(&b, p.Months, 'M')
+	writeComponent(&b, p.Weeks, 'W')
+	writeComponent(&b, p.Days, 'D')
+
+	if p.Hours != 0 || p.Minutes != 0 || p.Seconds != 0 || p.Nanoseconds != 0 {
+		b.WriteByte('T')
+		writeComponent(&b, p.Hours, 'H')
+		writeComponent(&b, p.Minutes, 'M')
+		if p.Nanoseconds != 0 {
+			b.WriteString(formatSeconds(p.Seconds, p.Nanoseconds))
+			b.WriteByte('S')
+		} else {
+			writeComponent(&b, p.Seconds, 'S')
+		}
+	}
+
+	return b.String()
+}
+
+// FormatDuration formats an exact duration as an ISO 8601 duration using only
+// hours, minutes and seconds (e.g. PT23H), since days are not a fixed length
+func FormatDuration(d time.Duration) string {
+	p := Period{}
+	if d < 0 {
+		p.Negative = true
+		d = -d
+	}
+	p.Hours = int(d / time.Hour)
+	d -= time.Duration(p.Hours) * time.Hour
+	p.Minutes = int(d / time.Minute)
+	d -= time.Duration(p.Minutes) * time.Minute
+	p.Seconds = int(d / time.Second)
+	d -= time.Duration(p.Seconds) * time.Second
+	p.Nanoseconds = int(d)
+	return p.ISO8601()
+}
+
+// writeComponent writes a non-zero duration component with its designator
+func writeComponent(b *strings.Builder, value int, designator byte) {
+	if value == 0 {
+		return
+	}
+	b.WriteString(strconv.Itoa(value))
+	b.WriteByte(designator)
+}
+
+// formatSeconds formats seconds with a fractional part, trimming trailing zeros
+func formatSeconds(seconds, nanoseconds int) string {
+	frac := strings.TrimRight(strconv.FormatInt(int64(1e9+nanoseconds), 10)[1:], "0")
+	return strconv.Itoa(seconds) + "." + frac
+}
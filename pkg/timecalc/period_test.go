@@ -0,0 +1,140 @@
+package timecalc
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("failed to load timezone %s: %v", name, err)
+	}
+	return loc
+}
+
+func TestBetween(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		loc   *time.Location
+		want  Period
+	}{
+		{
+			name:  "same instant",
+			start: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:  Period{},
+		},
+		{
+			name:  "mixed components",
+			start: time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 3, 18, 14, 30, 45, 0, time.UTC),
+			want:  Period{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 30, Seconds: 45},
+		},
+		{
+			name:  "spring forward day is one calendar day",
+			start: time.Date(2025, 3, 8, 12, 0, 0, 0, ny),
+			end:   time.Date(2025, 3, 9, 12, 0, 0, 0, ny),
+			loc:   ny,
+			want:  Period{Days: 1},
+		},
+		{
+			name:  "fall back day is one calendar day",
+			start: time.Date(2025, 11, 1, 12, 0, 0, 0, ny),
+			end:   time.Date(2025, 11, 2, 12, 0, 0, 0, ny),
+			loc:   ny,
+			want:  Period{Days: 1},
+		},
+		{
+			name:  "spring forward measured in UTC is 23 hours",
+			start: time.Date(2025, 3, 8, 12, 0, 0, 0, ny),
+			end:   time.Date(2025, 3, 9, 12, 0, 0, 0, ny),
+			loc:   time.UTC,
+			want:  Period{Hours: 23},
+		},
+		{
+			name:  "month end to shorter month",
+			start: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+			want:  Period{Days: 28},
+		},
+		{
+			name:  "leap day to next year",
+			start: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			want:  Period{Years: 1},
+		},
+		{
+			name:  "negative period",
+			start: time.Date(2025, 3, 1, 6, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+			want:  Period{Months: 1, Hours: 6, Negative: true},
+		},
+		{
+			name:  "sub-second remainder",
+			start: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, 1, 1, 0, 0, 1, 500000000, time.UTC),
+			want:  Period{Seconds: 1, Nanoseconds: 500000000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Between(tt.start, tt.end, tt.loc)
+			if got != tt.want {
+				t.Errorf("Between() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriodISO8601(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   string
+	}{
+		{Period{}, "PT0S"},
+		{Period{Days: 1}, "P1D"},
+		{Period{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}, "P1Y2M3DT4H5M6S"},
+		{Period{Weeks: 2}, "P2W"},
+		{Period{Hours: 23}, "PT23H"},
+		{Period{Minutes: 90}, "PT90M"},
+		{Period{Seconds: 1, Nanoseconds: 500000000}, "PT1.5S"},
+		{Period{Nanoseconds: 1000}, "PT0.000001S"},
+		{Period{Months: 1, Negative: true}, "-P1M"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.period.ISO8601(); got != tt.want {
+				t.Errorf("ISO8601() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		want     string
+	}{
+		{0, "PT0S"},
+		{23 * time.Hour, "PT23H"},
+		{49*time.Hour + 30*time.Minute, "PT49H30M"},
+		{-90 * time.Second, "-PT1M30S"},
+		{1500 * time.Millisecond, "PT1.5S"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatDuration(tt.duration); got != tt.want {
+				t.Errorf("FormatDuration(%v) = %s, want %s", tt.duration, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package timecalc
+
+import (
+	"sort"
+	"time"
+)
+
+// OffsetSpan is a period during which one timezone stays the same amount
+// ahead of another
+type OffsetSpan struct {
+	Start time.Time
+	End   time.Time
+	// Difference is how many seconds the first zone's UTC offset is ahead of
+	// the second's; negative when it is behind
+	Difference int
+}
+
+// OffsetTimeline returns the spans of [start, end) during which loc keeps the
+// same offset relative to other, in chronological order.
+//
+// A span ends only when the difference changes, so transitions the two zones
+// make at the same instant, such as the shared EU DST dates, do not split it.
+func OffsetTimeline(loc, other *time.Location, start, end time.Time) []OffsetSpan {
+	if loc == nil {
+		loc = time.UTC
+	}
+	if other == nil {
+		other = time.UTC
+	}
+	if !end.After(start) {
+		return nil
+	}
+
+	var boundaries []time.Time
+	for _, tr := range Transitions(loc, start, end) {
+		boundaries = append(boundaries, tr.At)
+	}
+	for _, tr := range Transitions(other, start, end) {
+		boundaries = append(boundaries, tr.At)
+	}
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i].Before(boundaries[j]) })
+
+	current := OffsetSpan{Start: start, Difference: difference(loc, other, start)}
+	var spans []OffsetSpan
+	for _, at := range boundaries {
+		if !at.After(current.Start) {
+			continue
+		}
+		if d := difference(loc, other, at); d != current.Difference {
+			current.End = at
+			spans = append(spans, current)
+			current = OffsetSpan{Start: at, Difference: d}
+		}
+	}
+	current.End = end
+	return append(spans, current)
+}
+
+// difference returns how many seconds loc's UTC offset is ahead of other's at t
+func difference(loc, other *time.Location, t time.Time) int {
+	_, offset := t.In(loc).Zone()
+	_, otherOffset := t.In(other).Zone()
+	return offset - otherOffset
+}
@@ -0,0 +1,60 @@
+package timecalc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOffsetTimeline(t *testing.T) {
+	london := mustLoad(t, "Europe/London")
+	ny := mustLoad(t, "America/New_York")
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got := OffsetTimeline(london, ny, start, end)
+	want := []OffsetSpan{
+		{start, time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), 5 * 3600},
+		{time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC), time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC), 4 * 3600},
+		{time.Date(2025, 3, 30, 1, 0, 0, 0, time.UTC), time.Date(2025, 10, 26, 1, 0, 0, 0, time.UTC), 5 * 3600},
+		{time.Date(2025, 10, 26, 1, 0, 0, 0, time.UTC), time.Date(2025, 11, 2, 6, 0, 0, 0, time.UTC), 4 * 3600},
+		{time.Date(2025, 11, 2, 6, 0, 0, 0, time.UTC), end, 5 * 3600},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d spans, got %d: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if !got[i].Start.Equal(want[i].Start) || !got[i].End.Equal(want[i].End) || got[i].Difference != want[i].Difference {
+			t.Errorf("span %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestOffsetTimelineSharedTransitions(t *testing.T) {
+	// Berlin and London change clocks at the same instants
+	berlin := mustLoad(t, "Europe/Berlin")
+	london := mustLoad(t, "Europe/London")
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	got := OffsetTimeline(berlin, london, start, end)
+	if len(got) != 1 || got[0].Difference != 3600 || !got[0].Start.Equal(start) || !got[0].End.Equal(end) {
+		t.Errorf("expected a single +1h span, got %+v", got)
+	}
+
+	// Opposite hemispheres: Sydney moves the other way
+	sydney := mustLoad(t, "Australia/Sydney")
+	got = OffsetTimeline(london, sydney, start, end)
+	if len(got) != 5 {
+		t.Fatalf("expected 5 spans, got %d: %+v", len(got), got)
+	}
+	if got[0].Difference != -11*3600 || got[2].Difference != -9*3600 {
+		t.Errorf("unexpected differences: %+v", got)
+	}
+}
+
+func TestOffsetTimelineEmptyRange(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := OffsetTimeline(time.UTC, time.UTC, at, at); got != nil {
+		t.Errorf("expected no spans for an empty range, got %+v", got)
+	}
+}
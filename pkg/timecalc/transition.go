@@ -0,0 +1,54 @@
+package timecalc
+
+import "time"
+
+// Transition is a change in a timezone's UTC offset or abbreviation
+type Transition struct {
+	// At is the first instant governed by the new offset
+	At              time.Time
+	OldOffset       int
+	NewOffset       int
+	OldAbbreviation string
+	NewAbbreviation string
+	// IsDST reports whether daylight saving time is in effect after the transition
+	IsDST bool
+}
+
+// Transitions returns the offset transitions of loc that occur in [start, end),
+// in chronological order.
+//
+// Transitions are read from the tz database through time.Time.ZoneBounds, so
+// they include both historical changes and future changes predicted by the
+// zone's recurring DST rule. Zone records that change neither the offset, the
+// abbreviation nor the DST flag are skipped.
+func Transitions(loc *time.Location, start, end time.Time) []Transition {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	var transitions []Transition
+	// Step back one nanosecond so a transition exactly at start is included
+	t := start.Add(-time.Nanosecond).In(loc)
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.Before(end) || !next.After(t) {
+			break
+		}
+
+		oldAbbr, oldOffset := t.Zone()
+		newAbbr, newOffset := next.Zone()
+		if oldOffset != newOffset || oldAbbr != newAbbr || t.IsDST() != next.IsDST() {
+			transitions = append(transitions, Transition{
+				At:              next,
+				OldOffset:       oldOffset,
+				NewOffset:       newOffset,
+				OldAbbreviation: oldAbbr,
+				NewAbbreviation: newAbbr,
+				IsDST:           next.IsDST(),
+			})
+		}
+		t = next
+	}
+
+	return transitions
+}
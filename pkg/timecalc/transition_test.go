@@ -0,0 +1,83 @@
+package timecalc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTransitions(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	got := Transitions(ny,
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 transitions, got %d: %+v", len(got), got)
+	}
+
+	spring, fall := got[0], got[1]
+	if !spring.At.Equal(time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("spring forward at %v, want 2025-03-09T07:00:00Z", spring.At)
+	}
+	if spring.OldOffset != -5*3600 || spring.NewOffset != -4*3600 {
+		t.Errorf("spring forward offsets = %d -> %d", spring.OldOffset, spring.NewOffset)
+	}
+	if spring.OldAbbreviation != "EST" || spring.NewAbbreviation != "EDT" || !spring.IsDST {
+		t.Errorf("unexpected spring forward: %+v", spring)
+	}
+
+	if !fall.At.Equal(time.Date(2025, 11, 2, 6, 0, 0, 0, time.UTC)) {
+		t.Errorf("fall back at %v, want 2025-11-02T06:00:00Z", fall.At)
+	}
+	if fall.NewAbbreviation != "EST" || fall.IsDST {
+		t.Errorf("unexpected fall back: %+v", fall)
+	}
+}
+
+func TestTransitionsRangeBounds(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+	at := time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC)
+
+	if got := Transitions(ny, at, at.Add(time.Hour)); len(got) != 1 {
+		t.Errorf("expected transition at start to be included, got %d", len(got))
+	}
+	if got := Transitions(ny, at.Add(-time.Hour), at); len(got) != 0 {
+		t.Errorf("expected transition at end to be excluded, got %d", len(got))
+	}
+}
+
+func TestTransitionsNone(t *testing.T) {
+	tests := []string{"UTC", "Asia/Tokyo"}
+
+	for _, name := range tests {
+		t.Run(name, func(t *testing.T) {
+			loc := mustLoad(t, name)
+			got := Transitions(loc,
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			)
+			if len(got) != 0 {
+				t.Errorf("expected no transitions, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestTransitionsSouthernHemisphere(t *testing.T) {
+	sydney := mustLoad(t, "Australia/Sydney")
+
+	got := Transitions(sydney,
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
+	if len(got) != 2 {
+		t.Fatalf("expected 2 transitions, got %d", len(got))
+	}
+	if got[0].IsDST || got[0].NewAbbreviation != "AEST" {
+		t.Errorf("expected DST to end first, got %+v", got[0])
+	}
+	if !got[1].IsDST || got[1].NewOffset != 11*3600 {
+		t.Errorf("expected DST to start second, got %+v", got[1])
+	}
+}
@@ -0,0 +1,64 @@
+package timecalc
+
+import (
+	"sort"
+	"time"
+)
+
+// WallResolution describes how a wall-clock time maps onto a timezone
+type WallResolution int
+
+const (
+	// WallExact means the wall-clock time occurs once
+	WallExact WallResolution = iota
+	// WallRepeated means the wall-clock time occurs twice, as clocks go back
+	WallRepeated
+	// WallSkipped means the wall-clock time does not occur, as clocks go forward
+	WallSkipped
+)
+
+// Floating returns the wall-clock fields of t as a time in UTC
+func Floating(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// InstantsAt returns the instants, in order, at which loc's wall clock shows
+// the date and time fields of wall: none in a DST gap, two in a DST fold and
+// one otherwise
+func InstantsAt(wall time.Time, loc *time.Location) []time.Time {
+	naive := wall.Unix()
+	_, before := time.Unix(naive-86400, 0).In(loc).Zone()
+	_, after := time.Unix(naive+86400, 0).In(loc).Zone()
+
+	offsets := []int{before}
+	if after != before {
+		offsets = append(offsets, after)
+	}
+
+	var out []time.Time
+	for _, offset := range offsets {
+		t := time.Unix(naive-int64(offset), int64(wall.Nanosecond())).In(loc)
+		if _, actual := t.Zone(); actual == offset {
+			out = append(out, t)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return out
+}
+
+// ResolveWall maps a wall-clock time onto loc as RFC 5545 section 3.3.5
+// describes. A repeated time resolves to its first occurrence, and a skipped
+// time is read with the UTC offset in effect before the gap, which moves it
+// forward by the length of the gap.
+func ResolveWall(wall time.Time, loc *time.Location) (time.Time, WallResolution) {
+	instants := InstantsAt(wall, loc)
+	switch len(instants) {
+	case 0:
+		_, before := time.Unix(wall.Unix()-86400, 0).In(loc).Zone()
+		return time.Unix(wall.Unix()-int64(before), int64(wall.Nanosecond())).In(loc), WallSkipped
+	case 1:
+		return instants[0], WallExact
+	default:
+		return instants[0], WallRepeated
+	}
+}
@@ -0,0 +1,44 @@
+package timecalc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveWall(t *testing.T) {
+	ny := mustLoad(t, "America/New_York")
+
+	tests := []struct {
+		name     string
+		wall     time.Time
+		want     string
+		res      WallResolution
+		instants int
+	}{
+		{"exact", time.Date(2025, 6, 1, 9, 30, 0, 0, time.UTC), "2025-06-01T09:30:00-04:00", WallExact, 1},
+		{"gap", time.Date(2025, 3, 9, 2, 30, 0, 0, time.UTC), "2025-03-09T03:30:00-04:00", WallSkipped, 0},
+		{"fold", time.Date(2025, 11, 2, 1, 30, 0, 0, time.UTC), "2025-11-02T01:30:00-04:00", WallRepeated, 2},
+		{"fractional seconds", time.Date(2025, 1, 15, 8, 0, 0, 500000000, time.UTC), "2025-01-15T08:00:00.5-05:00", WallExact, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, res := ResolveWall(tt.wall, ny)
+			if got.Format(time.RFC3339Nano) != tt.want || res != tt.res {
+				t.Errorf("ResolveWall() = %s (%v), want %s (%v)", got.Format(time.RFC3339Nano), res, tt.want, tt.res)
+			}
+			instants := InstantsAt(tt.wall, ny)
+			if len(instants) != tt.instants {
+				t.Fatalf("InstantsAt() returned %d instants, want %d", len(instants), tt.instants)
+			}
+			for i := 1; i < len(instants); i++ {
+				if !instants[i-1].Before(instants[i]) {
+					t.Errorf("InstantsAt() not in order: %v", instants)
+				}
+			}
+			if len(instants) > 0 && !Floating(instants[0]).Equal(tt.wall) {
+				t.Errorf("Floating(%v) = %v, want %v", instants[0], Floating(instants[0]), tt.wall)
+			}
+		})
+	}
+}
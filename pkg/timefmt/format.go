@@ -0,0 +1,127 @@
+// Package timefmt formats instants for API output. A format is a named preset,
+// a localized style, a strftime-style pattern or a raw Go layout, so REST
+// endpoints and MCP tools accept the same values and produce the same strings.
+package timefmt
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrInvalidFormat is returned when a strftime pattern is malformed
+var ErrInvalidFormat = errors.New("invalid time format")
+
+// Default is the format used when none is given
+const Default = "rfc3339"
+
+// preset formats an instant as one named format
+type preset func(t time.Time) string
+
+// layout returns a preset that formats with a Go layout
+func layout(l string) preset {
+	return func(t time.Time) string { return t.Format(l) }
+}
+
+// excelEpoch is day zero of Excel serial dates. Counting from 30 December 1899
+// matches Excel for dates after February 1900, where it wrongly has a 29th.
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// julianUnixEpoch is the Julian day number of 1970-01-01T00:00:00Z
+const julianUnixEpoch = 2440587.5
+
+// presetOrder lists the preset names as they are documented
+var presetOrder = []string{
+	"rfc3339", "iso8601", "rfc3339nano", "rfc1123", "rfc1123z", "rfc822", "rfc822z", "rfc850", "http",
+	"isoweek", "ordinal", "excel", "julian", "unix", "unixmilli", "unixmicro", "unixnano",
+}
+
+var presets = map[string]preset{
+	"rfc3339":     layout(time.RFC3339),
+	"iso8601":     layout(time.RFC3339),
+	"rfc3339nano": layout(time.RFC3339Nano),
+	"rfc1123":     layout(time.RFC1123),
+	"rfc1123z":    layout(time.RFC1123Z),
+	"rfc822":      layout(time.RFC822),
+	"rfc822z":     layout(time.RFC822Z),
+	"rfc850":      layout(time.RFC850),
+	// HTTP-date (RFC 9110) is always expressed in GMT
+	"http": func(t time.Time) string { return t.UTC().Format(http.TimeFormat) },
+	// ISO 8601 week date, e.g. 2025-W24-2
+	"isoweek": func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d-%d", year, week, isoWeekday(t))
+	},
+	// ISO 8601 ordinal date, e.g. 2025-161
+	"ordinal": func(t time.Time) string {
+		return fmt.Sprintf("%04d-%03d", t.Year(), t.YearDay())
+	},
+	// Excel serial date of the wall-clock time, which carries no offset
+	"excel": func(t time.Time) string {
+		wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+		return formatDays(wall.Unix()-excelEpoch.Unix(), wall.Nanosecond(), 0)
+	},
+	// Julian day of the instant
+	"julian": func(t time.Time) string {
+		return formatDays(t.Unix(), t.Nanosecond(), julianUnixEpoch)
+	},
+	"unix":      func(t time.Time) string { return strconv.FormatInt(t.Unix(), 10) },
+	"unixmilli": func(t time.Time) string { return strconv.FormatInt(t.UnixMilli(), 10) },
+	"unixmicro": func(t time.Time) string { return strconv.FormatInt(t.UnixMicro(), 10) },
+	"unixnano":  func(t time.Time) string { return strconv.FormatInt(t.UnixNano(), 10) },
+}
+
+// aliases maps alternative spellings onto preset names
+var aliases = map[string]string{
+	"iso":       "iso8601",
+	"http-date": "http",
+	"iso-week":  "isoweek",
+	"unix-ms":   "unixmilli",
+	"unixms":    "unixmilli",
+	"unix-us":   "unixmicro",
+	"unixus":    "unixmicro",
+	"unix-ns":   "unixnano",
+	"unixns":    "unixnano",
+}
+
+// Presets returns the names of the supported presets
+func Presets() []string {
+	return append([]string(nil), presetOrder...)
+}
+
+// Format formats t according to format, which is one of:
+//
+//   - a preset name (case-insensitive), see Presets; empty means Default
+//   - a localized style, see Styles, shown in American English
+//   - a strftime-style pattern, recognised by containing '%', e.g. "%Y-%m-%d %H:%M"
+//   - a Go layout, e.g. "2006-01-02 15:04"
+//
+// Only malformed strftime patterns return an error; any other string is
+// treated as a Go layout. Use Locale.Format to show styles and names in
+// another language.
+func Format(t time.Time, format string) (string, error) {
+	return (*Locale)(nil).Format(t, format)
+}
+
+// Validate reports whether format can be used with Format
+func Validate(format string) error {
+	_, err := Format(time.Time{}, format)
+	return err
+}
+
+// isoWeekday returns the ISO 8601 day of the week, Monday 1 to Sunday 7
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+// formatDays formats secs seconds plus nanos nanoseconds as a fractional day
+// count from base
+func formatDays(secs int64, nanos int, base float64) string {
+	days := float64(secs)/86400 + float64(nanos)/86400e9 + base
+	return strconv.FormatFloat(days, 'f', -1, 64)
+}
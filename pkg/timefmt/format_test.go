@@ -0,0 +1,90 @@
+package timefmt
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	// Tuesday 10 June 2025, 14:30:15.123456789 EDT
+	ts := time.Date(2025, 6, 10, 14, 30, 15, 123456789, ny)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"", "2025-06-10T14:30:15-04:00"},
+		{"RFC3339", "2025-06-10T14:30:15-04:00"},
+		{"iso8601", "2025-06-10T14:30:15-04:00"},
+		{"rfc3339nano", "2025-06-10T14:30:15.123456789-04:00"},
+		{"rfc1123", "Tue, 10 Jun 2025 14:30:15 EDT"},
+		{"rfc1123z", "Tue, 10 Jun 2025 14:30:15 -0400"},
+		{"rfc822", "10 Jun 25 14:30 EDT"},
+		{"http", "Tue, 10 Jun 2025 18:30:15 GMT"},
+		{"http-date", "Tue, 10 Jun 2025 18:30:15 GMT"},
+		{"isoweek", "2025-W24-2"},
+		{"ordinal", "2025-161"},
+		{"unix", "1749580215"},
+		{"unixmilli", "1749580215123"},
+		{"unixmicro", "1749580215123456"},
+		{"unixnano", "1749580215123456789"},
+		{"%Y-%m-%d %H:%M:%S.%L %z", "2025-06-10 14:30:15.123 -0400"},
+		{"%a %e %b %I:%M %p %Z", "Tue 10 Jun 02:30 PM EDT"},
+		{"%G-W%V-%u day %j %:z %%", "2025-W24-2 day 161 -04:00 %"},
+		{"%s.%N", "1749580215.123456789"},
+		{"2006-01-02 15:04", "2025-06-10 14:30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := Format(ts, tt.format)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Format(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatSerialDays(t *testing.T) {
+	tests := []struct {
+		format string
+		time   time.Time
+		want   string
+	}{
+		{"excel", time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC), "61"},
+		{"excel", time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC), "45818.75"},
+		// The serial date follows the wall clock, not the instant
+		{"excel", time.Date(2025, 6, 10, 18, 0, 0, 0, time.FixedZone("", -4*3600)), "45818.75"},
+		{"julian", time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), "2451545"},
+		{"julian", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "2440587.5"},
+	}
+
+	for _, tt := range tests {
+		got, err := Format(tt.time, tt.format)
+		if err != nil {
+			t.Fatalf("Format() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("Format(%s, %q) = %q, want %q", tt.time, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestFormatInvalidPattern(t *testing.T) {
+	for _, format := range []string{"%Y-%Q", "%Y %", "%:m"} {
+		if err := Validate(format); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Validate(%q) error = %v, want ErrInvalidFormat", format, err)
+		}
+	}
+	if err := Validate("custom"); err != nil {
+		t.Errorf("expected text without %% to be a Go layout, got %v", err)
+	}
+}
@@ -0,0 +1,280 @@
+package timefmt
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedLocale is returned for a locale that is not bundled
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+// DefaultStyle is the style used for localized output when none is given
+const DefaultStyle = "long"
+
+// Locale holds the names and patterns used to show times to people in one
+// language and region. Patterns use CLDR date field symbols, e.g. "d MMMM y"
+// or "h:mm a", with literal text in single quotes.
+type Locale struct {
+	// Tag is the BCP 47 language tag, e.g. "de-DE"
+	Tag string
+	// Name is the English name of the locale
+	Name string
+
+	months      [12]string
+	shortMonths [12]string
+	days        [7]string
+	shortDays   [7]string
+	am, pm      string
+
+	// dates and times hold the full, long, medium and short patterns
+	dates [4]string
+	times [4]string
+	// dateTimeLong joins the full and long styles and dateTimeShort the
+	// medium and short ones; {1} is the date and {0} the time
+	dateTimeLong  string
+	dateTimeShort string
+}
+
+// Style indices into the pattern arrays
+const (
+	styleFull = iota
+	styleLong
+	styleMedium
+	styleShort
+)
+
+// styleOrder lists the style names as they are documented
+var styleOrder = []string{
+	"full", "long", "medium", "short",
+	"date_full", "date_long", "date_medium", "date_short",
+	"time_full", "time_long", "time_medium", "time_short",
+}
+
+// styles maps a style name to its pattern in a locale
+var styles = map[string]func(l *Locale) string{
+	"full":        func(l *Locale) string { return l.dateTime(styleFull) },
+	"long":        func(l *Locale) string { return l.dateTime(styleLong) },
+	"medium":      func(l *Locale) string { return l.dateTime(styleMedium) },
+	"short":       func(l *Locale) string { return l.dateTime(styleShort) },
+	"date_full":   func(l *Locale) string { return l.dates[styleFull] },
+	"date_long":   func(l *Locale) string { return l.dates[styleLong] },
+	"date_medium": func(l *Locale) string { return l.dates[styleMedium] },
+	"date_short":  func(l *Locale) string { return l.dates[styleShort] },
+	"time_full":   func(l *Locale) string { return l.times[styleFull] },
+	"time_long":   func(l *Locale) string { return l.times[styleLong] },
+	"time_medium": func(l *Locale) string { return l.times[styleMedium] },
+	"time_short":  func(l *Locale) string { return l.times[styleShort] },
+}
+
+// Styles returns the names of the localized styles
+func Styles() []string {
+	return append([]string(nil), styleOrder...)
+}
+
+// IsStyle reports whether name is a localized style
+func IsStyle(name string) bool {
+	_, ok := styles[strings.ToLower(strings.TrimSpace(name))]
+	return ok
+}
+
+// Locales returns the bundled locales, sorted by tag
+func Locales() []*Locale {
+	out := append([]*Locale(nil), locales...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Tag < out[j].Tag })
+	return out
+}
+
+// LocaleTags returns the tags of the bundled locales, sorted
+func LocaleTags() []string {
+	var tags []string
+	for _, l := range Locales() {
+		tags = append(tags, l.Tag)
+	}
+	return tags
+}
+
+// ParseLocale returns the bundled locale for a language tag. Tags match
+// case-insensitively, "_" may separate the subtags, and a tag whose region
+// is not bundled falls back to the main locale for its language, so "de-AT"
+// and "de" both select de-DE.
+func ParseLocale(tag string) (*Locale, error) {
+	if l, ok := findLocale(tag); ok {
+		return l, nil
+	}
+	return nil, fmt.Errorf("%w %q (supported: %s)", ErrUnsupportedLocale, tag, strings.Join(LocaleTags(), ", "))
+}
+
+// NegotiateLocale returns the bundled locale that best matches an
+// Accept-Language header, or false if none of the listed languages is
+// bundled
+func NegotiateLocale(acceptLanguage string) (*Locale, bool) {
+	type candidate struct {
+		tag string
+		q   float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if tag == "" || tag == "*" || q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{tag, q})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+
+	for _, c := range candidates {
+		if l, ok := findLocale(c.tag); ok {
+			return l, true
+		}
+	}
+	return nil, false
+}
+
+// findLocale matches a tag exactly, then by language
+func findLocale(tag string) (*Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	for _, l := range locales {
+		if strings.ToLower(l.Tag) == tag {
+			return l, true
+		}
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	l, ok := languageDefaults[language]
+	return l, ok
+}
+
+// Format formats t according to format like the package-level Format, with
+// the locale's names in styles and strftime patterns. A nil locale formats
+// like the package-level Format.
+func (l *Locale) Format(t time.Time, format string) (string, error) {
+	if format == "" {
+		format = Default
+	}
+	name := strings.ToLower(strings.TrimSpace(format))
+	if style, ok := styles[name]; ok {
+		loc := l
+		if loc == nil {
+			loc = enUS
+		}
+		return loc.pattern(t, style(loc)), nil
+	}
+	if alias, ok := aliases[name]; ok {
+		name = alias
+	}
+	if p, ok := presets[name]; ok {
+		return p(t), nil
+	}
+	if strings.Contains(format, "%") {
+		return strftime(t, format, l)
+	}
+	return t.Format(format), nil
+}
+
+// dateTime returns the pattern joining the date and time of a style
+func (l *Locale) dateTime(style int) string {
+	join := l.dateTimeShort
+	if style == styleFull || style == styleLong {
+		join = l.dateTimeLong
+	}
+	return strings.NewReplacer("{1}", l.dates[style], "{0}", l.times[style]).Replace(join)
+}
+
+// pattern formats t with a CLDR date pattern. Supported fields are y, M, d,
+// E, H, h, m, s, a and z; other letters are copied as they are.
+func (l *Locale) pattern(t time.Time, p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); {
+		c := p[i]
+		switch {
+		case c == '\'':
+			// '' is a literal quote, otherwise copy up to the closing quote
+			if i+1 < len(p) && p[i+1] == '\'' {
+				b.WriteByte('\'')
+				i += 2
+				continue
+			}
+			end := strings.IndexByte(p[i+1:], '\'')
+			if end < 0 {
+				end = len(p) - i - 1
+			}
+			b.WriteString(p[i+1 : i+1+end])
+			i += end + 2
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			n := 1
+			for i+n < len(p) && p[i+n] == c {
+				n++
+			}
+			b.WriteString(l.field(t, c, n))
+			i += n
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// field formats one pattern field of width n
+func (l *Locale) field(t time.Time, c byte, n int) string {
+	pad := func(v int) string {
+		if n >= 2 {
+			return fmt.Sprintf("%0*d", n, v)
+		}
+		return strconv.Itoa(v)
+	}
+	switch c {
+	case 'y':
+		if n == 2 {
+			return fmt.Sprintf("%02d", t.Year()%100)
+		}
+		return pad(t.Year())
+	case 'M':
+		switch {
+		case n >= 4:
+			return l.months[t.Month()-1]
+		case n == 3:
+			return l.shortMonths[t.Month()-1]
+		}
+		return pad(int(t.Month()))
+	case 'd':
+		return pad(t.Day())
+	case 'E':
+		if n >= 4 {
+			return l.days[t.Weekday()]
+		}
+		return l.shortDays[t.Weekday()]
+	case 'H':
+		return pad(t.Hour())
+	case 'h':
+		h := t.Hour() % 12
+		if h == 0 {
+			h = 12
+		}
+		return pad(h)
+	case 'm':
+		return pad(t.Minute())
+	case 's':
+		return pad(t.Second())
+	case 'a':
+		if t.Hour() < 12 {
+			return l.am
+		}
+		return l.pm
+	case 'z':
+		abbr, _ := t.Zone()
+		return abbr
+	}
+	return strings.Repeat(string(c), n)
+}
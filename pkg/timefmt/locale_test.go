@@ -0,0 +1,126 @@
+package timefmt
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLocaleFormat(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	// Tuesday 10 June 2025, 14:30:15 EDT
+	ts := time.Date(2025, 6, 10, 14, 30, 15, 0, ny)
+
+	tests := []struct {
+		locale string
+		format string
+		want   string
+	}{
+		{"en-US", "full", "Tuesday, June 10, 2025 at 2:30:15 PM EDT"},
+		{"en-US", "short", "6/10/25, 2:30 PM"},
+		{"en-GB", "long", "10 June 2025 at 14:30:15 EDT"},
+		{"en-GB", "date_short", "10/06/2025"},
+		{"de", "full", "Dienstag, 10. Juni 2025 um 14:30:15 EDT"},
+		{"de-AT", "medium", "10.06.2025, 14:30:15"},
+		{"fr-FR", "long", "10 juin 2025 à 14:30:15 EDT"},
+		{"es", "date_full", "martes, 10 de junio de 2025"},
+		{"es", "time_short", "14:30"},
+		{"it", "date_long", "10 giugno 2025"},
+		{"pt-BR", "date_full", "terça-feira, 10 de junho de 2025"},
+		{"nl", "date_short", "10-06-2025"},
+		{"ja", "date_full", "2025年6月10日火曜日"},
+		{"zh", "date_full", "2025年6月10日星期二"},
+		{"fr", "%A %e %B %Y", "mardi 10 juin 2025"},
+		{"de", "%x %X", "10.06.25 14:30:15"},
+		{"ja", "%p%l時", "午後 2時"},
+		// Presets and Go layouts are the same in every locale
+		{"de", "rfc3339", "2025-06-10T14:30:15-04:00"},
+		{"fr", "Jan 2", "Jun 10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+" "+tt.format, func(t *testing.T) {
+			l, err := ParseLocale(tt.locale)
+			if err != nil {
+				t.Fatalf("ParseLocale() error = %v", err)
+			}
+			got, err := l.Format(ts, tt.format)
+			if err != nil {
+				t.Fatalf("Format() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Format(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatStyleWithoutLocale(t *testing.T) {
+	ts := time.Date(2025, 6, 10, 9, 5, 0, 0, time.UTC)
+	got, err := Format(ts, "LONG")
+	if err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+	if want := "June 10, 2025 at 9:05:00 AM UTC"; got != want {
+		t.Errorf("Format() = %q, want %q", got, want)
+	}
+	// Without a locale, strftime keeps the POSIX representations
+	if got, _ := Format(ts, "%x"); got != "06/10/25" {
+		t.Errorf("Format(%%x) = %q, want 06/10/25", got)
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	tests := map[string]string{
+		"en-US": "en-US",
+		"EN_gb": "en-GB",
+		"en":    "en-US",
+		"en-AU": "en-US",
+		"pt-PT": "pt-BR",
+		"zh":    "zh-CN",
+	}
+	for tag, want := range tests {
+		l, err := ParseLocale(tag)
+		if err != nil {
+			t.Errorf("ParseLocale(%q) error = %v", tag, err)
+			continue
+		}
+		if l.Tag != want {
+			t.Errorf("ParseLocale(%q) = %s, want %s", tag, l.Tag, want)
+		}
+	}
+
+	for _, tag := range []string{"", "xx", "klingon"} {
+		if _, err := ParseLocale(tag); !errors.Is(err, ErrUnsupportedLocale) {
+			t.Errorf("ParseLocale(%q) error = %v, want ErrUnsupportedLocale", tag, err)
+		}
+	}
+}
+
+func TestNegotiateLocale(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"de-CH,de;q=0.9,en;q=0.8", "de-DE"},
+		{"fr-CA;q=0.5, ja;q=0.9", "ja-JP"},
+		{"xx, en-GB;q=0.7", "en-GB"},
+		{"*;q=0.5, es", "es-ES"},
+		{"it;q=0, nl", "nl-NL"},
+		{"", ""},
+		{"xx, *", ""},
+	}
+	for _, tt := range tests {
+		l, ok := NegotiateLocale(tt.header)
+		got := ""
+		if ok {
+			got = l.Tag
+		}
+		if got != tt.want {
+			t.Errorf("NegotiateLocale(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package timefmt
+
+// Bundled locales. Names and patterns follow the CLDR data for each locale,
+// trimmed to the fields Locale supports.
+
+var enUS = &Locale{
+	Tag:  "en-US",
+	Name: "English (United States)",
+	months: [12]string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"},
+	shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	days:        [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	shortDays:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	am:          "AM",
+	pm:          "PM",
+	dates:       [4]string{"EEEE, MMMM d, y", "MMMM d, y", "MMM d, y", "M/d/yy"},
+	times:       [4]string{"h:mm:ss a z", "h:mm:ss a z", "h:mm:ss a", "h:mm a"},
+
+	dateTimeLong:  "{1} 'at' {0}",
+	dateTimeShort: "{1}, {0}",
+}
+
+var enGB = &Locale{
+	Tag:         "en-GB",
+	Name:        "English (United Kingdom)",
+	months:      enUS.months,
+	shortMonths: [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sept", "Oct", "Nov", "Dec"},
+	days:        enUS.days,
+	shortDays:   enUS.shortDays,
+	am:          "am",
+	pm:          "pm",
+	dates:       [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+	times:       [4]string{"HH:mm:ss z", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} 'at' {0}",
+	dateTimeShort: "{1}, {0}",
+}
+
+var deDE = &Locale{
+	Tag:  "de-DE",
+	Name: "German (Germany)",
+	months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember"},
+	shortMonths: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+	days:        [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	shortDays:   [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+	am:          "AM",
+	pm:          "PM",
+	dates:       [4]string{"EEEE, d. MMMM y", "d. MMMM y", "dd.MM.y", "dd.MM.yy"},
+	times:       [4]string{"HH:mm:ss z", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} 'um' {0}",
+	dateTimeShort: "{1}, {0}",
+}
+
+var frFR = &Locale{
+	Tag:  "fr-FR",
+	Name: "French (France)",
+	months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	shortMonths: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	days:        [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	shortDays:   [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	am:          "AM",
+	pm:          "PM",
+	dates:       [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/y"},
+	times:       [4]string{"HH:mm:ss z", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} 'à' {0}",
+	dateTimeShort: "{1} {0}",
+}
+
+var esES = &Locale{
+	Tag:  "es-ES",
+	Name: "Spanish (Spain)",
+	months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+		"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+	shortMonths: [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	days:        [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	shortDays:   [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+	am:          "a. m.",
+	pm:          "p. m.",
+	dates:       [4]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d MMM y", "d/M/yy"},
+	times:       [4]string{"H:mm:ss z", "H:mm:ss z", "H:mm:ss", "H:mm"},
+
+	dateTimeLong:  "{1}, {0}",
+	dateTimeShort: "{1}, {0}",
+}
+
+var itIT = &Locale{
+	Tag:  "it-IT",
+	Name: "Italian (Italy)",
+	months: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno",
+		"luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+	shortMonths: [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+	days:        [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+	shortDays:   [7]string{"dom", "lun", "mar", "mer", "gio", "ven", "sab"},
+	am:          "AM",
+	pm:          "PM",
+	dates:       [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd/MM/yy"},
+	times:       [4]string{"HH:mm:ss z", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} 'alle ore' {0}",
+	dateTimeShort: "{1}, {0}",
+}
+
+var ptBR = &Locale{
+	Tag:  "pt-BR",
+	Name: "Portuguese (Brazil)",
+	months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+		"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+	shortMonths: [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+	days:        [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	shortDays:   [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+	am:          "AM",
+	pm:          "PM",
+	dates:       [4]string{"EEEE, d 'de' MMMM 'de' y", "d 'de' MMMM 'de' y", "d 'de' MMM 'de' y", "dd/MM/y"},
+	times:       [4]string{"HH:mm:ss z", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} 'às' {0}",
+	dateTimeShort: "{1}, {0}",
+}
+
+var nlNL = &Locale{
+	Tag:  "nl-NL",
+	Name: "Dutch (Netherlands)",
+	months: [12]string{"januari", "februari", "maart", "april", "mei", "juni",
+		"juli", "augustus", "september", "oktober", "november", "december"},
+	shortMonths: [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	days:        [7]string{"zondag", "maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag"},
+	shortDays:   [7]string{"zo", "ma", "di", "wo", "do", "vr", "za"},
+	am:          "a.m.",
+	pm:          "p.m.",
+	dates:       [4]string{"EEEE d MMMM y", "d MMMM y", "d MMM y", "dd-MM-y"},
+	times:       [4]string{"HH:mm:ss z", "HH:mm:ss z", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} 'om' {0}",
+	dateTimeShort: "{1} {0}",
+}
+
+var jaJP = &Locale{
+	Tag:  "ja-JP",
+	Name: "Japanese (Japan)",
+	months: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月"},
+	shortMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月"},
+	days:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	shortDays: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+	am:        "午前",
+	pm:        "午後",
+	dates:     [4]string{"y年M月d日EEEE", "y年M月d日", "y/MM/dd", "y/MM/dd"},
+	times:     [4]string{"H時mm分ss秒 z", "H:mm:ss z", "H:mm:ss", "H:mm"},
+
+	dateTimeLong:  "{1} {0}",
+	dateTimeShort: "{1} {0}",
+}
+
+var zhCN = &Locale{
+	Tag:  "zh-CN",
+	Name: "Chinese (China)",
+	months: [12]string{"一月", "二月", "三月", "四月", "五月", "六月",
+		"七月", "八月", "九月", "十月", "十一月", "十二月"},
+	shortMonths: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+		"7月", "8月", "9月", "10月", "11月", "12月"},
+	days:      [7]string{"星期日", "星期一", "星期二", "星期三", "星期四", "星期五", "星期六"},
+	shortDays: [7]string{"周日", "周一", "周二", "周三", "周四", "周五", "周六"},
+	am:        "上午",
+	pm:        "下午",
+	dates:     [4]string{"y年M月d日EEEE", "y年M月d日", "y年M月d日", "y/M/d"},
+	times:     [4]string{"z HH:mm:ss", "z HH:mm:ss", "HH:mm:ss", "HH:mm"},
+
+	dateTimeLong:  "{1} {0}",
+	dateTimeShort: "{1} {0}",
+}
+
+// locales lists the bundled locales
+var locales = []*Locale{enUS, enGB, deDE, frFR, esES, itIT, ptBR, nlNL, jaJP, zhCN}
+
+// languageDefaults maps a language subtag to the locale used for regions
+// that are not bundled
+var languageDefaults = map[string]*Locale{
+	"en": enUS,
+	"de": deDE,
+	"fr": frFR,
+	"es": esES,
+	"it": itIT,
+	"pt": ptBR,
+	"nl": nlNL,
+	"ja": jaJP,
+	"zh": zhCN,
+}
@@ -0,0 +1,166 @@
+package timefmt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// strftime formats t with a C strftime-style pattern. It supports the POSIX
+// conversions plus the common GNU extensions %k, %l, %P, %s, %:z and %N, and
+// %L and %f for milliseconds and microseconds. With a locale, names, AM/PM and
+// the %c, %x and %X representations follow it; otherwise they follow the
+// POSIX locale.
+func strftime(t time.Time, pattern string, l *Locale) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c != '%' {
+			b.WriteByte(c)
+			continue
+		}
+		i++
+		if i == len(pattern) {
+			return "", fmt.Errorf("%w: pattern ends with %%", ErrInvalidFormat)
+		}
+
+		// %:z is the only two-character conversion
+		if pattern[i] == ':' {
+			if i+1 < len(pattern) && pattern[i+1] == 'z' {
+				i++
+				b.WriteString(t.Format("-07:00"))
+				continue
+			}
+			return "", fmt.Errorf("%w: unknown conversion %%:", ErrInvalidFormat)
+		}
+
+		s, ok := l.conversion(t, pattern[i])
+		if !ok {
+			s, ok = conversion(t, pattern[i])
+		}
+		if !ok {
+			return "", fmt.Errorf("%w: unknown conversion %%%c", ErrInvalidFormat, pattern[i])
+		}
+		b.WriteString(s)
+	}
+	return b.String(), nil
+}
+
+// conversion returns the expansion of a strftime conversion character that
+// depends on the locale, or false if c does not or l is nil
+func (l *Locale) conversion(t time.Time, c byte) (string, bool) {
+	if l == nil {
+		return "", false
+	}
+	switch c {
+	case 'a':
+		return l.shortDays[t.Weekday()], true
+	case 'A':
+		return l.days[t.Weekday()], true
+	case 'b', 'h':
+		return l.shortMonths[t.Month()-1], true
+	case 'B':
+		return l.months[t.Month()-1], true
+	case 'c':
+		return l.pattern(t, l.dateTime(styleMedium)), true
+	case 'p':
+		return l.field(t, 'a', 1), true
+	case 'P':
+		return strings.ToLower(l.field(t, 'a', 1)), true
+	case 'x':
+		return l.pattern(t, l.dates[styleShort]), true
+	case 'X':
+		return l.pattern(t, l.times[styleMedium]), true
+	}
+	return "", false
+}
+
+// conversion returns the expansion of a single strftime conversion character
+// in the POSIX locale
+func conversion(t time.Time, c byte) (string, bool) {
+	switch c {
+	case 'a':
+		return t.Format("Mon"), true
+	case 'A':
+		return t.Weekday().String(), true
+	case 'b', 'h':
+		return t.Format("Jan"), true
+	case 'B':
+		return t.Month().String(), true
+	case 'c':
+		return t.Format(time.ANSIC), true
+	case 'C':
+		return fmt.Sprintf("%02d", t.Year()/100), true
+	case 'd':
+		return fmt.Sprintf("%02d", t.Day()), true
+	case 'D', 'x':
+		return t.Format("01/02/06"), true
+	case 'e':
+		return fmt.Sprintf("%2d", t.Day()), true
+	case 'f':
+		return fmt.Sprintf("%06d", t.Nanosecond()/1e3), true
+	case 'F':
+		return t.Format("2006-01-02"), true
+	case 'G':
+		year, _ := t.ISOWeek()
+		return fmt.Sprintf("%04d", year), true
+	case 'g':
+		year, _ := t.ISOWeek()
+		return fmt.Sprintf("%02d", year%100), true
+	case 'H':
+		return fmt.Sprintf("%02d", t.Hour()), true
+	case 'I':
+		return t.Format("03"), true
+	case 'j':
+		return fmt.Sprintf("%03d", t.YearDay()), true
+	case 'k':
+		return fmt.Sprintf("%2d", t.Hour()), true
+	case 'l':
+		return fmt.Sprintf("%2s", t.Format("3")), true
+	case 'L':
+		return fmt.Sprintf("%03d", t.Nanosecond()/1e6), true
+	case 'm':
+		return fmt.Sprintf("%02d", t.Month()), true
+	case 'M':
+		return fmt.Sprintf("%02d", t.Minute()), true
+	case 'n':
+		return "\n", true
+	case 'N':
+		return fmt.Sprintf("%09d", t.Nanosecond()), true
+	case 'p':
+		return t.Format("PM"), true
+	case 'P':
+		return t.Format("pm"), true
+	case 'r':
+		return t.Format("03:04:05 PM"), true
+	case 'R':
+		return t.Format("15:04"), true
+	case 's':
+		return strconv.FormatInt(t.Unix(), 10), true
+	case 'S':
+		return fmt.Sprintf("%02d", t.Second()), true
+	case 't':
+		return "\t", true
+	case 'T', 'X':
+		return t.Format("15:04:05"), true
+	case 'u':
+		return strconv.Itoa(isoWeekday(t)), true
+	case 'V':
+		_, week := t.ISOWeek()
+		return fmt.Sprintf("%02d", week), true
+	case 'w':
+		return strconv.Itoa(int(t.Weekday())), true
+	case 'y':
+		return fmt.Sprintf("%02d", t.Year()%100), true
+	case 'Y':
+		return fmt.Sprintf("%04d", t.Year()), true
+	case 'z':
+		return t.Format("-0700"), true
+	case 'Z':
+		return t.Format("MST"), true
+	case '%':
+		return "%", true
+	}
+	return "", false
+}
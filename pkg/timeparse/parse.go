@@ -0,0 +1,309 @@
+package timeparse
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+)
+
+// ErrUnrecognizedFormat is returned when a value does not match any supported format
+var ErrUnrecognizedFormat = errors.New("unrecognized time format")
+
+// Detected input formats
+const (
+	FormatNow          = "now"
+	FormatRFC3339      = "rfc3339"
+	FormatISO8601      = "iso8601"
+	FormatISO8601Basic = "iso8601_basic"
+	FormatRFC1123      = "rfc1123"
+	FormatRFC5322      = "rfc5322"
+	FormatRFC850       = "rfc850"
+	FormatRFC822       = "rfc822"
+	FormatANSIC        = "ansic"
+	FormatUnixDate     = "unixdate"
+	FormatRubyDate     = "rubydate"
+	FormatCLF          = "clf"
+	FormatSyslog       = "syslog"
+	FormatLog          = "log"
+	FormatUnixSeconds  = "unix_seconds"
+	FormatUnixMillis   = "unix_millis"
+	FormatUnixMicros   = "unix_micros"
+	FormatUnixNanos    = "unix_nanos"
+	FormatDateTime     = "datetime"
+	FormatDate         = "date"
+	FormatTime         = "time"
+)
+
+// layout pairs a Go layout with the name of the format it detects
+type layout struct {
+	layout string
+	format string
+}
+
+// Layouts that carry an explicit UTC offset or zone abbreviation
+var absoluteLayouts = []layout{
+	{time.RFC3339Nano, FormatRFC3339},
+	{time.RFC3339, FormatRFC3339},
+	{"2006-01-02T15:04:05.999999999-0700", FormatISO8601},
+	{"2006-01-02 15:04:05.999999999-0700", FormatISO8601},
+	{"2006-01-02 15:04:05.999999999Z07:00", FormatISO8601},
+	{"2006-01-02 15:04:05.999999999 -0700", FormatISO8601},
+	{"2006-01-02 15:04:05.999999999 MST", FormatISO8601},
+	{"20060102T150405Z0700", FormatISO8601Basic},
+	{time.RFC1123, FormatRFC1123},
+	{time.RFC1123Z, FormatRFC1123},
+	{"Mon, 2 Jan 2006 15:04:05 -0700", FormatRFC5322},
+	{"Mon, 2 Jan 2006 15:04:05 MST", FormatRFC5322},
+	{"2 Jan 2006 15:04:05 -0700", FormatRFC5322},
+	{time.RFC850, FormatRFC850},
+	{time.RFC822, FormatRFC822},
+	{time.RFC822Z, FormatRFC822},
+	{time.UnixDate, FormatUnixDate},
+	{time.RubyDate, FormatRubyDate},
+	// Apache and nginx access logs
+	{"02/Jan/2006:15:04:05 -0700", FormatCLF},
+}
+
+// Layouts that describe a wall-clock date and time without an offset
+var localLayouts = []layout{
+	{"2006-01-02T15:04:05.999999999", FormatDateTime},
+	{"2006-01-02T15:04", FormatDateTime},
+	{"2006-01-02 15:04:05.999999999", FormatDateTime},
+	{"2006-01-02 15:04", FormatDateTime},
+	{"20060102T150405", FormatISO8601Basic},
+	{"20060102T1504", FormatISO8601Basic},
+	{time.ANSIC, FormatANSIC},
+	// Go's log package and nginx error logs
+	{"2006/01/02 15:04:05.999999999", FormatLog},
+}
+
+// Layouts that describe a date only, read as midnight
+var dateLayouts = []layout{
+	{"2006-01-02", FormatDate},
+	{"Jan 2, 2006", FormatDate},
+	{"January 2, 2006", FormatDate},
+	{"2 Jan 2006", FormatDate},
+	{"2 January 2006", FormatDate},
+}
+
+// Layouts that describe a wall-clock time of day only
+var timeOfDayLayouts = []layout{
+	{"15:04:05.999999999", FormatTime},
+	{"15:04", FormatTime},
+	{"3:04:05PM", FormatTime},
+	{"3:04:05 PM", FormatTime},
+	{"3:04PM", FormatTime},
+	{"3:04 PM", FormatTime},
+	{"3PM", FormatTime},
+	{"3 PM", FormatTime},
+}
+
+// Layouts of syslog (RFC 3164) timestamps, which have no year
+var syslogLayouts = []layout{
+	{time.StampNano, FormatSyslog},
+	{time.StampMicro, FormatSyslog},
+	{time.StampMilli, FormatSyslog},
+	{time.Stamp, FormatSyslog},
+}
+
+// Result describes how a value was parsed
+type Result struct {
+	// Time is the parsed instant in the requested location
+	Time time.Time
+	// Format names the detected input format, one of the Format constants
+	Format string
+	// Local reports whether the value had no offset and was read on the wall
+	// clock of the requested location
+	Local bool
+	// Shifted reports whether the wall-clock time does not exist in the
+	// location and was moved forward by the length of the DST gap
+	Shifted bool
+	// Ambiguous reports whether the value could also mean the instants in
+	// Alternatives
+	Ambiguous    bool
+	Alternatives []Alternative
+}
+
+// Alternative is another reading of an ambiguous value
+type Alternative struct {
+	Time   time.Time
+	Format string
+	// Reason explains why the value can be read this way
+	Reason string
+}
+
+// Parse parses value as an instant. See Detect for the supported inputs.
+func Parse(value string, loc *time.Location, now time.Time) (time.Time, error) {
+	r, err := Detect(value, loc, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return r.Time, nil
+}
+
+// Detect parses value as an instant and reports which format it was in.
+//
+// Supported inputs are RFC 3339 and other ISO 8601 timestamps, RFC 1123,
+// RFC 5322, RFC 850 and RFC 822 dates, ANSI C and Unix date(1) output, Apache
+// access log and syslog timestamps, unix epoch values, wall-clock date/time
+// strings, dates and times of day. An empty value or "now" returns now.
+//
+// Unix epoch values are read as seconds, milliseconds, microseconds or
+// nanoseconds by magnitude. Values without an offset are read on the wall
+// clock of loc: dates at midnight, times of day on the current date and
+// syslog timestamps in the most recent year that does not put them in the
+// future.
+func Detect(value string, loc *time.Location, now time.Time) (Result, error) {
+	value = strings.TrimSpace(value)
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	if value == "" || strings.EqualFold(value, "now") {
+		return Result{Time: now.In(loc), Format: FormatNow}, nil
+	}
+
+	for _, l := range absoluteLayouts {
+		if t, err := time.ParseInLocation(l.layout, value, loc); err == nil {
+			return resolveAbbreviation(t, l.format, loc, value)
+		}
+	}
+
+	if r, ok := parseEpoch(value, loc); ok {
+		return r, nil
+	}
+
+	for _, l := range localLayouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			// Go accepts a comma before fractional seconds, which is how
+			// Python logging and log4j write them
+			if l.format == FormatDateTime && strings.Contains(value, ",") {
+				return resolveWall(t, FormatLog, loc), nil
+			}
+			return resolveWall(t, l.format, loc), nil
+		}
+	}
+
+	for _, l := range dateLayouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			return resolveWall(t, l.format, loc), nil
+		}
+	}
+
+	today := now.In(loc)
+	for _, l := range timeOfDayLayouts {
+		if t, err := time.Parse(l.layout, strings.ToUpper(value)); err == nil {
+			wall := time.Date(today.Year(), today.Month(), today.Day(),
+				t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+			return resolveWall(wall, l.format, loc), nil
+		}
+	}
+
+	for _, l := range syslogLayouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			wall := time.Date(today.Year(), t.Month(), t.Day(),
+				t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+			// A timestamp more than a day ahead is from last year, e.g. a
+			// December log line read in January
+			if wall.Sub(timecalc.Floating(today)) > 24*time.Hour {
+				wall = wall.AddDate(-1, 0, 0)
+			}
+			return resolveWall(wall, l.format, loc), nil
+		}
+	}
+
+	return Result{}, fmt.Errorf("%w: %q", ErrUnrecognizedFormat, value)
+}
+
+// Epoch magnitudes: values below each bound are read in the matching unit.
+// 1e11 seconds is the year 5138, and 1e11 milliseconds is March 1973, so
+// current timestamps in each unit fall well inside their band.
+const (
+	maxEpochSeconds = 1e11
+	maxEpochMillis  = 1e14
+	maxEpochMicros  = 1e17
+)
+
+// parseEpoch parses an integer or decimal unix epoch value, choosing the
+// unit by magnitude
+func parseEpoch(value string, loc *time.Location) (Result, bool) {
+	intPart, fracPart, hasFrac := strings.Cut(value, ".")
+	n, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || (hasFrac && !isDigits(fracPart)) {
+		return Result{}, false
+	}
+	negative := strings.HasPrefix(intPart, "-")
+
+	var t time.Time
+	var format string
+	abs := math.Abs(float64(n))
+	switch {
+	case abs < maxEpochSeconds:
+		t, format = scaleEpoch(n, negative, fracPart, time.Second), FormatUnixSeconds
+	case abs < maxEpochMillis:
+		t, format = scaleEpoch(n, negative, fracPart, time.Millisecond), FormatUnixMillis
+	case abs < maxEpochMicros:
+		t, format = scaleEpoch(n, negative, fracPart, time.Microsecond), FormatUnixMicros
+	default:
+		t, format = time.Unix(0, n), FormatUnixNanos
+	}
+	r := Result{Time: t.In(loc), Format: format}
+
+	// Eight and fourteen digit values may be compact dates rather than epochs
+	if !hasFrac {
+		for _, l := range []layout{{"20060102", FormatDate}, {"20060102150405", FormatISO8601Basic}} {
+			if len(intPart) != len(l.layout) {
+				continue
+			}
+			if wall, err := time.Parse(l.layout, intPart); err == nil {
+				alt := resolveWall(wall, l.format, loc)
+				r.Ambiguous = true
+				r.Alternatives = append(r.Alternatives, Alternative{
+					Time:   alt.Time,
+					Format: l.format,
+					Reason: "digits also form a compact " + l.format + " in " + loc.String(),
+				})
+			}
+		}
+	}
+	return r, true
+}
+
+// scaleEpoch returns the instant n units after the unix epoch, plus frac, the
+// digits after the decimal point, as a fraction of a unit. negative carries
+// the sign of values such as "-0.5".
+func scaleEpoch(n int64, negative bool, frac string, unit time.Duration) time.Time {
+	perSecond := int64(time.Second / unit)
+	nanos := (n % perSecond) * int64(unit)
+	if frac != "" {
+		// Only the digits that fit in the unit's nanoseconds are significant
+		digits := len(strconv.FormatInt(int64(unit), 10)) - 1
+		if len(frac) > digits {
+			frac = frac[:digits]
+		}
+		f, _ := strconv.ParseInt(frac+strings.Repeat("0", digits-len(frac)), 10, 64)
+		if negative {
+			f = -f
+		}
+		nanos += f
+	}
+	return time.Unix(n/perSecond, nanos)
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package timeparse
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	now := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"empty is now", "", now},
+		{"now keyword", "NOW", now},
+		{"rfc3339 utc", "2025-06-01T12:00:00Z", time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)},
+		{"rfc3339 with offset", "2025-06-01T12:00:00+09:00", time.Date(2025, 6, 1, 3, 0, 0, 0, time.UTC)},
+		{"rfc3339 nano", "2025-06-01T12:00:00.5Z", time.Date(2025, 6, 1, 12, 0, 0, 500000000, time.UTC)},
+		{"unix seconds", "1735689600", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"local datetime in summer", "2025-06-01T14:30:00", time.Date(2025, 6, 1, 12, 30, 0, 0, time.UTC)},
+		{"local datetime with space", "2025-01-15 14:30", time.Date(2025, 1, 15, 13, 30, 0, 0, time.UTC)},
+		{"date only", "2025-01-15", time.Date(2025, 1, 14, 23, 0, 0, 0, time.UTC)},
+		{"time of day", "14:30", time.Date(2025, 3, 10, 13, 30, 0, 0, time.UTC)},
+		{"time of day with seconds", "14:30:15", time.Date(2025, 3, 10, 13, 30, 15, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.input, berlin, now)
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if got.Location() != berlin {
+				t.Errorf("Parse(%q) location = %v, want %v", tt.input, got.Location(), berlin)
+			}
+		})
+	}
+}
+
+func TestParseNilLocation(t *testing.T) {
+	now := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	got, err := Parse("2025-01-15 14:30", nil, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2025, 1, 15, 14, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	inputs := []string{"yesterday", "2025-13-01", "25:00", "12/31/2025"}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			_, err := Parse(input, time.UTC, time.Now())
+			if !errors.Is(err, ErrUnrecognizedFormat) {
+				t.Errorf("Parse(%q) error = %v, want ErrUnrecognizedFormat", input, err)
+			}
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load timezone: %v", err)
+	}
+	now := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+	utc := func(s string) time.Time {
+		v, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			t.Fatalf("bad test time %q: %v", s, err)
+		}
+		return v
+	}
+
+	tests := []struct {
+		input     string
+		want      string
+		format    string
+		local     bool
+		ambiguous bool
+		shifted   bool
+	}{
+		{input: "2025-06-10T14:30:15.25-04:00", want: "2025-06-10T18:30:15.25Z", format: FormatRFC3339},
+		{input: "2025-06-10 14:30:15+0200", want: "2025-06-10T12:30:15Z", format: FormatISO8601},
+		{input: "20250610T143015Z", want: "2025-06-10T14:30:15Z", format: FormatISO8601Basic},
+		{input: "Tue, 10 Jun 2025 14:30:15 GMT", want: "2025-06-10T14:30:15Z", format: FormatRFC1123},
+		{input: "Tue, 10 Jun 2025 14:30:15 EDT", want: "2025-06-10T18:30:15Z", format: FormatRFC1123},
+		{input: "Tue, 3 Jun 2025 14:30:15 +0100", want: "2025-06-03T13:30:15Z", format: FormatRFC5322},
+		{input: "Tue Jun 10 14:30:15 PDT 2025", want: "2025-06-10T21:30:15Z", format: FormatUnixDate},
+		{input: "10/Jun/2025:14:30:15 -0700", want: "2025-06-10T21:30:15Z", format: FormatCLF},
+		{input: "1749580215", want: "2025-06-10T18:30:15Z", format: FormatUnixSeconds},
+		{input: "1749580215.5", want: "2025-06-10T18:30:15.5Z", format: FormatUnixSeconds},
+		{input: "1749580215123", want: "2025-06-10T18:30:15.123Z", format: FormatUnixMillis},
+		{input: "1749580215123456", want: "2025-06-10T18:30:15.123456Z", format: FormatUnixMicros},
+		{input: "1749580215123456789", want: "2025-06-10T18:30:15.123456789Z", format: FormatUnixNanos},
+		{input: "-0.5", want: "1969-12-31T23:59:59.5Z", format: FormatUnixSeconds},
+		{input: "20250610", want: "1970-08-23T09:10:10Z", format: FormatUnixSeconds, ambiguous: true},
+		{input: "Tue Jun 10 14:30:15 2025", want: "2025-06-10T18:30:15Z", format: FormatANSIC, local: true},
+		{input: "2025-06-10 14:30:15,123", want: "2025-06-10T18:30:15.123Z", format: FormatLog, local: true},
+		{input: "2025/06/10 14:30:15", want: "2025-06-10T18:30:15Z", format: FormatLog, local: true},
+		{input: "June 10, 2025", want: "2025-06-10T04:00:00Z", format: FormatDate, local: true},
+		{input: "2:30 pm", want: "2025-03-10T18:30:00Z", format: FormatTime, local: true},
+		{input: "Mar  9 23:59:59", want: "2025-03-10T03:59:59Z", format: FormatSyslog, local: true},
+		{input: "Dec 31 23:00:00", want: "2025-01-01T04:00:00Z", format: FormatSyslog, local: true},
+		// Clocks go back at 02:00 on 2 November and forward at 02:00 on 9 March
+		{input: "2025-11-02 01:30", want: "2025-11-02T05:30:00Z", format: FormatDateTime, local: true, ambiguous: true},
+		{input: "2025-03-09 02:30", want: "2025-03-09T07:30:00Z", format: FormatDateTime, local: true, shifted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			r, err := Detect(tt.input, ny, now)
+			if err != nil {
+				t.Fatalf("Detect(%q) unexpected error: %v", tt.input, err)
+			}
+			if !r.Time.Equal(utc(tt.want)) {
+				t.Errorf("Detect(%q) = %v, want %s", tt.input, r.Time.UTC(), tt.want)
+			}
+			if r.Format != tt.format || r.Local != tt.local || r.Ambiguous != tt.ambiguous || r.Shifted != tt.shifted {
+				t.Errorf("Detect(%q) = format %s local %v ambiguous %v shifted %v", tt.input, r.Format, r.Local, r.Ambiguous, r.Shifted)
+			}
+			if r.Ambiguous != (len(r.Alternatives) > 0) {
+				t.Errorf("Detect(%q) alternatives = %+v", tt.input, r.Alternatives)
+			}
+		})
+	}
+}
+
+func TestDetectAmbiguousAlternatives(t *testing.T) {
+	now := time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC)
+
+	r, err := Detect("20250610", time.UTC, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.Alternatives) != 1 || r.Alternatives[0].Format != FormatDate ||
+		!r.Alternatives[0].Time.Equal(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the compact date as an alternative, got %+v", r.Alternatives)
+	}
+
+	// CST is US Central time and China Standard Time
+	r, err = Detect("Tue, 10 Jun 2025 14:30:15 CST", time.UTC, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !r.Ambiguous || !r.Time.Equal(time.Date(2025, 6, 10, 20, 30, 15, 0, time.UTC)) {
+		t.Errorf("expected US Central time first and ambiguous, got %v %+v", r.Time, r.Alternatives)
+	}
+	found := false
+	for _, alt := range r.Alternatives {
+		if alt.Time.Equal(time.Date(2025, 6, 10, 6, 30, 15, 0, time.UTC)) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected China Standard Time as an alternative, got %+v", r.Alternatives)
+	}
+
+	if _, err := Detect("Tue, 10 Jun 2025 14:30:15 QQQ", time.UTC, now); !errors.Is(err, ErrUnrecognizedFormat) {
+		t.Errorf("expected an unknown abbreviation to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package timeparse
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timecalc"
+	"github.com/yourorg/timeservice/pkg/tzcatalog"
+)
+
+// resolveWall returns the instant at which loc's wall clock shows the date and
+// time fields of wall. A time that repeats as clocks go back resolves to its
+// first occurrence and is ambiguous; a time skipped as clocks go forward is
+// moved forward by the gap.
+func resolveWall(wall time.Time, format string, loc *time.Location) Result {
+	r := Result{Format: format, Local: true}
+
+	t, res := timecalc.ResolveWall(wall, loc)
+	r.Time = t
+	switch res {
+	case timecalc.WallSkipped:
+		r.Shifted = true
+	case timecalc.WallRepeated:
+		r.Ambiguous = true
+		for _, alt := range timecalc.InstantsAt(wall, loc)[1:] {
+			r.Alternatives = append(r.Alternatives, Alternative{
+				Time:   alt,
+				Format: format,
+				Reason: "local time repeats as clocks go back in " + loc.String(),
+			})
+		}
+	}
+	return r
+}
+
+// universalAbbreviations are the abbreviations Go parses as UTC in any location
+var universalAbbreviations = map[string]bool{"UTC": true, "GMT": true, "UT": true, "Z": true}
+
+// resolveAbbreviation finishes a timestamp parsed in loc. When the timestamp
+// names a zone abbreviation that loc does not use, Go reads it as UTC, so the
+// offset is looked up in the zones that use the abbreviation around that
+// time instead. An abbreviation shared by zones with different offsets, such
+// as IST or CST, resolves to the most common reading and is ambiguous.
+func resolveAbbreviation(t time.Time, format string, loc *time.Location, value string) (Result, error) {
+	abbr, offset := t.Zone()
+	if offset != 0 || t.Location() == loc || t.Location().String() != abbr || universalAbbreviations[abbr] {
+		return Result{Time: t.In(loc), Format: format}, nil
+	}
+
+	// For each offset, keep the zone that best represents it: the most
+	// preferred reading of the abbreviation, or else the first match
+	query := tzcatalog.Query{Abbreviation: strings.ToUpper(abbr)}
+	type reading struct {
+		offset     int
+		zone       string
+		preference int
+		zones      int
+	}
+	var readings []*reading
+	byOffset := make(map[int]*reading)
+	for _, m := range tzcatalog.Find(query, t) {
+		if m.Status == tzcatalog.StatusHistorical {
+			continue
+		}
+		rd, ok := byOffset[m.Offset]
+		if !ok {
+			rd = &reading{offset: m.Offset, zone: m.Zone}
+			byOffset[m.Offset] = rd
+			readings = append(readings, rd)
+		}
+		rd.zones++
+		if p := tzcatalog.Preference(query, m.Zone); p > 0 && (rd.preference == 0 || p < rd.preference) {
+			rd.preference, rd.zone = p, m.Zone
+		}
+	}
+	if len(readings) == 0 {
+		return Result{}, fmt.Errorf("%w: unknown zone abbreviation %q in %q", ErrUnrecognizedFormat, abbr, value)
+	}
+	sort.SliceStable(readings, func(i, j int) bool {
+		a, b := readings[i], readings[j]
+		if (a.preference > 0) != (b.preference > 0) {
+			return a.preference > 0
+		}
+		if a.preference != b.preference {
+			return a.preference < b.preference
+		}
+		return a.zones > b.zones
+	})
+
+	at := func(offset int) time.Time {
+		return time.Unix(t.Unix()-int64(offset), int64(t.Nanosecond())).In(loc)
+	}
+	r := Result{Time: at(readings[0].offset), Format: format}
+	for _, rd := range readings[1:] {
+		r.Ambiguous = true
+		r.Alternatives = append(r.Alternatives, Alternative{
+			Time:   at(rd.offset),
+			Format: format,
+			Reason: fmt.Sprintf("%s is also UTC%s in %s", abbr, time.Unix(0, 0).In(time.FixedZone("", rd.offset)).Format("-07:00"), rd.zone),
+		})
+	}
+	return r, nil
+}
@@ -0,0 +1,238 @@
+package timescale
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// bundled is the IERS leap-seconds.list shipped with the service
+//
+//go:embed leap-seconds.list
+var bundled []byte
+
+// ntpEpoch is the origin of the NTP timestamps in leap-seconds.list
+var ntpEpoch = time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Leap second table errors
+var (
+	ErrInvalidTable = errors.New("invalid leap second table")
+	ErrHashMismatch = errors.New("leap second table hash does not match its contents")
+)
+
+// Leap is an entry of a leap second table: from Start, a UTC midnight, TAI is
+// Offset seconds ahead of UTC. Apart from the first entry, which starts the
+// table in 1972, each entry follows a leap second inserted as 23:59:60 UTC
+// on the day before Start.
+type Leap struct {
+	Start  time.Time
+	Offset int
+}
+
+// Table is a leap second table. It is complete up to Expires; later leap
+// seconds may be announced after it was published.
+type Table struct {
+	Leaps   []Leap
+	Updated time.Time
+	Expires time.Time
+	// Source is the file the table was read from, or "bundled"
+	Source string
+}
+
+// defaultTable holds the table used by Default
+var defaultTable atomic.Pointer[Table]
+
+func init() {
+	t, err := Parse(bytes.NewReader(bundled), "bundled")
+	if err != nil {
+		panic("timescale: bundled leap-seconds.list: " + err.Error())
+	}
+	defaultTable.Store(t)
+}
+
+// Default returns the table used for conversions, the bundled one unless
+// SetDefault replaced it
+func Default() *Table {
+	return defaultTable.Load()
+}
+
+// SetDefault replaces the table returned by Default
+func SetDefault(t *Table) {
+	defaultTable.Store(t)
+}
+
+// Load reads a leap second table from a file in the leap-seconds.list format
+func Load(path string) (*Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f, path)
+}
+
+// Parse reads a leap second table in the leap-seconds.list format published
+// by the IERS and in the tz database. Data lines hold an NTP timestamp and
+// TAI−UTC; the "#$" and "#@" lines hold the NTP times the file was updated and
+// expires. A "#h" SHA-1 hash line, when present, must match the contents.
+func Parse(r io.Reader, source string) (*Table, error) {
+	t := &Table{Source: source}
+	var hashed strings.Builder
+	var hash []string
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		var fields []string
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#$"), strings.HasPrefix(line, "#@"):
+			fields = strings.Fields(line[2:])
+			if len(fields) != 1 {
+				return nil, fmt.Errorf("%w: line %d: expected one timestamp", ErrInvalidTable, n)
+			}
+			at, err := parseNTP(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidTable, n, err)
+			}
+			if line[1] == '$' {
+				t.Updated = at
+			} else {
+				t.Expires = at
+			}
+			hashed.WriteString(fields[0])
+		case strings.HasPrefix(line, "#h"):
+			hash = strings.Fields(line[2:])
+		case strings.HasPrefix(line, "#"):
+			continue
+		default:
+			// Drop the trailing comment with the date
+			data, _, _ := strings.Cut(line, "#")
+			fields = strings.Fields(data)
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("%w: line %d: expected a timestamp and an offset", ErrInvalidTable, n)
+			}
+			start, err := parseNTP(fields[0])
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidTable, n, err)
+			}
+			offset, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("%w: line %d: invalid offset %q", ErrInvalidTable, n, fields[1])
+			}
+			t.Leaps = append(t.Leaps, Leap{Start: start, Offset: offset})
+			hashed.WriteString(fields[0])
+			hashed.WriteString(fields[1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := t.validate(); err != nil {
+		return nil, err
+	}
+	if hash != nil && !hashMatches(hash, hashed.String()) {
+		return nil, ErrHashMismatch
+	}
+	return t, nil
+}
+
+// Newer reports whether t is known to be complete for longer than other
+func (t *Table) Newer(other *Table) bool {
+	return t.Expires.After(other.Expires)
+}
+
+// Expired reports whether leap seconds may have been announced after the
+// table, so it is not known to be complete at now
+func (t *Table) Expired(now time.Time) bool {
+	return !now.Before(t.Expires)
+}
+
+// Offset returns TAI−UTC in seconds at a UTC instant
+func (t *Table) Offset(utc time.Time) (int, error) {
+	i := t.index(utc)
+	if i < 0 {
+		return 0, ErrBeforeTable
+	}
+	return t.Leaps[i].Offset, nil
+}
+
+// Next returns the first entry starting after a UTC instant, that is the next
+// leap second the table lists, if any
+func (t *Table) Next(utc time.Time) (Leap, bool) {
+	i := t.index(utc) + 1
+	if i >= len(t.Leaps) {
+		return Leap{}, false
+	}
+	return t.Leaps[i], true
+}
+
+// index returns the index of the entry in force at a UTC instant, or -1
+// before the table starts
+func (t *Table) index(utc time.Time) int {
+	return sort.Search(len(t.Leaps), func(i int) bool { return t.Leaps[i].Start.After(utc) }) - 1
+}
+
+// validate checks that a parsed table is usable
+func (t *Table) validate() error {
+	if len(t.Leaps) == 0 {
+		return fmt.Errorf("%w: no leap seconds", ErrInvalidTable)
+	}
+	if t.Expires.IsZero() {
+		return fmt.Errorf("%w: missing expiration (#@) line", ErrInvalidTable)
+	}
+	for i, l := range t.Leaps {
+		if !l.Start.Equal(l.Start.Truncate(24 * time.Hour)) {
+			return fmt.Errorf("%w: %s is not a UTC midnight", ErrInvalidTable, l.Start.Format(time.RFC3339))
+		}
+		if i == 0 {
+			continue
+		}
+		prev := t.Leaps[i-1]
+		if !l.Start.After(prev.Start) {
+			return fmt.Errorf("%w: entries are not in order at %s", ErrInvalidTable, l.Start.Format(time.DateOnly))
+		}
+		if d := l.Offset - prev.Offset; d != 1 && d != -1 {
+			return fmt.Errorf("%w: offset changes by %d seconds at %s", ErrInvalidTable, d, l.Start.Format(time.DateOnly))
+		}
+	}
+	return nil
+}
+
+// parseNTP parses an NTP timestamp, seconds since 1900
+func parseNTP(s string) (time.Time, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, fmt.Errorf("invalid NTP timestamp %q", s)
+	}
+	return ntpEpoch.Add(time.Duration(n) * time.Second), nil
+}
+
+// hashMatches reports whether the five hexadecimal words of a "#h" line are
+// the SHA-1 hash of data. Leading zeros may be left out of each word.
+func hashMatches(words []string, data string) bool {
+	if len(words) != 5 {
+		return false
+	}
+	sum := sha1.Sum([]byte(data))
+	for i, w := range words {
+		v, err := strconv.ParseUint(w, 16, 32)
+		if err != nil || uint32(v) != binary.BigEndian.Uint32(sum[4*i:]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,302 @@
+// Package timescale converts between the UTC, TAI, GPS, TT and Unix time
+// scales using a leap second table.
+//
+// TAI is atomic time and runs without leap seconds. GPS time is TAI less 19
+// seconds and TT is TAI plus 32.184 seconds. UTC follows TAI less a whole
+// number of seconds that grows by one at each leap second, which is inserted
+// as 23:59:60. Unix time counts every day as 86400 seconds since 1970, so it
+// repeats a second when a leap second is inserted.
+//
+// A reading on a scale is held in a time.Time in UTC whose date and clock
+// fields are the reading itself, not an instant: the TAI reading
+// 2017-01-01T00:00:37 is time.Date(2017, 1, 1, 0, 0, 37, 0, time.UTC).
+// Conversions are defined from 1972-01-01, when the leap second table starts.
+package timescale
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yourorg/timeservice/pkg/timeparse"
+)
+
+// Scale is a time scale
+type Scale string
+
+// Supported time scales
+const (
+	UTC  Scale = "utc"
+	TAI  Scale = "tai"
+	GPS  Scale = "gps"
+	TT   Scale = "tt"
+	Unix Scale = "unix"
+)
+
+// Offsets of GPS time and TT from TAI
+const (
+	GPSMinusTAI = -19 * time.Second
+	TTMinusTAI  = 32184 * time.Millisecond
+)
+
+// GPSEpoch is the start of GPS week 0, a GPS reading
+var GPSEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+
+// Week is the length of a GPS week
+const Week = 7 * 24 * time.Hour
+
+// Conversion errors
+var (
+	ErrUnknownScale  = errors.New("unknown time scale")
+	ErrBeforeTable   = errors.New("time scales are only converted from 1972-01-01, when the leap second table starts")
+	ErrNotLeapSecond = errors.New("no leap second was inserted at that time")
+	ErrInvalidValue  = errors.New("invalid time scale reading")
+)
+
+// Scales returns the supported time scales
+func Scales() []Scale {
+	return []Scale{UTC, TAI, GPS, TT, Unix}
+}
+
+// ParseScale parses a time scale name, ignoring case
+func ParseScale(s string) (Scale, error) {
+	scale := Scale(strings.ToLower(strings.TrimSpace(s)))
+	for _, known := range Scales() {
+		if scale == known {
+			return scale, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownScale, s)
+}
+
+// Reading is a clock reading on a time scale
+type Reading struct {
+	Scale Scale
+	// Time holds the reading in its date and clock fields, in UTC. For Unix
+	// it is the instant the Unix time counts to.
+	Time time.Time
+	// Leap marks a UTC reading during an inserted leap second, whose Time
+	// holds second 59 and which reads as second 60. For Unix it marks a value
+	// that repeats because the second before it was a leap second.
+	Leap bool
+}
+
+// String formats a reading: Unix time as decimal seconds, the others as
+// YYYY-MM-DDTHH:MM:SS with a fraction when there is one and, for UTC, a Z
+func (r Reading) String() string {
+	if r.Scale == Unix {
+		return formatSeconds(r.Time.Sub(time.Unix(0, 0).UTC()))
+	}
+	s := r.Time.UTC().Format("2006-01-02T15:04:05.999999999")
+	if r.Leap {
+		s = s[:17] + "60" + s[19:]
+	}
+	if r.Scale == UTC {
+		s += "Z"
+	}
+	return s
+}
+
+// Seconds returns the seconds since the epoch of Unix and GPS readings
+func (r Reading) Seconds() (float64, bool) {
+	switch r.Scale {
+	case Unix:
+		return r.Time.Sub(time.Unix(0, 0).UTC()).Seconds(), true
+	case GPS:
+		return r.Time.Sub(GPSEpoch).Seconds(), true
+	}
+	return 0, false
+}
+
+// GPSWeek returns the GPS week number and seconds into the week of a GPS
+// reading
+func (r Reading) GPSWeek() (int, float64) {
+	d := r.Time.Sub(GPSEpoch)
+	week := d / Week
+	if d%Week < 0 {
+		week--
+	}
+	return int(week), (d - week*Week).Seconds()
+}
+
+// FromGPSWeek returns the GPS reading a number of seconds into a GPS week
+func FromGPSWeek(week int, seconds float64) Reading {
+	d := time.Duration(week)*Week + time.Duration(seconds*float64(time.Second))
+	return Reading{Scale: GPS, Time: GPSEpoch.Add(d)}
+}
+
+// ParseReading parses a reading on a scale. UTC and Unix readings take any
+// format timeparse detects, read in UTC, and UTC readings may use second 60
+// during a leap second. GPS readings may be decimal seconds since the GPS
+// epoch. TAI, GPS and TT readings otherwise take a date and optional clock
+// time with no zone, such as 2017-01-01T00:00:37.5.
+func ParseReading(scale Scale, value string, now time.Time) (Reading, error) {
+	value = strings.TrimSpace(value)
+	switch scale {
+	case UTC, Unix:
+		leap := false
+		if m := leapNotation.FindStringSubmatchIndex(value); m != nil {
+			value = value[:m[2]] + "59" + value[m[3]:]
+			leap = true
+		}
+		t, err := timeparse.Parse(value, time.UTC, now)
+		if err != nil {
+			return Reading{}, err
+		}
+		t = t.UTC()
+		if leap && (t.Hour() != 23 || t.Minute() != 59 || t.Second() != 59) {
+			return Reading{}, fmt.Errorf("%w: leap seconds are inserted at 23:59:60 UTC", ErrNotLeapSecond)
+		}
+		if leap && scale == Unix {
+			// Unix time repeats the following midnight for the leap second
+			return Reading{Scale: Unix, Time: t.Truncate(time.Second).Add(time.Second), Leap: true}, nil
+		}
+		return Reading{Scale: scale, Time: t, Leap: leap}, nil
+	case TAI, GPS, TT:
+		if scale == GPS {
+			if d, ok := parseSeconds(value); ok {
+				return Reading{Scale: GPS, Time: GPSEpoch.Add(d)}, nil
+			}
+		}
+		for _, layout := range readingLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return Reading{Scale: scale, Time: t}, nil
+			}
+		}
+		return Reading{}, fmt.Errorf("%w: %q is not a date and time such as 2017-01-01T00:00:37", ErrInvalidValue, value)
+	}
+	return Reading{}, fmt.Errorf("%w: %q", ErrUnknownScale, scale)
+}
+
+// leapNotation matches the seconds of a clock time reading 60
+var leapNotation = regexp.MustCompile(`[T ]\d{2}:\d{2}:(60)(?:\D|$)`)
+
+// readingLayouts are the layouts of TAI, GPS and TT readings
+var readingLayouts = []string{
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+// ToTAI converts a reading to TAI using the table
+func (t *Table) ToTAI(r Reading) (time.Time, error) {
+	tai := r.Time.UTC()
+	switch r.Scale {
+	case TAI:
+	case GPS:
+		tai = tai.Add(-GPSMinusTAI)
+	case TT:
+		tai = tai.Add(-TTMinusTAI)
+	case UTC, Unix:
+		// A repeated Unix value is taken as the later second, after the leap
+		utc := r.Time.UTC()
+		offset, err := t.Offset(utc)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if r.Scale == UTC && r.Leap {
+			next, ok := t.Next(utc)
+			if !ok || next.Offset != offset+1 || !next.Start.Equal(utc.Truncate(time.Second).Add(time.Second)) {
+				return time.Time{}, fmt.Errorf("%w: %s", ErrNotLeapSecond, r)
+			}
+			utc = utc.Add(time.Second)
+		}
+		tai = utc.Add(time.Duration(offset) * time.Second)
+	default:
+		return time.Time{}, fmt.Errorf("%w: %q", ErrUnknownScale, r.Scale)
+	}
+	if tai.Before(t.Leaps[0].Start.Add(time.Duration(t.Leaps[0].Offset) * time.Second)) {
+		return time.Time{}, ErrBeforeTable
+	}
+	return tai, nil
+}
+
+// FromTAI converts a TAI reading to a scale using the table
+func (t *Table) FromTAI(scale Scale, tai time.Time) (Reading, error) {
+	tai = tai.UTC()
+	first := t.Leaps[0]
+	if tai.Before(first.Start.Add(time.Duration(first.Offset) * time.Second)) {
+		return Reading{}, ErrBeforeTable
+	}
+	switch scale {
+	case TAI:
+		return Reading{Scale: TAI, Time: tai}, nil
+	case GPS:
+		return Reading{Scale: GPS, Time: tai.Add(GPSMinusTAI)}, nil
+	case TT:
+		return Reading{Scale: TT, Time: tai.Add(TTMinusTAI)}, nil
+	case UTC, Unix:
+		// The entry in force is the last whose start, read on TAI, has passed
+		i := sort.Search(len(t.Leaps), func(i int) bool {
+			l := t.Leaps[i]
+			return l.Start.Add(time.Duration(l.Offset) * time.Second).After(tai)
+		}) - 1
+		utc := tai.Add(-time.Duration(t.Leaps[i].Offset) * time.Second)
+		leap := i+1 < len(t.Leaps) && !utc.Before(t.Leaps[i+1].Start)
+		if scale == Unix {
+			return Reading{Scale: Unix, Time: utc, Leap: leap}, nil
+		}
+		if leap {
+			utc = utc.Add(-time.Second)
+		}
+		return Reading{Scale: UTC, Time: utc, Leap: leap}, nil
+	}
+	return Reading{}, fmt.Errorf("%w: %q", ErrUnknownScale, scale)
+}
+
+// Convert converts a reading to another scale using the table
+func (t *Table) Convert(r Reading, to Scale) (Reading, error) {
+	tai, err := t.ToTAI(r)
+	if err != nil {
+		return Reading{}, err
+	}
+	return t.FromTAI(to, tai)
+}
+
+// parseSeconds parses a decimal number of seconds, keeping nanosecond
+// precision
+func parseSeconds(s string) (time.Duration, bool) {
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	n, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil || n > maxSeconds || n < -maxSeconds {
+		return 0, false
+	}
+	d := time.Duration(n) * time.Second
+	if hasFrac {
+		if fracPart == "" || strings.Trim(fracPart, "0123456789") != "" {
+			return 0, false
+		}
+		if len(fracPart) > 9 {
+			fracPart = fracPart[:9]
+		}
+		f, _ := strconv.ParseInt(fracPart+strings.Repeat("0", 9-len(fracPart)), 10, 64)
+		if strings.HasPrefix(intPart, "-") {
+			f = -f
+		}
+		d += time.Duration(f)
+	}
+	return d, true
+}
+
+// maxSeconds keeps parsed seconds within a time.Duration
+const maxSeconds = math.MaxInt64 / int64(time.Second)
+
+// formatSeconds formats a duration as decimal seconds without trailing zeros
+func formatSeconds(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	s := sign + strconv.FormatInt(int64(d/time.Second), 10)
+	if frac := int64(d % time.Second); frac != 0 {
+		s += "." + strings.TrimRight(fmt.Sprintf("%09d", frac), "0")
+	}
+	return s
+}
@@ -0,0 +1,223 @@
+package timescale
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBundled(t *testing.T) {
+	table := Default()
+	if table.Source != "bundled" || len(table.Leaps) != 28 {
+		t.Fatalf("unexpected bundled table: source %q, %d entries", table.Source, len(table.Leaps))
+	}
+	first, last := table.Leaps[0], table.Leaps[len(table.Leaps)-1]
+	if !first.Start.Equal(time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC)) || first.Offset != 10 {
+		t.Errorf("unexpected first entry: %+v", first)
+	}
+	if !last.Start.Equal(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)) || last.Offset != 37 {
+		t.Errorf("unexpected last entry: %+v", last)
+	}
+	if !table.Expires.Equal(time.Date(2026, 6, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected expiry: %v", table.Expires)
+	}
+}
+
+func TestParseHash(t *testing.T) {
+	// Extending the expiry without updating the hash
+	tampered := strings.Replace(string(bundled), "#@\t3991593600", "#@\t4022697600", 1)
+	if tampered == string(bundled) {
+		t.Fatal("failed to tamper with the bundled table")
+	}
+	if _, err := Parse(strings.NewReader(tampered), "test"); !errors.Is(err, ErrHashMismatch) {
+		t.Errorf("Parse() error = %v, want %v", err, ErrHashMismatch)
+	}
+
+	// A table without a hash line is accepted
+	var unhashed bytes.Buffer
+	for _, line := range strings.Split(string(bundled), "\n") {
+		if !strings.HasPrefix(line, "#h") {
+			unhashed.WriteString(line + "\n")
+		}
+	}
+	if _, err := Parse(&unhashed, "test"); err != nil {
+		t.Errorf("Parse() without hash: %v", err)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"no expiry", "2272060800\t10\n"},
+		{"not midnight", "#@\t3991593600\n2272060801\t10\n"},
+		{"jump", "#@\t3991593600\n2272060800\t10\n2287785600\t12\n"},
+		{"out of order", "#@\t3991593600\n2287785600\t11\n2272060800\t10\n"},
+		{"bad offset", "#@\t3991593600\n2272060800\tten\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.input), "test"); !errors.Is(err, ErrInvalidTable) {
+				t.Errorf("Parse() error = %v, want %v", err, ErrInvalidTable)
+			}
+		})
+	}
+}
+
+func TestOffsetAndNext(t *testing.T) {
+	table := Default()
+	tests := []struct {
+		utc    time.Time
+		offset int
+	}{
+		{time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC), 10},
+		{time.Date(1972, 7, 1, 0, 0, 0, 0, time.UTC), 11},
+		{time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), 36},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+		{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+	}
+	for _, tt := range tests {
+		if got, err := table.Offset(tt.utc); err != nil || got != tt.offset {
+			t.Errorf("Offset(%v) = %d, %v, want %d", tt.utc, got, err, tt.offset)
+		}
+	}
+
+	if _, err := table.Offset(time.Date(1971, 12, 31, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrBeforeTable) {
+		t.Errorf("Offset() before the table error = %v, want %v", err, ErrBeforeTable)
+	}
+
+	next, ok := table.Next(time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC))
+	if !ok || !next.Start.Equal(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Next() = %+v, %v", next, ok)
+	}
+	if _, ok := table.Next(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)); ok {
+		t.Error("Next() after the last leap second should find none")
+	}
+}
+
+func TestConvert(t *testing.T) {
+	table := Default()
+	utc := func(value string) Reading {
+		r, err := ParseReading(UTC, value, time.Now())
+		if err != nil {
+			t.Fatalf("ParseReading(%q): %v", value, err)
+		}
+		return r
+	}
+
+	tests := []struct {
+		name string
+		from Reading
+		to   Scale
+		want string
+	}{
+		{"utc to tai", utc("2025-01-01T00:00:00Z"), TAI, "2025-01-01T00:00:37"},
+		{"utc to gps", utc("2025-01-01T00:00:00Z"), GPS, "2025-01-01T00:00:18"},
+		{"utc to tt", utc("2025-01-01T00:00:00Z"), TT, "2025-01-01T00:01:09.184"},
+		{"utc to unix", utc("2025-01-01T00:00:00Z"), Unix, "1735689600"},
+		{"leap second to tai", utc("2016-12-31T23:59:60Z"), TAI, "2017-01-01T00:00:36"},
+		{"leap second in a zone", utc("2017-01-01T08:59:60.5+09:00"), TAI, "2017-01-01T00:00:36.5"},
+		{"leap second to unix", utc("2016-12-31T23:59:60.5Z"), Unix, "1483228800.5"},
+		{"second before the leap", utc("2016-12-31T23:59:59Z"), TAI, "2017-01-01T00:00:35"},
+		{"tai in the leap second", Reading{Scale: TAI, Time: time.Date(2017, 1, 1, 0, 0, 36, 250e6, time.UTC)}, UTC, "2016-12-31T23:59:60.25Z"},
+		{"tai after the leap second", Reading{Scale: TAI, Time: time.Date(2017, 1, 1, 0, 0, 37, 0, time.UTC)}, UTC, "2017-01-01T00:00:00Z"},
+		{"gps epoch", Reading{Scale: GPS, Time: GPSEpoch}, UTC, "1980-01-06T00:00:00Z"},
+		{"table start", utc("1972-01-01T00:00:00Z"), TAI, "1972-01-01T00:00:10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := table.Convert(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Convert() error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Convert() = %s, want %s", got, tt.want)
+			}
+			back, err := table.Convert(got, tt.from.Scale)
+			if err != nil {
+				t.Fatalf("Convert() back error: %v", err)
+			}
+			if tt.to != Unix && back != tt.from {
+				t.Errorf("round trip = %+v, want %+v", back, tt.from)
+			}
+		})
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	table := Default()
+	tests := []struct {
+		name string
+		from Reading
+		want error
+	}{
+		{"before the table", Reading{Scale: UTC, Time: time.Date(1971, 6, 1, 0, 0, 0, 0, time.UTC)}, ErrBeforeTable},
+		{"tai before the table", Reading{Scale: TAI, Time: time.Date(1972, 1, 1, 0, 0, 9, 0, time.UTC)}, ErrBeforeTable},
+		{"no leap second that day", Reading{Scale: UTC, Time: time.Date(2015, 12, 31, 23, 59, 59, 0, time.UTC), Leap: true}, ErrNotLeapSecond},
+		{"unknown scale", Reading{Scale: "tcg", Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}, ErrUnknownScale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := table.Convert(tt.from, TAI); !errors.Is(err, tt.want) {
+				t.Errorf("Convert() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseReading(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		scale Scale
+		value string
+		want  string
+		err   error
+	}{
+		{UTC, "2016-12-31 23:59:60", "2016-12-31T23:59:60Z", nil},
+		{UTC, "now", "2025-06-01T12:00:00Z", nil},
+		{UTC, "2016-12-31T12:00:60Z", "", ErrNotLeapSecond},
+		{Unix, "1735689600.25", "1735689600.25", nil},
+		{TAI, "2017-01-01T00:00:37.5", "2017-01-01T00:00:37.5", nil},
+		{TT, "2017-01-01", "2017-01-01T00:00:00", nil},
+		{GPS, "1419724818.5", "2025-01-01T00:00:18.5", nil},
+		{GPS, "2025-01-01 00:00:18", "2025-01-01T00:00:18", nil},
+		{TAI, "2017-01-01T00:00:37Z", "", ErrInvalidValue},
+		{"tcb", "2017-01-01", "", ErrUnknownScale},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.scale)+" "+tt.value, func(t *testing.T) {
+			got, err := ParseReading(tt.scale, tt.value, now)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("ParseReading() error = %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseReading() error: %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseReading() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGPSWeek(t *testing.T) {
+	r := Reading{Scale: GPS, Time: time.Date(2025, 1, 1, 0, 0, 18, 0, time.UTC)}
+	week, sow := r.GPSWeek()
+	if week != 2347 || sow != 259218 {
+		t.Errorf("GPSWeek() = %d, %v, want 2347, 259218", week, sow)
+	}
+	if back := FromGPSWeek(week, sow); back != r {
+		t.Errorf("FromGPSWeek() = %+v, want %+v", back, r)
+	}
+}
@@ -0,0 +1,81 @@
+// Package tzcatalog lists the IANA timezone names known to the service.
+//
+// The catalog is generated from the tz database source and the zone data is
+// embedded through time/tzdata, so lookups behave the same on hosts without
+// a system zoneinfo directory (such as distroless containers).
+package tzcatalog
+
+//go:generate go run gen.go
+
+import (
+	"sort"
+	"strings"
+
+	// Embed the tz database so time.LoadLocation works without system zoneinfo
+	_ "time/tzdata"
+)
+
+// Entry describes a timezone name in the catalog
+type Entry struct {
+	// Name is the IANA timezone name
+	Name string
+	// Link is the canonical zone this name is an alias for, empty for canonical zones
+	Link string
+}
+
+// Canonical reports whether the entry is a zone rather than a link to one
+func (e Entry) Canonical() bool {
+	return e.Link == ""
+}
+
+// entries holds the catalog sorted by name
+var entries = buildEntries()
+
+// All returns every timezone in the catalog, sorted by name
+func All() []Entry {
+	out := make([]Entry, len(entries))
+	copy(out, entries)
+	return out
+}
+
+// Lookup returns the catalog entry for name. The match is case-sensitive,
+// as IANA names are.
+func Lookup(name string) (Entry, bool) {
+	link, ok := links[name]
+	if !ok {
+		return Entry{}, false
+	}
+	return Entry{Name: name, Link: link}, true
+}
+
+// Version returns the tz database release the catalog was generated from
+func Version() string {
+	return tzdataVersion
+}
+
+// Search returns the entries whose name starts with prefix and contains query.
+// Both comparisons ignore case, and spaces in query match underscores, so
+// "new york" finds America/New_York.
+func Search(query, prefix string) []Entry {
+	query = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(query)), " ", "_")
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+
+	var out []Entry
+	for _, e := range entries {
+		name := strings.ToLower(e.Name)
+		if strings.HasPrefix(name, prefix) && strings.Contains(name, query) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// buildEntries converts the generated link table into a sorted slice
+func buildEntries() []Entry {
+	out := make([]Entry, 0, len(links))
+	for name, link := range links {
+		out = append(out, Entry{Name: name, Link: link})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,99 @@
+package tzcatalog
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestCatalogLoads(t *testing.T) {
+	all := All()
+	if len(all) < 400 {
+		t.Fatalf("expected at least 400 timezones, got %d", len(all))
+	}
+	if !sort.SliceIsSorted(all, func(i, j int) bool { return all[i].Name < all[j].Name }) {
+		t.Error("expected entries sorted by name")
+	}
+
+	for _, e := range all {
+		if _, err := time.LoadLocation(e.Name); err != nil {
+			t.Errorf("LoadLocation(%q) failed: %v", e.Name, err)
+		}
+		if !e.Canonical() {
+			if target, ok := Lookup(e.Link); !ok || !target.Canonical() {
+				t.Errorf("%s links to %q, which is not a canonical zone", e.Name, e.Link)
+			}
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name      string
+		wantOK    bool
+		canonical bool
+		link      string
+	}{
+		{"America/New_York", true, true, ""},
+		{"UTC", true, false, "Etc/UTC"},
+		{"Australia/ACT", true, false, "Australia/Sydney"},
+		{"Europe/Oslo", true, false, "Europe/Berlin"},
+		{"Asia/Kuwait", true, false, "Asia/Riyadh"},
+		{"america/new_york", false, false, ""},
+		{"Factory", false, false, ""},
+		{"Mars/Olympus_Mons", false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := Lookup(tt.name)
+			if ok != tt.wantOK {
+				t.Fatalf("Lookup(%q) ok = %v, want %v", tt.name, ok, tt.wantOK)
+			}
+			if ok && (e.Canonical() != tt.canonical || e.Link != tt.link) {
+				t.Errorf("Lookup(%q) = %+v", tt.name, e)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		prefix  string
+		want    string
+		wantLen int
+	}{
+		{name: "spaces match underscores", query: "new york", want: "America/New_York"},
+		{name: "case insensitive", query: "TOKYO", want: "Asia/Tokyo"},
+		{name: "prefix only", prefix: "antarctica/", want: "Antarctica/McMurdo"},
+		{name: "prefix and query", query: "ind", prefix: "america/", want: "America/Indiana/Indianapolis"},
+		{name: "no match", query: "atlantis", wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Search(tt.query, tt.prefix)
+			if tt.want == "" {
+				if len(got) != tt.wantLen {
+					t.Errorf("Search(%q, %q) returned %d entries, want %d", tt.query, tt.prefix, len(got), tt.wantLen)
+				}
+				return
+			}
+			found := false
+			for _, e := range got {
+				if e.Name == tt.want {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Search(%q, %q) missing %s", tt.query, tt.prefix, tt.want)
+			}
+		})
+	}
+
+	if got := Search("", ""); len(got) != len(All()) {
+		t.Errorf("empty search returned %d entries, want all %d", len(got), len(All()))
+	}
+}
@@ -0,0 +1,128 @@
+//go:build ignore
+
+// gen.go generates zones_gen.go from a compiled tz database source file.
+//
+// Usage:
+//
+//	go run gen.go [path/to/tzdata.zi]
+//
+// tzdata.zi must be built without backzone: backzone turns many links (such
+// as Europe/Oslo) into zones of their own, so the link table would not match
+// the main tz database. Many distributions ship a backzone build, which is
+// rejected. The names read must be the same set the Go toolchain's
+// lib/time/zoneinfo.zip holds, so every generated name can be loaded with
+// time.LoadLocation. The version recorded is the one tzdata.zi declares.
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+func main() {
+	path := "/usr/share/zoneinfo/tzdata.zi"
+	if len(os.Args) > 1 {
+		path = os.Args[1]
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	version := ""
+	links := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) >= 3 && fields[0] == "#" && fields[1] == "version":
+			version = fields[2]
+		case len(fields) >= 2 && fields[0] == "#" && fields[1] == "ddeps" && slices.Contains(fields[2:], "backzone"):
+			log.Fatalf("%s is built with backzone; use a tzdata.zi built from the main data only", path)
+		case len(fields) >= 2 && fields[0] == "Z":
+			links[fields[1]] = ""
+		case len(fields) >= 3 && fields[0] == "L":
+			links[fields[2]] = fields[1]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+	if version == "" {
+		log.Fatalf("%s does not declare a tz version", path)
+	}
+	if err := checkToolchainNames(links); err != nil {
+		log.Fatal(err)
+	}
+
+	// Factory is a placeholder for unconfigured systems, not a real zone
+	delete(links, "Factory")
+
+	names := make([]string, 0, len(links))
+	for name := range links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from tzdata %s; DO NOT EDIT.\n\n", version)
+	fmt.Fprintf(&buf, "package tzcatalog\n\n")
+	fmt.Fprintf(&buf, "// tzdataVersion is the tz database release the catalog was generated from\n")
+	fmt.Fprintf(&buf, "const tzdataVersion = %q\n\n", version)
+	fmt.Fprintf(&buf, "// links maps every timezone name to its link target, or \"\" for canonical zones\n")
+	fmt.Fprintf(&buf, "var links = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", name, links[name])
+	}
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("zones_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// checkToolchainNames verifies that the names read match the zoneinfo.zip
+// shipped with the Go toolchain, which time/tzdata embeds
+func checkToolchainNames(links map[string]string) error {
+	out, err := exec.Command("go", "env", "GOROOT").Output()
+	if err != nil {
+		return fmt.Errorf("go env GOROOT: %w", err)
+	}
+	path := filepath.Join(strings.TrimSpace(string(out)), "lib", "time", "zoneinfo.zip")
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	seen := make(map[string]bool, len(r.File))
+	for _, f := range r.File {
+		seen[f.Name] = true
+	}
+	for name := range links {
+		if !seen[name] {
+			return fmt.Errorf("%s is not in %s; use the tz release the toolchain embeds", name, path)
+		}
+		delete(seen, name)
+	}
+	for name := range seen {
+		return fmt.Errorf("%s from %s is missing; use the tz release the toolchain embeds", name, path)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package tzcatalog
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidQuery is returned when a query is neither an abbreviation nor a UTC offset
+var ErrInvalidQuery = errors.New("query must be a timezone abbreviation (e.g. PST) or UTC offset (e.g. +05:30)")
+
+// maxOffsetSeconds bounds UTC offsets accepted in queries
+const maxOffsetSeconds = 15 * 3600
+
+// historyStart is the earliest instant searched for historical matches
+var historyStart = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Query is a parsed reverse lookup: either an abbreviation or a UTC offset
+type Query struct {
+	// Abbreviation is the upper-cased abbreviation, empty for offset queries
+	Abbreviation string
+	// Offset is the UTC offset in seconds, used when Abbreviation is empty
+	Offset int
+}
+
+// IsOffset reports whether the query is a UTC offset
+func (q Query) IsOffset() bool {
+	return q.Abbreviation == ""
+}
+
+// matches reports whether a zone period with the given abbreviation and offset matches
+func (q Query) matches(abbr string, offset int) bool {
+	if q.IsOffset() {
+		return offset == q.Offset
+	}
+	return strings.EqualFold(abbr, q.Abbreviation)
+}
+
+// ParseQuery parses an abbreviation such as "PST" or an offset such as
+// "+05:30", "-0800", "+5" or "UTC+5:30"
+func ParseQuery(s string) (Query, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+
+	rest := s
+	for _, prefix := range []string{"UTC", "GMT"} {
+		if len(s) > len(prefix) && strings.HasPrefix(s, prefix) {
+			rest = s[len(prefix):]
+		}
+	}
+	if strings.HasPrefix(rest, "+") || strings.HasPrefix(rest, "-") {
+		offset, err := parseOffset(rest)
+		if err != nil {
+			return Query{}, fmt.Errorf("%w: %q", ErrInvalidQuery, s)
+		}
+		return Query{Offset: offset}, nil
+	}
+
+	if len(s) < 2 || len(s) > 6 {
+		return Query{}, fmt.Errorf("%w: %q", ErrInvalidQuery, s)
+	}
+	for _, c := range s {
+		if c < 'A' || c > 'Z' {
+			return Query{}, fmt.Errorf("%w: %q", ErrInvalidQuery, s)
+		}
+	}
+	return Query{Abbreviation: s}, nil
+}
+
+// parseOffset parses a signed offset of the form ±H, ±HH, ±HHMM or ±HH:MM
+func parseOffset(s string) (int, error) {
+	sign := 1
+	if s[0] == '-' {
+		sign = -1
+	}
+	s = s[1:]
+
+	hours, minutes := s, ""
+	switch {
+	case strings.Contains(s, ":"):
+		hours, minutes, _ = strings.Cut(s, ":")
+	case len(s) == 4:
+		hours, minutes = s[:2], s[2:]
+	}
+	if hours == "" || len(hours) > 2 || (minutes != "" && len(minutes) != 2) {
+		return 0, errors.New("malformed offset")
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, err
+	}
+	m := 0
+	if minutes != "" {
+		if m, err = strconv.Atoi(minutes); err != nil || m >= 60 {
+			return 0, errors.New("malformed offset")
+		}
+	}
+
+	offset := h*3600 + m*60
+	if offset > maxOffsetSeconds {
+		return 0, errors.New("offset out of range")
+	}
+	return sign * offset, nil
+}
+
+// MatchStatus describes when a zone matched a query
+type MatchStatus string
+
+const (
+	// StatusCurrent means the zone matches at the requested instant
+	StatusCurrent MatchStatus = "current"
+	// StatusSeasonal means the zone matches within a year of the instant, e.g. in the other DST season
+	StatusSeasonal MatchStatus = "seasonal"
+	// StatusHistorical means the zone matched at some point since 1970 but no longer does
+	StatusHistorical MatchStatus = "historical"
+)
+
+// rank orders statuses from most to least relevant
+func (s MatchStatus) rank() int {
+	switch s {
+	case StatusCurrent:
+		return 0
+	case StatusSeasonal:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Match is a canonical zone that uses the queried abbreviation or offset
+type Match struct {
+	Zone   string
+	Status MatchStatus
+	// Offset and Abbreviation are those in effect during the matching period
+	Offset       int
+	Abbreviation string
+	// Until is the end of the last matching period, set for historical matches
+	Until time.Time
+}
+
+// Find returns the canonical zones that use the queried abbreviation or offset
+// at, around or before the instant at, ordered by status and then by name.
+//
+// A zone matches currently if the query applies at the instant, seasonally if
+// it applies within one year either side of it, and historically if it has
+// applied at any time since 1970.
+func Find(q Query, at time.Time) []Match {
+	windowStart, windowEnd := at.AddDate(-1, 0, 0), at.AddDate(1, 0, 0)
+
+	var matches []Match
+	for _, e := range entries {
+		if !e.Canonical() {
+			continue
+		}
+		loc, err := time.LoadLocation(e.Name)
+		if err != nil {
+			continue
+		}
+
+		var best *Match
+		t := historyStart.In(loc)
+		for t.Before(windowEnd) {
+			start, end := t.ZoneBounds()
+			abbr, offset := t.Zone()
+
+			if q.matches(abbr, offset) {
+				m := Match{Zone: e.Name, Status: StatusHistorical, Offset: offset, Abbreviation: abbr}
+				switch {
+				case !start.After(at) && (end.IsZero() || end.After(at)):
+					m.Status = StatusCurrent
+				case (end.IsZero() || end.After(windowStart)) && start.Before(windowEnd):
+					m.Status = StatusSeasonal
+				default:
+					m.Until = end
+				}
+				// Periods are chronological, so a later match of equal status replaces an earlier one
+				if best == nil || m.Status.rank() <= best.Status.rank() {
+					best = &m
+				}
+			}
+
+			if end.IsZero() || !end.After(t) {
+				break
+			}
+			t = end
+		}
+
+		if best != nil {
+			matches = append(matches, *best)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Status.rank() < matches[j].Status.rank()
+	})
+	return matches
+}
@@ -0,0 +1,154 @@
+package tzcatalog
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Query
+	}{
+		{"PST", Query{Abbreviation: "PST"}},
+		{" ist ", Query{Abbreviation: "IST"}},
+		{"ChST", Query{Abbreviation: "CHST"}},
+		{"UTC", Query{Abbreviation: "UTC"}},
+		{"+05:30", Query{Offset: 5*3600 + 1800}},
+		{"-0800", Query{Offset: -8 * 3600}},
+		{"+5", Query{Offset: 5 * 3600}},
+		{"-03:30", Query{Offset: -(3*3600 + 1800)}},
+		{"UTC+5:45", Query{Offset: 5*3600 + 45*60}},
+		{"gmt-3", Query{Offset: -3 * 3600}},
+		{"+00:00", Query{Offset: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseQuery(tt.input)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuery(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQueryInvalid(t *testing.T) {
+	inputs := []string{"", "P", "PACIFIC", "P5T", "+", "+123", "+05:3", "+05:60", "+16", "UTC+", "America/New_York"}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseQuery(input); !errors.Is(err, ErrInvalidQuery) {
+				t.Errorf("ParseQuery(%q) error = %v, want ErrInvalidQuery", input, err)
+			}
+		})
+	}
+}
+
+// findZone returns the match for zone, if any
+func findZone(matches []Match, zone string) (Match, bool) {
+	for _, m := range matches {
+		if m.Zone == zone {
+			return m, true
+		}
+	}
+	return Match{}, false
+}
+
+func TestFindAbbreviation(t *testing.T) {
+	winter := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+	matches := Find(Query{Abbreviation: "IST"}, winter)
+
+	tests := []struct {
+		zone   string
+		status MatchStatus
+		offset int
+	}{
+		{"Asia/Kolkata", StatusCurrent, 5*3600 + 1800},
+		{"Asia/Jerusalem", StatusCurrent, 2 * 3600},
+		// Dublin uses IST in summer only
+		{"Europe/Dublin", StatusSeasonal, 3600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			m, ok := findZone(matches, tt.zone)
+			if !ok {
+				t.Fatalf("expected %s to match IST", tt.zone)
+			}
+			if m.Status != tt.status || m.Offset != tt.offset {
+				t.Errorf("match = %+v, want status %s offset %d", m, tt.status, tt.offset)
+			}
+		})
+	}
+
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Status.rank() < matches[i-1].Status.rank() {
+			t.Fatalf("matches not ordered by status: %+v", matches)
+		}
+	}
+}
+
+func TestFindOffset(t *testing.T) {
+	summer := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	matches := Find(Query{Offset: -7 * 3600}, summer)
+
+	if m, ok := findZone(matches, "America/Phoenix"); !ok || m.Status != StatusCurrent || m.Abbreviation != "MST" {
+		t.Errorf("expected Phoenix on MST currently, got %+v (found=%v)", m, ok)
+	}
+	if m, ok := findZone(matches, "America/Los_Angeles"); !ok || m.Status != StatusCurrent || m.Abbreviation != "PDT" {
+		t.Errorf("expected Los Angeles on PDT currently, got %+v (found=%v)", m, ok)
+	}
+	for _, m := range matches {
+		if m.Zone == "US/Arizona" {
+			t.Error("expected links to be excluded")
+		}
+	}
+}
+
+func TestFindHistorical(t *testing.T) {
+	// Moscow used +04:00 from 2011 to 2014
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	m, ok := findZone(Find(Query{Offset: 4 * 3600}, at), "Europe/Moscow")
+	if !ok || m.Status != StatusHistorical {
+		t.Fatalf("expected historical Moscow match, got %+v (found=%v)", m, ok)
+	}
+	if !m.Until.Equal(time.Date(2014, 10, 25, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected Moscow to leave +04:00 on 2014-10-25T22:00Z, got %v", m.Until)
+	}
+}
+
+func TestPreference(t *testing.T) {
+	ist := Query{Abbreviation: "IST"}
+	if got := Preference(ist, "Asia/Kolkata"); got != 1 {
+		t.Errorf("Preference(IST, Asia/Kolkata) = %d, want 1", got)
+	}
+	if got := Preference(ist, "Europe/London"); got != 0 {
+		t.Errorf("Preference(IST, Europe/London) = %d, want 0", got)
+	}
+	if got := Preference(Query{Offset: 5*3600 + 1800}, "Asia/Kolkata"); got != 1 {
+		t.Errorf("Preference(+05:30, Asia/Kolkata) = %d, want 1", got)
+	}
+}
+
+func TestPreferredZonesAreCanonical(t *testing.T) {
+	tables := make([][]string, 0, len(preferredByAbbreviation)+len(preferredByOffset))
+	for _, zones := range preferredByAbbreviation {
+		tables = append(tables, zones)
+	}
+	for _, zones := range preferredByOffset {
+		tables = append(tables, zones)
+	}
+
+	for _, zones := range tables {
+		for _, zone := range zones {
+			if e, ok := Lookup(zone); !ok || !e.Canonical() {
+				t.Errorf("preferred zone %s is not a canonical catalog zone", zone)
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package tzcatalog
+
+// preferredByAbbreviation lists the zones most people mean by a common
+// abbreviation, most likely first. Abbreviations shared by several regions
+// (CST, IST, PST) list every common reading.
+var preferredByAbbreviation = map[string][]string{
+	"UTC":  {"Etc/UTC"},
+	"GMT":  {"Europe/London", "Etc/GMT"},
+	"BST":  {"Europe/London"},
+	"IST":  {"Asia/Kolkata", "Europe/Dublin", "Asia/Jerusalem"},
+	"WET":  {"Europe/Lisbon"},
+	"WEST": {"Europe/Lisbon"},
+	"CET":  {"Europe/Paris", "Europe/Berlin"},
+	"CEST": {"Europe/Paris", "Europe/Berlin"},
+	"EET":  {"Europe/Athens", "Europe/Helsinki", "Africa/Cairo"},
+	"EEST": {"Europe/Athens", "Europe/Helsinki", "Africa/Cairo"},
+	"MSK":  {"Europe/Moscow"},
+	"SAST": {"Africa/Johannesburg"},
+	"WAT":  {"Africa/Lagos"},
+	"CAT":  {"Africa/Maputo"},
+	"EAT":  {"Africa/Nairobi"},
+	"PKT":  {"Asia/Karachi"},
+	"WIB":  {"Asia/Jakarta"},
+	"HKT":  {"Asia/Hong_Kong"},
+	"JST":  {"Asia/Tokyo"},
+	"KST":  {"Asia/Seoul"},
+	"AWST": {"Australia/Perth"},
+	"ACST": {"Australia/Adelaide", "Australia/Darwin"},
+	"ACDT": {"Australia/Adelaide"},
+	"AEST": {"Australia/Sydney", "Australia/Brisbane"},
+	"AEDT": {"Australia/Sydney"},
+	"NZST": {"Pacific/Auckland"},
+	"NZDT": {"Pacific/Auckland"},
+	"HST":  {"Pacific/Honolulu"},
+	"AKST": {"America/Anchorage"},
+	"AKDT": {"America/Anchorage"},
+	"PST":  {"America/Los_Angeles", "Asia/Manila"},
+	"PDT":  {"America/Los_Angeles"},
+	"MST":  {"America/Denver", "America/Phoenix"},
+	"MDT":  {"America/Denver"},
+	"CST":  {"America/Chicago", "Asia/Shanghai", "America/Havana"},
+	"CDT":  {"America/Chicago", "America/Havana"},
+	"EST":  {"America/New_York"},
+	"EDT":  {"America/New_York"},
+	"AST":  {"America/Halifax", "America/Puerto_Rico"},
+	"ADT":  {"America/Halifax"},
+	"NST":  {"America/St_Johns"},
+	"NDT":  {"America/St_Johns"},
+}
+
+// preferredByOffset lists the zones most people mean by a UTC offset, keyed by seconds
+var preferredByOffset = map[int][]string{
+	-10 * 3600:       {"Pacific/Honolulu"},
+	-9 * 3600:        {"America/Anchorage"},
+	-8 * 3600:        {"America/Los_Angeles"},
+	-7 * 3600:        {"America/Denver", "America/Phoenix", "America/Los_Angeles"},
+	-6 * 3600:        {"America/Chicago", "America/Mexico_City", "America/Denver"},
+	-5 * 3600:        {"America/New_York", "America/Bogota", "America/Chicago"},
+	-4 * 3600:        {"America/New_York", "America/Halifax", "America/Santiago"},
+	-3 * 3600:        {"America/Sao_Paulo", "America/Argentina/Buenos_Aires"},
+	-(3*3600 + 1800): {"America/St_Johns"},
+	0:                {"Etc/UTC", "Europe/London"},
+	1 * 3600:         {"Europe/Paris", "Europe/Berlin", "Europe/London", "Africa/Lagos"},
+	2 * 3600:         {"Europe/Paris", "Europe/Berlin", "Europe/Athens", "Africa/Johannesburg"},
+	3 * 3600:         {"Europe/Moscow", "Europe/Athens", "Asia/Riyadh", "Africa/Nairobi"},
+	4 * 3600:         {"Asia/Dubai"},
+	5 * 3600:         {"Asia/Karachi", "Asia/Tashkent"},
+	5*3600 + 1800:    {"Asia/Kolkata"},
+	5*3600 + 45*60:   {"Asia/Kathmandu"},
+	7 * 3600:         {"Asia/Bangkok", "Asia/Jakarta"},
+	8 * 3600:         {"Asia/Shanghai", "Asia/Singapore", "Australia/Perth"},
+	9 * 3600:         {"Asia/Tokyo", "Asia/Seoul"},
+	9*3600 + 1800:    {"Australia/Adelaide", "Australia/Darwin"},
+	10 * 3600:        {"Australia/Sydney", "Australia/Brisbane"},
+	11 * 3600:        {"Australia/Sydney"},
+	12 * 3600:        {"Pacific/Auckland"},
+	13 * 3600:        {"Pacific/Auckland"},
+}
+
+// Preference returns the position of zone in the built-in preference table for
+// q, starting at 1, or 0 if the zone is not a preferred reading of the query
+func Preference(q Query, zone string) int {
+	list := preferredByOffset[q.Offset]
+	if !q.IsOffset() {
+		list = preferredByAbbreviation[q.Abbreviation]
+	}
+	for i, z := range list {
+		if z == zone {
+			return i + 1
+		}
+	}
+	return 0
+}